@@ -0,0 +1,58 @@
+// import_account recreates a user from a takeout archive directly against the
+// database, it is the offline counterpart of the ImportAccount rpc
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/khailequang334/social_network/configs"
+	"github.com/khailequang334/social_network/internal/interfaces/app/user_and_post_service"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/takeout"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	path        = flag.String("conf", "config.yml", "config path of the user_and_post service")
+	archivePath = flag.String("archive", "", "takeout archive to import")
+	dryRun      = flag.Bool("dry-run", false, "report what would be imported without writing anything")
+)
+
+func main() {
+	flag.Parse()
+	if *archivePath == "" {
+		log.Fatalf("-archive is required")
+	}
+
+	conf, err := configs.GetUserAndPostConfig(*path)
+	if err != nil {
+		log.Fatalf("failed to parse config: %v", err)
+	}
+
+	file, err := os.Open(*archivePath)
+	if err != nil {
+		log.Fatalf("failed to open archive: %v", err)
+	}
+	archive, err := takeout.Read(file)
+	file.Close()
+	if err != nil {
+		log.Fatalf("failed to read archive: %v", err)
+	}
+
+	service, err := user_and_post_service.NewUserAndPostService(conf)
+	if err != nil {
+		log.Fatalf("failed to init service %s", err)
+	}
+
+	response, err := service.ImportArchive(archive, *dryRun)
+	if err != nil {
+		log.Fatalf("failed to import archive: %v", err)
+	}
+	fmt.Println(protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Format(response))
+	if response.GetStatus() != user_and_post.ImportAccountResponse_OK {
+		os.Exit(1)
+	}
+}
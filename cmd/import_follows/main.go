@@ -0,0 +1,118 @@
+// import_follows applies a follower,followee CSV of user ids directly against
+// the database in batches, it is the offline counterpart of the BulkFollow and
+// BulkUnfollow rpcs. A first line that is not numeric is taken as a header
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/khailequang334/social_network/configs"
+	"github.com/khailequang334/social_network/internal/interfaces/app/user_and_post_service"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+)
+
+var (
+	path      = flag.String("conf", "config.yml", "config path of the user_and_post service")
+	csvPath   = flag.String("csv", "", "follower,followee CSV to import")
+	batchSize = flag.Int("batch", 500, "edges applied per transaction")
+	unfollow  = flag.Bool("unfollow", false, "remove the edges instead of adding them")
+)
+
+func main() {
+	flag.Parse()
+	if *csvPath == "" {
+		log.Fatalf("-csv is required")
+	}
+	if *batchSize <= 0 || *batchSize > user_and_post_service.MaxBulkFollowEdges {
+		log.Fatalf("-batch must be between 1 and %d", user_and_post_service.MaxBulkFollowEdges)
+	}
+
+	conf, err := configs.GetUserAndPostConfig(*path)
+	if err != nil {
+		log.Fatalf("failed to parse config: %v", err)
+	}
+
+	file, err := os.Open(*csvPath)
+	if err != nil {
+		log.Fatalf("failed to open csv: %v", err)
+	}
+	defer file.Close()
+
+	service, err := user_and_post_service.NewUserAndPostService(conf)
+	if err != nil {
+		log.Fatalf("failed to init service %s", err)
+	}
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	outcomes := make(map[user_and_post.FollowEdgeResult_FollowEdgeOutcome]int)
+	var batch []*user_and_post.FollowEdge
+	var batchLines []int
+	apply := func() {
+		if len(batch) == 0 {
+			return
+		}
+		results, err := service.ApplyFollowEdges(context.Background(), batch, *unfollow)
+		if err != nil {
+			log.Fatalf("failed to apply batch ending at line %d: %v", batchLines[len(batchLines)-1], err)
+		}
+		for i, result := range results {
+			outcomes[result.GetOutcome()]++
+			if result.GetOutcome() != user_and_post.FollowEdgeResult_APPLIED {
+				fmt.Fprintf(os.Stderr, "line %d: %d,%d: %s\n", batchLines[i], result.GetEdge().GetFollowerId(), result.GetEdge().GetFolloweeId(), result.GetOutcome())
+			}
+		}
+		batch, batchLines = batch[:0], batchLines[:0]
+	}
+
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			log.Fatalf("failed to read csv: %v", err)
+		}
+
+		followerId, followerErr := strconv.ParseInt(record[0], 10, 64)
+		followeeId, followeeErr := strconv.ParseInt(record[1], 10, 64)
+		if followerErr != nil || followeeErr != nil {
+			if line == 1 {
+				continue
+			}
+			log.Fatalf("line %d: user ids must be numbers", line)
+		}
+
+		batch = append(batch, &user_and_post.FollowEdge{FollowerId: followerId, FolloweeId: followeeId})
+		batchLines = append(batchLines, line)
+		if len(batch) == *batchSize {
+			apply()
+		}
+	}
+	apply()
+
+	failed := false
+	for value := 0; value < len(user_and_post.FollowEdgeResult_FollowEdgeOutcome_name); value++ {
+		outcome := user_and_post.FollowEdgeResult_FollowEdgeOutcome(value)
+		if outcomes[outcome] == 0 {
+			continue
+		}
+		fmt.Printf("%s: %d\n", outcome, outcomes[outcome])
+		switch outcome {
+		case user_and_post.FollowEdgeResult_USER_NOT_FOUND, user_and_post.FollowEdgeResult_INVALID_EDGE, user_and_post.FollowEdgeResult_BLOCKED:
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"net"
 
 	"github.com/khailequang334/social_network/configs"
+	"github.com/khailequang334/social_network/internal/identity"
 	"github.com/khailequang334/social_network/internal/interfaces/app/user_and_post_service"
 	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
 	"google.golang.org/grpc"
@@ -22,17 +24,27 @@ func main() {
 		log.Fatalf("failed to parse config: %v", err)
 	}
 
+	if conf.ServiceSecret == "" {
+		log.Fatal("service_secret is not set, caller identities could not be trusted")
+	}
+
 	service, err := user_and_post_service.NewUserAndPostService(conf)
 	if err != nil {
 		log.Fatalf("failed to init server %s", err)
 	}
 
+	go service.PurgeDeactivatedAccounts(context.Background())
+	go service.ProcessDataExports(context.Background())
+	go service.RefreshFollowSuggestions(context.Background())
+
 	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", conf.Port))
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	var opts []grpc.ServerOption
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(identity.UnaryServerInterceptor(conf.ServiceSecret)),
+	}
 	grpcServer := grpc.NewServer(opts...)
 	user_and_post.RegisterUserAndPostServer(grpcServer, service)
 	err = grpcServer.Serve(lis)
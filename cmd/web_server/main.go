@@ -24,8 +24,11 @@ func main() {
 		log.Fatalf("failed to init service: %v", err)
 	}
 	server := &web_server.WebServer{
-		Service: webSvc,
-		Port:    conf.Port,
+		Service:        webSvc,
+		Port:           conf.Port,
+		TrustedProxies: conf.TrustedProxies,
+	}
+	if err := server.Run(); err != nil {
+		log.Fatalf("failed to run server: %v", err)
 	}
-	server.Run()
 }
@@ -4,16 +4,128 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"gopkg.in/yaml.v3"
 	"gorm.io/driver/mysql"
 )
 
+type LoginProtectionConfig struct {
+	FailureWindow      time.Duration `yaml:"failure_window"`
+	BackoffThreshold   int64         `yaml:"backoff_threshold"`
+	IPBackoffThreshold int64         `yaml:"ip_backoff_threshold"`
+	BaseBackoff        time.Duration `yaml:"base_backoff"`
+	MaxBackoff         time.Duration `yaml:"max_backoff"`
+	LockoutThreshold   int64         `yaml:"lockout_threshold"`
+	LockoutDuration    time.Duration `yaml:"lockout_duration"`
+}
+
+type TwoFactorConfig struct {
+	Issuer       string        `yaml:"issuer"`
+	ChallengeTTL time.Duration `yaml:"challenge_ttl"`
+	MaxAttempts  int64         `yaml:"max_attempts"`
+}
+
+type SMTPConfig struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+type MailerConfig struct {
+	Type string     `yaml:"type"` // smtp, file or memory
+	From string     `yaml:"from"`
+	Dir  string     `yaml:"dir"`
+	SMTP SMTPConfig `yaml:"smtp"`
+}
+
+type AccountTokensConfig struct {
+	// PublicURL is the base of the links sent by mail
+	PublicURL        string        `yaml:"public_url"`
+	VerifyEmailTTL   time.Duration `yaml:"verify_email_ttl"`
+	ResetPasswordTTL time.Duration `yaml:"reset_password_ttl"`
+}
+
+type Argon2idConfig struct {
+	Memory      uint32 `yaml:"memory"` // KiB
+	Iterations  uint32 `yaml:"iterations"`
+	Parallelism uint8  `yaml:"parallelism"`
+	SaltLength  uint32 `yaml:"salt_length"`
+	KeyLength   uint32 `yaml:"key_length"`
+}
+
+type BcryptConfig struct {
+	Cost int `yaml:"cost"`
+}
+
+type PasswordHashingConfig struct {
+	Algorithm string         `yaml:"algorithm"` // argon2id or bcrypt
+	Argon2id  Argon2idConfig `yaml:"argon2id"`
+	Bcrypt    BcryptConfig   `yaml:"bcrypt"`
+}
+
+type PasswordPolicyConfig struct {
+	MinLength int `yaml:"min_length"`
+	// MinScore is the lowest accepted strength score, from 0 to 4
+	MinScore         int    `yaml:"min_score"`
+	BreachedListPath string `yaml:"breached_list_path"`
+}
+
+type AccountDeletionConfig struct {
+	// GracePeriod is how long a deleted account can still be restored by logging in
+	GracePeriod   time.Duration `yaml:"grace_period"`
+	PurgeInterval time.Duration `yaml:"purge_interval"`
+}
+
+type DataExportConfig struct {
+	Dir string `yaml:"dir"`
+	// TTL is how long a finished archive can be downloaded before it is deleted
+	TTL time.Duration `yaml:"ttl"`
+	// PollInterval is how often pending exports are picked up besides the
+	// immediate run after a request
+	PollInterval time.Duration `yaml:"poll_interval"`
+}
+
+type UserNameChangeConfig struct {
+	// Cooldown is the minimum time between two renames of the same user
+	Cooldown time.Duration `yaml:"cooldown"`
+	// HoldPeriod is how long an old user name keeps resolving to the user
+	// before anyone can take it
+	HoldPeriod time.Duration `yaml:"hold_period"`
+}
+
+type FollowSuggestionsConfig struct {
+	// CacheTTL is how long computed suggestions are served, stale ones are
+	// recomputed on the next request
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+	// RefreshInterval is how often the suggestions of users whose follow graph
+	// changed are recomputed in the background
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+	// ActivityWindow is how far back posts count as recent activity
+	ActivityWindow time.Duration `yaml:"activity_window"`
+	// MaxSuggestions is how many suggestions are kept per user
+	MaxSuggestions int `yaml:"max_suggestions"`
+}
+
 type UserAndPostConfig struct {
-	Port  int           `yaml:"port"`
-	MySQL mysql.Config  `yaml:"my_sql"`
-	Redis redis.Options `yaml:"redis"`
+	Port              int                     `yaml:"port"`
+	MySQL             mysql.Config            `yaml:"my_sql"`
+	Redis             redis.Options           `yaml:"redis"`
+	LoginProtection   LoginProtectionConfig   `yaml:"login_protection"`
+	TwoFactor         TwoFactorConfig         `yaml:"two_factor"`
+	Mailer            MailerConfig            `yaml:"mailer"`
+	AccountTokens     AccountTokensConfig     `yaml:"account_tokens"`
+	PasswordHashing   PasswordHashingConfig   `yaml:"password_hashing"`
+	PasswordPolicy    PasswordPolicyConfig    `yaml:"password_policy"`
+	AccountDeletion   AccountDeletionConfig   `yaml:"account_deletion"`
+	DataExport        DataExportConfig        `yaml:"data_export"`
+	UserNameChange    UserNameChangeConfig    `yaml:"user_name_change"`
+	FollowSuggestions FollowSuggestionsConfig `yaml:"follow_suggestions"`
+	// ServiceSecret authenticates the web server, the only peer whose caller
+	// user id is trusted
+	ServiceSecret string `yaml:"service_secret"`
 }
 
 type NewsfeedConfig struct {
@@ -22,14 +134,61 @@ type NewsfeedConfig struct {
 	Redis redis.Options `yaml:"redis"`
 }
 
+type SessionConfig struct {
+	Secret string        `yaml:"secret"`
+	TTL    time.Duration `yaml:"ttl"`
+	Secure bool          `yaml:"secure"`
+}
+
+type JWTKeyConfig struct {
+	Kid            string `yaml:"kid"`
+	Algorithm      string `yaml:"algorithm"` // HS256, RS256 or EdDSA
+	Secret         string `yaml:"secret"`
+	PrivateKeyPath string `yaml:"private_key_path"`
+	PublicKeyPath  string `yaml:"public_key_path"`
+}
+
+type JWTConfig struct {
+	Enabled    bool           `yaml:"enabled"`
+	Issuer     string         `yaml:"issuer"`
+	AccessTTL  time.Duration  `yaml:"access_ttl"`
+	RefreshTTL time.Duration  `yaml:"refresh_ttl"`
+	SigningKid string         `yaml:"signing_kid"`
+	Keys       []JWTKeyConfig `yaml:"keys"`
+}
+
+type OIDCProviderConfig struct {
+	Name         string   `yaml:"name"` // used in the login url, /oidc/<name>/login
+	IssuerURL    string   `yaml:"issuer_url"`
+	ClientID     string   `yaml:"client_id"`
+	ClientSecret string   `yaml:"client_secret"`
+	RedirectURL  string   `yaml:"redirect_url"`
+	Scopes       []string `yaml:"scopes"`
+}
+
+type OIDCConfig struct {
+	// StateTTL bounds how long the user may take at the provider
+	StateTTL  time.Duration        `yaml:"state_ttl"`
+	Providers []OIDCProviderConfig `yaml:"providers"`
+}
+
 type WebConfig struct {
-	Port        int `yaml:"port"`
+	Port        int           `yaml:"port"`
+	Redis       redis.Options `yaml:"redis"`
+	Session     SessionConfig `yaml:"session"`
+	JWT         JWTConfig     `yaml:"jwt"`
+	OIDC        OIDCConfig    `yaml:"oidc"`
 	UserAndPost struct {
 		Hosts []string `yaml:"hosts"`
 	} `yaml:"user_and_post"`
 	Newsfeed struct {
 		Hosts []string `yaml:"hosts"`
 	} `yaml:"newsfeed"`
+	// TrustedProxies lists the proxies whose X-Forwarded-For header is used
+	// for the client ip, the connection address is used when empty
+	TrustedProxies []string `yaml:"trusted_proxies"`
+	// ServiceSecret is sent with the caller user id to the backend services
+	ServiceSecret string `yaml:"service_secret"`
 }
 
 type SystemConfig struct {
@@ -7,13 +7,29 @@ USE socialnetwork;
 CREATE TABLE user (
   id INT AUTO_INCREMENT PRIMARY KEY,
   hashed_password VARCHAR(256) NOT NULL,
-  salt VARCHAR(20) NOT NULL,
+  salt VARCHAR(20) NOT NULL DEFAULT '',
   first_name VARCHAR(50) NOT NULL,
   last_name VARCHAR(50) NOT NULL,
-  date_of_birth TIMESTAMP NOT NULL,
+  date_of_birth TIMESTAMP NULL,
   email VARCHAR(50) NOT NULL ,
+  email_verified_at TIMESTAMP NULL,
+  suspended_at TIMESTAMP NULL,
+  deactivated_at TIMESTAMP NULL,
+  purged_at TIMESTAMP NULL,
+  bio_visibility TINYINT NOT NULL DEFAULT 0,
+  avatar_visibility TINYINT NOT NULL DEFAULT 0,
+  location_visibility TINYINT NOT NULL DEFAULT 0,
+  website_visibility TINYINT NOT NULL DEFAULT 0,
+  pronouns_visibility TINYINT NOT NULL DEFAULT 0,
+  private BOOL NOT NULL DEFAULT FALSE,
   user_name VARCHAR(50) NOT NULL,
-  INDEX idx_username (user_name)
+  bio VARCHAR(255) NOT NULL DEFAULT '',
+  avatar_path VARCHAR(255) NOT NULL DEFAULT '',
+  location VARCHAR(100) NOT NULL DEFAULT '',
+  website VARCHAR(255) NOT NULL DEFAULT '',
+  pronouns VARCHAR(30) NOT NULL DEFAULT '',
+  UNIQUE INDEX idx_username (user_name),
+  UNIQUE INDEX idx_email (email)
 );
 
 -- Create the post table
@@ -22,20 +38,26 @@ CREATE TABLE post (
   content_text VARCHAR(500),
   content_image_path VARCHAR(255),
   user_id INT NOT NULL,
-  visible BOOL NOT NULL,
+  audience TINYINT NOT NULL DEFAULT 0,
+  pinned_at TIMESTAMP NULL,
+  edited_at TIMESTAMP NULL,
   created_at TIMESTAMP NULL,
   updated_at TIMESTAMP NULL,
   deleted_at TIMESTAMP NULL,
-  FOREIGN KEY (user_id) REFERENCES user(id)
+  FOREIGN KEY (user_id) REFERENCES user(id),
+  INDEX idx_post_user_id_created_at (user_id, created_at, id)
 );
 
 -- Create the friendship table
 CREATE TABLE following (
     user_id INT NOT NULL ,
     friend_id INT NOT NULL ,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     FOREIGN KEY (user_id) REFERENCES user(id),
     FOREIGN KEY (friend_id) REFERENCES user(id),
-    PRIMARY KEY (user_id, friend_id)
+    PRIMARY KEY (user_id, friend_id),
+    INDEX idx_following_friend_id_created_at (friend_id, created_at),
+    INDEX idx_following_user_id_created_at (user_id, created_at)
 );
 
 -- Create the comment table
@@ -58,4 +80,202 @@ CREATE TABLE `like` (
     FOREIGN KEY (user_id) REFERENCES user(id),
     FOREIGN KEY (post_id) REFERENCES post(id),
     PRIMARY KEY (post_id, user_id)
-);
\ No newline at end of file
+);
+
+-- Create the two factor table
+CREATE TABLE two_factor (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    secret VARCHAR(64) NOT NULL,
+    confirmed_at TIMESTAMP NULL,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    UNIQUE INDEX idx_two_factor_user_id (user_id)
+);
+
+-- Create the recovery code table
+CREATE TABLE recovery_code (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    INDEX idx_recovery_code_user_id (user_id)
+);
+
+
+-- Create the user token table
+CREATE TABLE user_token (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    purpose VARCHAR(20) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    UNIQUE INDEX idx_user_token_token_hash (token_hash)
+);
+
+
+-- Create the identity link table
+CREATE TABLE identity_link (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    issuer VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(50),
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    INDEX idx_identity_link_user_id (user_id),
+    UNIQUE INDEX idx_identity_link_issuer_subject (issuer, subject)
+);
+
+
+-- Create the role and permission tables, users without a role are plain users
+CREATE TABLE role (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(20) NOT NULL,
+    UNIQUE INDEX idx_role_name (name)
+);
+
+CREATE TABLE permission (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    UNIQUE INDEX idx_permission_name (name)
+);
+
+CREATE TABLE role_permission (
+    role_id INT NOT NULL,
+    permission_id INT NOT NULL,
+    FOREIGN KEY (role_id) REFERENCES role(id),
+    FOREIGN KEY (permission_id) REFERENCES permission(id),
+    PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE TABLE user_role (
+    user_id INT NOT NULL,
+    role_id INT NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    FOREIGN KEY (role_id) REFERENCES role(id),
+    PRIMARY KEY (user_id, role_id)
+);
+
+INSERT INTO role (name) VALUES ('user'), ('moderator'), ('admin');
+INSERT INTO permission (name) VALUES ('posts:delete_any'), ('reports:list'), ('users:suspend'), ('users:import'), ('follows:bulk'), ('posts:view_revisions');
+INSERT INTO role_permission (role_id, permission_id)
+    SELECT role.id, permission.id FROM role JOIN permission
+    WHERE role.name = 'admin'
+       OR (role.name = 'moderator' AND permission.name IN ('posts:delete_any', 'reports:list', 'posts:view_revisions'));
+
+-- Create the report table
+CREATE TABLE report (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    reporter_id INT NOT NULL,
+    post_id INT NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    resolved_at TIMESTAMP NULL,
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (reporter_id) REFERENCES user(id),
+    FOREIGN KEY (post_id) REFERENCES post(id),
+    UNIQUE INDEX idx_report_reporter_post (reporter_id, post_id)
+);
+
+-- Create the data export table
+CREATE TABLE data_export (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    state VARCHAR(20) NOT NULL,
+    file_path VARCHAR(255) NOT NULL DEFAULT '',
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    completed_at TIMESTAMP NULL,
+    expires_at TIMESTAMP NULL,
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    INDEX idx_data_export_user_id (user_id)
+);
+
+-- Create the user name history table
+CREATE TABLE user_name_history (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    user_name VARCHAR(50) NOT NULL,
+    released_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    INDEX idx_user_name_history_user_id (user_id),
+    INDEX idx_user_name_history_user_name (user_name)
+);
+
+-- Create the block and mute tables
+CREATE TABLE user_block (
+    blocker_id INT NOT NULL,
+    blocked_id INT NOT NULL,
+    created_at TIMESTAMP NULL,
+    FOREIGN KEY (blocker_id) REFERENCES user(id),
+    FOREIGN KEY (blocked_id) REFERENCES user(id),
+    PRIMARY KEY (blocker_id, blocked_id),
+    INDEX idx_user_block_blocked_id (blocked_id)
+);
+
+CREATE TABLE user_mute (
+    muter_id INT NOT NULL,
+    muted_id INT NOT NULL,
+    created_at TIMESTAMP NULL,
+    FOREIGN KEY (muter_id) REFERENCES user(id),
+    FOREIGN KEY (muted_id) REFERENCES user(id),
+    PRIMARY KEY (muter_id, muted_id),
+    INDEX idx_user_mute_muted_id (muted_id)
+);
+
+-- Create the follow request table, pending follows of private accounts
+CREATE TABLE follow_request (
+    requester_id INT NOT NULL,
+    target_id INT NOT NULL,
+    created_at TIMESTAMP NULL,
+    FOREIGN KEY (requester_id) REFERENCES user(id),
+    FOREIGN KEY (target_id) REFERENCES user(id),
+    PRIMARY KEY (requester_id, target_id),
+    INDEX idx_follow_request_target_id (target_id)
+);
+
+-- Create the close friend table, friend_id reads the posts user_id shares with close friends
+CREATE TABLE close_friend (
+    user_id INT NOT NULL,
+    friend_id INT NOT NULL,
+    created_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    FOREIGN KEY (friend_id) REFERENCES user(id),
+    PRIMARY KEY (user_id, friend_id),
+    INDEX idx_close_friend_friend_id (friend_id)
+);
+
+-- Create the post revision table, the content of posts before each of their edits
+CREATE TABLE post_revision (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    post_id INT NOT NULL,
+    editor_id INT NOT NULL,
+    content_text VARCHAR(500),
+    content_image_path VARCHAR(255),
+    audience TINYINT NOT NULL,
+    created_at TIMESTAMP NULL,
+    FOREIGN KEY (post_id) REFERENCES post(id),
+    FOREIGN KEY (editor_id) REFERENCES user(id),
+    INDEX idx_post_revision_post_id (post_id)
+);
@@ -0,0 +1,7 @@
+-- Migrate a database created before account deletion, init/01-init.sql
+-- already creates the new schema. Run once, after profile_fields.sql.
+USE socialnetwork;
+
+ALTER TABLE user
+  ADD COLUMN deactivated_at TIMESTAMP NULL AFTER suspended_at,
+  ADD COLUMN purged_at TIMESTAMP NULL AFTER deactivated_at;
@@ -0,0 +1,8 @@
+-- Migrate a database created before the admin account import, init/01-init.sql
+-- already creates the new schema. Run once, after data_exports.sql.
+USE socialnetwork;
+
+INSERT INTO permission (name) VALUES ('users:import');
+INSERT INTO role_permission (role_id, permission_id)
+    SELECT role.id, permission.id FROM role JOIN permission
+    WHERE role.name = 'admin' AND permission.name = 'users:import';
@@ -0,0 +1,20 @@
+-- Migrate a database created before email verification and password reset,
+-- init/01-init.sql already creates the new schema. Existing emails start out
+-- unverified. Run once, after two_factor.sql.
+USE socialnetwork;
+
+ALTER TABLE user ADD COLUMN email_verified_at TIMESTAMP NULL AFTER email;
+
+CREATE TABLE user_token (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    purpose VARCHAR(20) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    UNIQUE INDEX idx_user_token_token_hash (token_hash)
+);
@@ -0,0 +1,23 @@
+-- Migrate a database created before blocks and mutes, init/01-init.sql
+-- already creates the new schema. Run once, after user_name_history.sql.
+USE socialnetwork;
+
+CREATE TABLE user_block (
+    blocker_id INT NOT NULL,
+    blocked_id INT NOT NULL,
+    created_at TIMESTAMP NULL,
+    FOREIGN KEY (blocker_id) REFERENCES user(id),
+    FOREIGN KEY (blocked_id) REFERENCES user(id),
+    PRIMARY KEY (blocker_id, blocked_id),
+    INDEX idx_user_block_blocked_id (blocked_id)
+);
+
+CREATE TABLE user_mute (
+    muter_id INT NOT NULL,
+    muted_id INT NOT NULL,
+    created_at TIMESTAMP NULL,
+    FOREIGN KEY (muter_id) REFERENCES user(id),
+    FOREIGN KEY (muted_id) REFERENCES user(id),
+    PRIMARY KEY (muter_id, muted_id),
+    INDEX idx_user_mute_muted_id (muted_id)
+);
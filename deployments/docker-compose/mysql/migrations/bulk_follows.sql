@@ -0,0 +1,8 @@
+-- Migrate a database created before bulk follows, init/01-init.sql already
+-- creates the new schema. Run once, after follow_times.sql.
+USE socialnetwork;
+
+INSERT INTO permission (name) VALUES ('follows:bulk');
+INSERT INTO role_permission (role_id, permission_id)
+    SELECT role.id, permission.id FROM role JOIN permission
+    WHERE role.name = 'admin' AND permission.name = 'follows:bulk';
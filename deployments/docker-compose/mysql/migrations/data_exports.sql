@@ -0,0 +1,18 @@
+-- Migrate a database created before personal data exports, init/01-init.sql
+-- already creates the new schema. Run once, after account_deletion.sql.
+USE socialnetwork;
+
+CREATE TABLE data_export (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    state VARCHAR(20) NOT NULL,
+    file_path VARCHAR(255) NOT NULL DEFAULT '',
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    completed_at TIMESTAMP NULL,
+    expires_at TIMESTAMP NULL,
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    INDEX idx_data_export_user_id (user_id)
+);
@@ -0,0 +1,13 @@
+-- Migrate a database created before follow times, init/01-init.sql already
+-- creates the new schema. When existing edges were made is not recorded, they
+-- are backfilled with the time of the migration so follows made afterwards
+-- list before them, edges sharing a time are ordered by user id. Run once,
+-- after private_accounts.sql.
+USE socialnetwork;
+
+ALTER TABLE following ADD COLUMN created_at TIMESTAMP NULL AFTER friend_id;
+UPDATE following SET created_at = CURRENT_TIMESTAMP WHERE created_at IS NULL;
+ALTER TABLE following
+  MODIFY COLUMN created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  ADD INDEX idx_following_friend_id_created_at (friend_id, created_at),
+  ADD INDEX idx_following_user_id_created_at (user_id, created_at);
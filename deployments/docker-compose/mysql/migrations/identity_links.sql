@@ -0,0 +1,20 @@
+-- Migrate a database created before OpenID Connect login, init/01-init.sql
+-- already creates the new schema. Run once, after unique_user_names.sql.
+USE socialnetwork;
+
+-- accounts provisioned from an external identity have no date of birth
+ALTER TABLE user MODIFY COLUMN date_of_birth TIMESTAMP NULL;
+
+CREATE TABLE identity_link (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    issuer VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(50),
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    INDEX idx_identity_link_user_id (user_id),
+    UNIQUE INDEX idx_identity_link_issuer_subject (issuer, subject)
+);
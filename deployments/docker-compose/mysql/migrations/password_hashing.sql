@@ -0,0 +1,7 @@
+-- Migrate a database created before PHC password hashes, init/01-init.sql
+-- already creates the new schema. Legacy hashes keep their salt until they
+-- are rehashed on the next login, new users are stored without one. Run
+-- once, after account_tokens.sql.
+USE socialnetwork;
+
+ALTER TABLE user MODIFY COLUMN salt VARCHAR(20) NOT NULL DEFAULT '';
@@ -0,0 +1,20 @@
+-- Migrate a database created before post audiences, init/01-init.sql already
+-- creates the new schema. Posts that were not visible become only-me posts,
+-- visible ones stay public. Run once, after bulk_follows.sql, while the
+-- user_and_post and newsfeed services are stopped, then delete the post:* keys
+-- from redis as cached posts still carry the old visible flag.
+USE socialnetwork;
+
+ALTER TABLE post ADD COLUMN audience TINYINT NOT NULL DEFAULT 0 AFTER user_id;
+UPDATE post SET audience = IF(visible, 0, 3);
+ALTER TABLE post DROP COLUMN visible;
+
+CREATE TABLE close_friend (
+    user_id INT NOT NULL,
+    friend_id INT NOT NULL,
+    created_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    FOREIGN KEY (friend_id) REFERENCES user(id),
+    PRIMARY KEY (user_id, friend_id),
+    INDEX idx_close_friend_friend_id (friend_id)
+);
@@ -0,0 +1,8 @@
+-- Migrate a database created before pinned posts and profile timelines,
+-- init/01-init.sql already creates the new schema. Run once, after
+-- post_audience.sql.
+USE socialnetwork;
+
+ALTER TABLE post
+  ADD COLUMN pinned_at TIMESTAMP NULL AFTER audience,
+  ADD INDEX idx_post_user_id_created_at (user_id, created_at, id);
@@ -0,0 +1,23 @@
+-- Migrate a database created before post revisions, init/01-init.sql already
+-- creates the new schema. Run once, after post_pins.sql.
+USE socialnetwork;
+
+ALTER TABLE post ADD COLUMN edited_at TIMESTAMP NULL AFTER pinned_at;
+
+CREATE TABLE post_revision (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    post_id INT NOT NULL,
+    editor_id INT NOT NULL,
+    content_text VARCHAR(500),
+    content_image_path VARCHAR(255),
+    audience TINYINT NOT NULL,
+    created_at TIMESTAMP NULL,
+    FOREIGN KEY (post_id) REFERENCES post(id),
+    FOREIGN KEY (editor_id) REFERENCES user(id),
+    INDEX idx_post_revision_post_id (post_id)
+);
+
+INSERT INTO permission (name) VALUES ('posts:view_revisions');
+INSERT INTO role_permission (role_id, permission_id)
+    SELECT role.id, permission.id FROM role JOIN permission
+    WHERE role.name IN ('admin', 'moderator') AND permission.name = 'posts:view_revisions';
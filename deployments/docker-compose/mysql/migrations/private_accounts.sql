@@ -0,0 +1,16 @@
+-- Migrate a database created before private accounts, init/01-init.sql
+-- already creates the new schema. Existing accounts stay public. Run once,
+-- after blocks_and_mutes.sql.
+USE socialnetwork;
+
+ALTER TABLE user ADD COLUMN private BOOL NOT NULL DEFAULT FALSE AFTER pronouns_visibility;
+
+CREATE TABLE follow_request (
+    requester_id INT NOT NULL,
+    target_id INT NOT NULL,
+    created_at TIMESTAMP NULL,
+    FOREIGN KEY (requester_id) REFERENCES user(id),
+    FOREIGN KEY (target_id) REFERENCES user(id),
+    PRIMARY KEY (requester_id, target_id),
+    INDEX idx_follow_request_target_id (target_id)
+);
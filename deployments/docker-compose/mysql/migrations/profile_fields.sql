@@ -0,0 +1,14 @@
+-- Migrate a database created before the extended profile fields,
+-- init/01-init.sql already creates the new schema. Existing users keep empty
+-- fields, all public. Run once, after profiles.sql.
+USE socialnetwork;
+
+ALTER TABLE user
+  ADD COLUMN bio_visibility TINYINT NOT NULL DEFAULT 0 AFTER suspended_at,
+  ADD COLUMN avatar_visibility TINYINT NOT NULL DEFAULT 0 AFTER bio_visibility,
+  ADD COLUMN location_visibility TINYINT NOT NULL DEFAULT 0 AFTER avatar_visibility,
+  ADD COLUMN website_visibility TINYINT NOT NULL DEFAULT 0 AFTER location_visibility,
+  ADD COLUMN pronouns_visibility TINYINT NOT NULL DEFAULT 0 AFTER website_visibility,
+  ADD COLUMN location VARCHAR(100) NOT NULL DEFAULT '' AFTER avatar_path,
+  ADD COLUMN website VARCHAR(255) NOT NULL DEFAULT '' AFTER location,
+  ADD COLUMN pronouns VARCHAR(30) NOT NULL DEFAULT '' AFTER website;
@@ -0,0 +1,7 @@
+-- Migrate a database created before user profiles, init/01-init.sql already
+-- creates the new schema. Run once, after roles.sql.
+USE socialnetwork;
+
+ALTER TABLE user
+  ADD COLUMN bio VARCHAR(255) NOT NULL DEFAULT '' AFTER user_name,
+  ADD COLUMN avatar_path VARCHAR(255) NOT NULL DEFAULT '' AFTER bio;
@@ -0,0 +1,57 @@
+-- Migrate a database created before roles and moderation, init/01-init.sql
+-- already creates the new schema. Existing users get no role, grant the
+-- first admin by hand with
+--   INSERT INTO user_role (user_id, role_id) SELECT <user id>, id FROM role WHERE name = 'admin';
+-- Run once, after identity_links.sql.
+USE socialnetwork;
+
+ALTER TABLE user ADD COLUMN suspended_at TIMESTAMP NULL AFTER email_verified_at;
+
+CREATE TABLE role (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(20) NOT NULL,
+    UNIQUE INDEX idx_role_name (name)
+);
+
+CREATE TABLE permission (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    UNIQUE INDEX idx_permission_name (name)
+);
+
+CREATE TABLE role_permission (
+    role_id INT NOT NULL,
+    permission_id INT NOT NULL,
+    FOREIGN KEY (role_id) REFERENCES role(id),
+    FOREIGN KEY (permission_id) REFERENCES permission(id),
+    PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE TABLE user_role (
+    user_id INT NOT NULL,
+    role_id INT NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    FOREIGN KEY (role_id) REFERENCES role(id),
+    PRIMARY KEY (user_id, role_id)
+);
+
+INSERT INTO role (name) VALUES ('user'), ('moderator'), ('admin');
+INSERT INTO permission (name) VALUES ('posts:delete_any'), ('reports:list'), ('users:suspend');
+INSERT INTO role_permission (role_id, permission_id)
+    SELECT role.id, permission.id FROM role JOIN permission
+    WHERE role.name = 'admin'
+       OR (role.name = 'moderator' AND permission.name IN ('posts:delete_any', 'reports:list'));
+
+CREATE TABLE report (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    reporter_id INT NOT NULL,
+    post_id INT NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    resolved_at TIMESTAMP NULL,
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (reporter_id) REFERENCES user(id),
+    FOREIGN KEY (post_id) REFERENCES post(id),
+    UNIQUE INDEX idx_report_reporter_post (reporter_id, post_id)
+);
@@ -0,0 +1,29 @@
+-- Migrate a database created before two-factor authentication,
+-- init/01-init.sql already creates the new schema. This is the first
+-- migration, run it once before all the others.
+USE socialnetwork;
+
+CREATE TABLE two_factor (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    secret VARCHAR(64) NOT NULL,
+    confirmed_at TIMESTAMP NULL,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    UNIQUE INDEX idx_two_factor_user_id (user_id)
+);
+
+CREATE TABLE recovery_code (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    INDEX idx_recovery_code_user_id (user_id)
+);
@@ -0,0 +1,12 @@
+-- Migrate a database created before usernames and emails were unique,
+-- init/01-init.sql already creates the new schema. The indexes can not be
+-- built while two accounts share a username or email, list them with
+--   SELECT user_name FROM user GROUP BY user_name HAVING COUNT(*) > 1;
+--   SELECT email FROM user GROUP BY email HAVING COUNT(*) > 1;
+-- and rename them first. Run once, after password_hashing.sql.
+USE socialnetwork;
+
+ALTER TABLE user
+  DROP INDEX idx_username,
+  ADD UNIQUE INDEX idx_username (user_name),
+  ADD UNIQUE INDEX idx_email (email);
@@ -0,0 +1,16 @@
+-- Migrate a database created before user name changes, init/01-init.sql
+-- already creates the new schema. Run once, after account_import.sql.
+USE socialnetwork;
+
+CREATE TABLE user_name_history (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    user_name VARCHAR(50) NOT NULL,
+    released_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES user(id),
+    INDEX idx_user_name_history_user_id (user_id),
+    INDEX idx_user_name_history_user_name (user_name)
+);
@@ -0,0 +1,52 @@
+package authz
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Permissions granted to roles through the role_permission table
+const (
+	PermissionDeleteAnyPost = "posts:delete_any"
+	PermissionListReports   = "reports:list"
+	PermissionSuspendUser   = "users:suspend"
+	PermissionImportAccount = "users:import"
+	PermissionBulkFollow    = "follows:bulk"
+	// PermissionViewPostRevisions shows the edit history of any post
+	PermissionViewPostRevisions = "posts:view_revisions"
+)
+
+// Checker answers whether a user holds a permission through one of their roles,
+// the gRPC service reads the database while the web server asks the service
+type Checker interface {
+	HasPermission(ctx context.Context, userId int64, permission string) (bool, error)
+}
+
+// CheckerFunc adapts a function to the Checker interface
+type CheckerFunc func(ctx context.Context, userId int64, permission string) (bool, error)
+
+func (f CheckerFunc) HasPermission(ctx context.Context, userId int64, permission string) (bool, error) {
+	return f(ctx, userId, permission)
+}
+
+type DBChecker struct {
+	DB *gorm.DB
+}
+
+func NewDBChecker(db *gorm.DB) *DBChecker {
+	return &DBChecker{DB: db}
+}
+
+func (c *DBChecker) HasPermission(ctx context.Context, userId int64, permission string) (bool, error) {
+	var count int64
+	err := c.DB.WithContext(ctx).Table("user_role").
+		Joins("JOIN role_permission ON role_permission.role_id = user_role.role_id").
+		Joins("JOIN permission ON permission.id = role_permission.permission_id").
+		Where("user_role.user_id = ? AND permission.name = ?", userId, permission).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/rand"
 
+	"github.com/khailequang334/social_network/internal/identity"
 	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/newsfeed"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -17,8 +18,11 @@ func (r *randomClient) GenerateNewsfeed(ctx context.Context, in *newsfeed.Genera
 	return r.clients[rand.Intn(len(r.clients))].GenerateNewsfeed(ctx, in, opts...)
 }
 
-func NewClient(hosts []string) (newsfeed.NewsfeedClient, error) {
-	var opts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+func NewClient(hosts []string, secret string) (newsfeed.NewsfeedClient, error) {
+	var opts = []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(identity.UnaryClientInterceptor(secret)),
+	}
 	clients := make([]newsfeed.NewsfeedClient, 0, len(hosts))
 	for _, host := range hosts {
 		conn, err := grpc.Dial(host, opts...)
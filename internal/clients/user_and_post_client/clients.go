@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/rand"
 
+	"github.com/khailequang334/social_network/internal/identity"
 	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -25,6 +26,110 @@ func (a *randomClient) AuthenticateUser(ctx context.Context, in *user_and_post.A
 	return a.clients[rand.Intn(len(a.clients))].AuthenticateUser(ctx, in, opts...)
 }
 
+func (a *randomClient) EnrollSecondFactor(ctx context.Context, in *user_and_post.EnrollSecondFactorRequest, opts ...grpc.CallOption) (*user_and_post.EnrollSecondFactorResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].EnrollSecondFactor(ctx, in, opts...)
+}
+
+func (a *randomClient) ConfirmSecondFactor(ctx context.Context, in *user_and_post.ConfirmSecondFactorRequest, opts ...grpc.CallOption) (*user_and_post.ConfirmSecondFactorResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ConfirmSecondFactor(ctx, in, opts...)
+}
+
+func (a *randomClient) GenerateRecoveryCodes(ctx context.Context, in *user_and_post.GenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*user_and_post.GenerateRecoveryCodesResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GenerateRecoveryCodes(ctx, in, opts...)
+}
+
+func (a *randomClient) VerifySecondFactor(ctx context.Context, in *user_and_post.VerifySecondFactorRequest, opts ...grpc.CallOption) (*user_and_post.VerifySecondFactorResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].VerifySecondFactor(ctx, in, opts...)
+}
+
+func (a *randomClient) RequestEmailVerification(ctx context.Context, in *user_and_post.RequestEmailVerificationRequest, opts ...grpc.CallOption) (*user_and_post.RequestEmailVerificationResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RequestEmailVerification(ctx, in, opts...)
+}
+
+func (a *randomClient) VerifyEmail(ctx context.Context, in *user_and_post.VerifyEmailRequest, opts ...grpc.CallOption) (*user_and_post.VerifyEmailResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].VerifyEmail(ctx, in, opts...)
+}
+
+func (a *randomClient) RequestPasswordReset(ctx context.Context, in *user_and_post.RequestPasswordResetRequest, opts ...grpc.CallOption) (*user_and_post.RequestPasswordResetResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RequestPasswordReset(ctx, in, opts...)
+}
+
+func (a *randomClient) ResetPassword(ctx context.Context, in *user_and_post.ResetPasswordRequest, opts ...grpc.CallOption) (*user_and_post.ResetPasswordResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ResetPassword(ctx, in, opts...)
+}
+
+func (a *randomClient) LoginWithIdentity(ctx context.Context, in *user_and_post.LoginWithIdentityRequest, opts ...grpc.CallOption) (*user_and_post.LoginWithIdentityResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].LoginWithIdentity(ctx, in, opts...)
+}
+
+func (a *randomClient) LinkIdentity(ctx context.Context, in *user_and_post.LinkIdentityRequest, opts ...grpc.CallOption) (*user_and_post.LinkIdentityResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].LinkIdentity(ctx, in, opts...)
+}
+
+func (a *randomClient) UnlinkIdentity(ctx context.Context, in *user_and_post.UnlinkIdentityRequest, opts ...grpc.CallOption) (*user_and_post.UnlinkIdentityResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].UnlinkIdentity(ctx, in, opts...)
+}
+
+func (a *randomClient) ListIdentities(ctx context.Context, in *user_and_post.ListIdentitiesRequest, opts ...grpc.CallOption) (*user_and_post.ListIdentitiesResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ListIdentities(ctx, in, opts...)
+}
+
+func (a *randomClient) CheckPermission(ctx context.Context, in *user_and_post.CheckPermissionRequest, opts ...grpc.CallOption) (*user_and_post.CheckPermissionResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].CheckPermission(ctx, in, opts...)
+}
+
+func (a *randomClient) SuspendUser(ctx context.Context, in *user_and_post.SuspendUserRequest, opts ...grpc.CallOption) (*user_and_post.SuspendUserResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].SuspendUser(ctx, in, opts...)
+}
+
+func (a *randomClient) ForceDeletePost(ctx context.Context, in *user_and_post.ForceDeletePostRequest, opts ...grpc.CallOption) (*user_and_post.ForceDeletePostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ForceDeletePost(ctx, in, opts...)
+}
+
+func (a *randomClient) ReportPost(ctx context.Context, in *user_and_post.ReportPostRequest, opts ...grpc.CallOption) (*user_and_post.ReportPostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ReportPost(ctx, in, opts...)
+}
+
+func (a *randomClient) ListReports(ctx context.Context, in *user_and_post.ListReportsRequest, opts ...grpc.CallOption) (*user_and_post.ListReportsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ListReports(ctx, in, opts...)
+}
+
+func (a *randomClient) GetUser(ctx context.Context, in *user_and_post.GetUserRequest, opts ...grpc.CallOption) (*user_and_post.GetUserResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetUser(ctx, in, opts...)
+}
+
+func (a *randomClient) GetUserByUserName(ctx context.Context, in *user_and_post.GetUserByUserNameRequest, opts ...grpc.CallOption) (*user_and_post.GetUserResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetUserByUserName(ctx, in, opts...)
+}
+
+func (a *randomClient) GetUsers(ctx context.Context, in *user_and_post.GetUsersRequest, opts ...grpc.CallOption) (*user_and_post.GetUsersResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetUsers(ctx, in, opts...)
+}
+
+func (a *randomClient) DeleteAccount(ctx context.Context, in *user_and_post.DeleteAccountRequest, opts ...grpc.CallOption) (*user_and_post.DeleteAccountResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].DeleteAccount(ctx, in, opts...)
+}
+
+func (a *randomClient) RequestDataExport(ctx context.Context, in *user_and_post.RequestDataExportRequest, opts ...grpc.CallOption) (*user_and_post.RequestDataExportResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RequestDataExport(ctx, in, opts...)
+}
+
+func (a *randomClient) GetDataExport(ctx context.Context, in *user_and_post.GetDataExportRequest, opts ...grpc.CallOption) (*user_and_post.GetDataExportResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetDataExport(ctx, in, opts...)
+}
+
+func (a *randomClient) DownloadDataExport(ctx context.Context, in *user_and_post.DownloadDataExportRequest, opts ...grpc.CallOption) (user_and_post.UserAndPost_DownloadDataExportClient, error) {
+	return a.clients[rand.Intn(len(a.clients))].DownloadDataExport(ctx, in, opts...)
+}
+
+func (a *randomClient) ImportAccount(ctx context.Context, in *user_and_post.ImportAccountRequest, opts ...grpc.CallOption) (*user_and_post.ImportAccountResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ImportAccount(ctx, in, opts...)
+}
+
+func (a *randomClient) ChangeUserName(ctx context.Context, in *user_and_post.ChangeUserNameRequest, opts ...grpc.CallOption) (*user_and_post.ChangeUserNameResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ChangeUserName(ctx, in, opts...)
+}
+
 func (a *randomClient) FollowUser(ctx context.Context, in *user_and_post.FollowUserRequest, opts ...grpc.CallOption) (*user_and_post.FollowUserResponse, error) {
 	return a.clients[rand.Intn(len(a.clients))].FollowUser(ctx, in, opts...)
 }
@@ -37,6 +142,82 @@ func (a *randomClient) GetFollowerList(ctx context.Context, in *user_and_post.Ge
 	return a.clients[rand.Intn(len(a.clients))].GetFollowerList(ctx, in, opts...)
 }
 
+func (a *randomClient) GetFollowingList(ctx context.Context, in *user_and_post.GetFollowingListRequest, opts ...grpc.CallOption) (*user_and_post.GetFollowingListResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetFollowingList(ctx, in, opts...)
+}
+
+func (a *randomClient) ListFollowRequests(ctx context.Context, in *user_and_post.ListFollowRequestsRequest, opts ...grpc.CallOption) (*user_and_post.ListFollowRequestsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ListFollowRequests(ctx, in, opts...)
+}
+
+func (a *randomClient) ApproveFollowRequest(ctx context.Context, in *user_and_post.ApproveFollowRequestRequest, opts ...grpc.CallOption) (*user_and_post.ApproveFollowRequestResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ApproveFollowRequest(ctx, in, opts...)
+}
+
+func (a *randomClient) RejectFollowRequest(ctx context.Context, in *user_and_post.RejectFollowRequestRequest, opts ...grpc.CallOption) (*user_and_post.RejectFollowRequestResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RejectFollowRequest(ctx, in, opts...)
+}
+
+func (a *randomClient) CancelFollowRequest(ctx context.Context, in *user_and_post.CancelFollowRequestRequest, opts ...grpc.CallOption) (*user_and_post.CancelFollowRequestResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].CancelFollowRequest(ctx, in, opts...)
+}
+
+func (a *randomClient) GetRelationship(ctx context.Context, in *user_and_post.GetRelationshipRequest, opts ...grpc.CallOption) (*user_and_post.GetRelationshipResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetRelationship(ctx, in, opts...)
+}
+
+func (a *randomClient) GetRelationships(ctx context.Context, in *user_and_post.GetRelationshipsRequest, opts ...grpc.CallOption) (*user_and_post.GetRelationshipsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetRelationships(ctx, in, opts...)
+}
+
+func (a *randomClient) SuggestFollows(ctx context.Context, in *user_and_post.SuggestFollowsRequest, opts ...grpc.CallOption) (*user_and_post.SuggestFollowsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].SuggestFollows(ctx, in, opts...)
+}
+
+func (a *randomClient) BulkFollow(ctx context.Context, in *user_and_post.BulkFollowRequest, opts ...grpc.CallOption) (*user_and_post.BulkFollowResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].BulkFollow(ctx, in, opts...)
+}
+
+func (a *randomClient) BulkUnfollow(ctx context.Context, in *user_and_post.BulkFollowRequest, opts ...grpc.CallOption) (*user_and_post.BulkFollowResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].BulkUnfollow(ctx, in, opts...)
+}
+
+func (a *randomClient) BlockUser(ctx context.Context, in *user_and_post.BlockUserRequest, opts ...grpc.CallOption) (*user_and_post.BlockUserResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].BlockUser(ctx, in, opts...)
+}
+
+func (a *randomClient) UnblockUser(ctx context.Context, in *user_and_post.UnblockUserRequest, opts ...grpc.CallOption) (*user_and_post.UnblockUserResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].UnblockUser(ctx, in, opts...)
+}
+
+func (a *randomClient) ListBlockedUsers(ctx context.Context, in *user_and_post.ListBlockedUsersRequest, opts ...grpc.CallOption) (*user_and_post.ListBlockedUsersResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ListBlockedUsers(ctx, in, opts...)
+}
+
+func (a *randomClient) MuteUser(ctx context.Context, in *user_and_post.MuteUserRequest, opts ...grpc.CallOption) (*user_and_post.MuteUserResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].MuteUser(ctx, in, opts...)
+}
+
+func (a *randomClient) UnmuteUser(ctx context.Context, in *user_and_post.UnmuteUserRequest, opts ...grpc.CallOption) (*user_and_post.UnmuteUserResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].UnmuteUser(ctx, in, opts...)
+}
+
+func (a *randomClient) ListMutedUsers(ctx context.Context, in *user_and_post.ListMutedUsersRequest, opts ...grpc.CallOption) (*user_and_post.ListMutedUsersResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ListMutedUsers(ctx, in, opts...)
+}
+
+func (a *randomClient) AddCloseFriend(ctx context.Context, in *user_and_post.AddCloseFriendRequest, opts ...grpc.CallOption) (*user_and_post.AddCloseFriendResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].AddCloseFriend(ctx, in, opts...)
+}
+
+func (a *randomClient) RemoveCloseFriend(ctx context.Context, in *user_and_post.RemoveCloseFriendRequest, opts ...grpc.CallOption) (*user_and_post.RemoveCloseFriendResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RemoveCloseFriend(ctx, in, opts...)
+}
+
+func (a *randomClient) ListCloseFriends(ctx context.Context, in *user_and_post.ListCloseFriendsRequest, opts ...grpc.CallOption) (*user_and_post.ListCloseFriendsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ListCloseFriends(ctx, in, opts...)
+}
+
 func (a *randomClient) CreatePost(ctx context.Context, in *user_and_post.CreatePostRequest, opts ...grpc.CallOption) (*user_and_post.CreatePostResponse, error) {
 	return a.clients[rand.Intn(len(a.clients))].CreatePost(ctx, in, opts...)
 }
@@ -53,6 +234,26 @@ func (a *randomClient) EditPost(ctx context.Context, in *user_and_post.EditPostR
 	return a.clients[rand.Intn(len(a.clients))].EditPost(ctx, in, opts...)
 }
 
+func (a *randomClient) ListUserPosts(ctx context.Context, in *user_and_post.ListUserPostsRequest, opts ...grpc.CallOption) (*user_and_post.ListUserPostsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ListUserPosts(ctx, in, opts...)
+}
+
+func (a *randomClient) PinPost(ctx context.Context, in *user_and_post.PinPostRequest, opts ...grpc.CallOption) (*user_and_post.PinPostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].PinPost(ctx, in, opts...)
+}
+
+func (a *randomClient) UnpinPost(ctx context.Context, in *user_and_post.UnpinPostRequest, opts ...grpc.CallOption) (*user_and_post.UnpinPostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].UnpinPost(ctx, in, opts...)
+}
+
+func (a *randomClient) ListPostRevisions(ctx context.Context, in *user_and_post.ListPostRevisionsRequest, opts ...grpc.CallOption) (*user_and_post.ListPostRevisionsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ListPostRevisions(ctx, in, opts...)
+}
+
+func (a *randomClient) GetPostRevision(ctx context.Context, in *user_and_post.GetPostRevisionRequest, opts ...grpc.CallOption) (*user_and_post.GetPostRevisionResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetPostRevision(ctx, in, opts...)
+}
+
 func (a *randomClient) LikePost(ctx context.Context, in *user_and_post.LikePostRequest, opts ...grpc.CallOption) (*user_and_post.LikePostResponse, error) {
 	return a.clients[rand.Intn(len(a.clients))].LikePost(ctx, in, opts...)
 }
@@ -61,8 +262,11 @@ func (a *randomClient) CommentPost(ctx context.Context, in *user_and_post.Commen
 	return a.clients[rand.Intn(len(a.clients))].CommentPost(ctx, in, opts...)
 }
 
-func NewClient(hosts []string) (user_and_post.UserAndPostClient, error) {
-	var opts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+func NewClient(hosts []string, secret string) (user_and_post.UserAndPostClient, error) {
+	var opts = []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(identity.UnaryClientInterceptor(secret)),
+	}
 	clients := make([]user_and_post.UserAndPostClient, 0, len(hosts))
 	for _, host := range hosts {
 		conn, err := grpc.Dial(host, opts...)
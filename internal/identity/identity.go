@@ -0,0 +1,79 @@
+package identity
+
+import (
+	"context"
+	"crypto/subtle"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ContextKey is the key the web server stores the authenticated user id under
+// in the gin context
+const ContextKey = "current_user_id"
+
+const metadataKey = "x-user-id"
+
+const secretMetadataKey = "x-service-secret"
+
+type callerIdKey struct{}
+
+// WithCallerId returns a copy of ctx carrying the authenticated user id
+func WithCallerId(ctx context.Context, userId int64) context.Context {
+	return context.WithValue(ctx, callerIdKey{}, userId)
+}
+
+// CallerIdFromContext returns the authenticated user id, if any
+func CallerIdFromContext(ctx context.Context) (int64, bool) {
+	if userId, ok := ctx.Value(callerIdKey{}).(int64); ok {
+		return userId, true
+	}
+	// gin.Context resolves string keys from its own key/value store
+	if userId, ok := ctx.Value(ContextKey).(int64); ok {
+		return userId, true
+	}
+	return 0, false
+}
+
+// UnaryClientInterceptor forwards the authenticated user id as gRPC metadata,
+// together with the secret shared by the services
+func UnaryClientInterceptor(secret string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if userId, ok := CallerIdFromContext(ctx); ok {
+			ctx = metadata.AppendToOutgoingContext(ctx, metadataKey, strconv.FormatInt(userId, 10), secretMetadataKey, secret)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// UnaryServerInterceptor extracts the user id sent by UnaryClientInterceptor.
+//
+// The user id is whatever the caller claims, so it is only trusted from peers
+// that know the shared secret, in practice the web server after it checked the
+// session or access token. A call carrying a user id without the secret is
+// rejected, calls without a user id pass through as anonymous
+func UnaryServerInterceptor(secret string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(metadataKey); len(values) > 0 {
+				if !validSecret(md.Get(secretMetadataKey), secret) {
+					return nil, status.Error(codes.Unauthenticated, "caller identity from an untrusted peer")
+				}
+				if userId, err := strconv.ParseInt(values[0], 10, 64); err == nil {
+					ctx = WithCallerId(ctx, userId)
+				}
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func validSecret(values []string, secret string) bool {
+	if secret == "" || len(values) != 1 {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(values[0]), []byte(secret)) == 1
+}
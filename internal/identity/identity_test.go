@@ -0,0 +1,45 @@
+package identity
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor("shared-secret")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		userId, ok := CallerIdFromContext(ctx)
+		if !ok {
+			return int64(0), nil
+		}
+		return userId, nil
+	}
+
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		wantId   int64
+		wantCode codes.Code
+	}{
+		{"anonymous", metadata.Pairs(), 0, codes.OK},
+		{"trusted", metadata.Pairs(metadataKey, "42", secretMetadataKey, "shared-secret"), 42, codes.OK},
+		{"missing secret", metadata.Pairs(metadataKey, "42"), 0, codes.Unauthenticated},
+		{"wrong secret", metadata.Pairs(metadataKey, "42", secretMetadataKey, "guess"), 0, codes.Unauthenticated},
+	}
+	for _, test := range tests {
+		ctx := metadata.NewIncomingContext(context.Background(), test.md)
+		got, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+		if code := status.Code(err); code != test.wantCode {
+			t.Errorf("%s: got code %s, want %s", test.name, code, test.wantCode)
+			continue
+		}
+		if err == nil && got.(int64) != test.wantId {
+			t.Errorf("%s: got caller %d, want %d", test.name, got, test.wantId)
+		}
+	}
+}
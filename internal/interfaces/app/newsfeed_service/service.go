@@ -72,7 +72,18 @@ func (nfs *NewsfeedService) GenerateNewsfeed(ctx context.Context, request *newsf
 	}
 
 	var user model.User
-	err = nfs.DB.Preload("Following").Preload("Following.Posts").Find(&user, request.UserId).Error
+	// the feed only holds posts of followed users, so private accounts reach
+	// their approved followers only. Deleted accounts drop out of the feed while
+	// they wait for the purge, blocked users in either direction and muted users
+	// are left out as well. Posts shared with an audience the user is not part
+	// of are skipped
+	err = nfs.DB.Preload("Following", "deactivated_at IS NULL"+
+		" AND id NOT IN (SELECT blocked_id FROM user_block WHERE blocker_id = ?)"+
+		" AND id NOT IN (SELECT blocker_id FROM user_block WHERE blocked_id = ?)"+
+		" AND id NOT IN (SELECT muted_id FROM user_mute WHERE muter_id = ?)",
+		request.UserId, request.UserId, request.UserId).
+		Preload("Following.Posts", model.PostsReadableBy(request.UserId)).
+		Find(&user, request.UserId).Error
 	if err != nil {
 		nfs.Logger.Error("Error retrieving user and following users", zap.Error(err))
 		return nil, err
@@ -0,0 +1,216 @@
+package user_and_post_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// purgeBatchSize bounds how many accounts one purge run handles, the rest wait for the next tick
+const purgeBatchSize = 100
+
+// DeleteAccount deactivates the account right away, its content is only
+// purged once the grace period is over
+func (uaps *UserAndPostService) DeleteAccount(ctx context.Context, request *user_and_post.DeleteAccountRequest) (*user_and_post.DeleteAccountResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.DeleteAccountResponse{Status: user_and_post.DeleteAccountResponse_FORBIDDEN}, nil
+	}
+
+	var user model.User
+	err := uaps.DB.First(&user, request.UserId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.DeleteAccountResponse{Status: user_and_post.DeleteAccountResponse_USER_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// deleting twice keeps the original deadline
+	if user.DeactivatedAt == nil {
+		now := time.Now()
+		if err := uaps.DB.Model(&user).Update("deactivated_at", now).Error; err != nil {
+			return nil, err
+		}
+		user.DeactivatedAt = &now
+		uaps.hideAccountContent(ctx, user.ID)
+		uaps.Logger.Info("account deactivated", zap.Uint("userID", user.ID))
+	}
+
+	return &user_and_post.DeleteAccountResponse{
+		Status:    user_and_post.DeleteAccountResponse_OK,
+		PurgeTime: timestamppb.New(user.DeactivatedAt.Add(uaps.AccountDeletion.GracePeriod)),
+	}, nil
+}
+
+// restoreAccount reactivates an account deleted within the grace period, it
+// is called once a login has fully succeeded
+func (uaps *UserAndPostService) restoreAccount(ctx context.Context, userId uint) error {
+	result := uaps.DB.Model(&model.User{}).
+		Where("id = ? AND deactivated_at IS NOT NULL AND purged_at IS NULL", userId).
+		Update("deactivated_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		uaps.hideAccountContent(ctx, userId)
+		uaps.Logger.Info("account restored", zap.Uint("userID", userId))
+	}
+	return nil
+}
+
+// hideAccountContent drops the cached posts and follower newsfeeds of a user
+// whose account changed state, so they are rebuilt with or without the user.
+// Failures are only logged, the caches expire on their own
+func (uaps *UserAndPostService) hideAccountContent(ctx context.Context, userId uint) {
+	var postIds []int64
+	if err := uaps.DB.Model(&model.Post{}).Where("user_id = ?", userId).Pluck("id", &postIds).Error; err != nil {
+		uaps.Logger.Error("failed to list posts of account", zap.Error(err), zap.Uint("userID", userId))
+	}
+	for _, postId := range postIds {
+		if err := uaps.invalidatePostCache(ctx, postId); err != nil {
+			uaps.Logger.Error("failed to invalidate post cache", zap.Error(err), zap.Int64("PostId", postId))
+		}
+	}
+
+	var followerIds []int64
+	if err := uaps.DB.Table("following").Where("friend_id = ?", userId).Pluck("user_id", &followerIds).Error; err != nil {
+		uaps.Logger.Error("failed to list followers of account", zap.Error(err), zap.Uint("userID", userId))
+	}
+	uaps.invalidateNewsfeeds(ctx, followerIds...)
+}
+
+// PurgeDeactivatedAccounts purges the accounts whose grace period is over,
+// every PurgeInterval until ctx is done
+func (uaps *UserAndPostService) PurgeDeactivatedAccounts(ctx context.Context) {
+	if uaps.AccountDeletion.PurgeInterval <= 0 {
+		uaps.Logger.Warn("account purge disabled, purge_interval is not set")
+		return
+	}
+
+	ticker := time.NewTicker(uaps.AccountDeletion.PurgeInterval)
+	defer ticker.Stop()
+	for {
+		if err := uaps.purgeExpiredAccounts(ctx); err != nil {
+			uaps.Logger.Error("failed to purge deactivated accounts", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (uaps *UserAndPostService) purgeExpiredAccounts(ctx context.Context) error {
+	cutoff := time.Now().Add(-uaps.AccountDeletion.GracePeriod)
+
+	var userIds []uint
+	err := uaps.DB.Model(&model.User{}).
+		Where("deactivated_at < ? AND purged_at IS NULL", cutoff).
+		Limit(purgeBatchSize).
+		Pluck("id", &userIds).Error
+	if err != nil {
+		return err
+	}
+
+	for _, userId := range userIds {
+		if err := uaps.purgeAccount(ctx, userId, cutoff); err != nil {
+			return fmt.Errorf("purge account %d: %w", userId, err)
+		}
+	}
+	return nil
+}
+
+// purgeAccount hard-deletes the content of the user and anonymizes the user
+// row, the row itself stays because reports keep referencing it
+func (uaps *UserAndPostService) purgeAccount(ctx context.Context, userId uint, cutoff time.Time) error {
+	var postIds []int64
+	var exportPaths []string
+	purged := false
+	err := uaps.DB.Transaction(func(tx *gorm.DB) error {
+		// anonymize first, the condition locks the row and skips accounts
+		// restored since they were listed
+		result := tx.Model(&model.User{}).
+			Where("id = ? AND deactivated_at < ? AND purged_at IS NULL", userId, cutoff).
+			Updates(map[string]interface{}{
+				"user_name":         fmt.Sprintf("deleted_%d", userId),
+				"email":             fmt.Sprintf("deleted_%d@invalid", userId),
+				"hashed_password":   "",
+				"salt":              "",
+				"first_name":        "",
+				"last_name":         "",
+				"date_of_birth":     nil,
+				"email_verified_at": nil,
+				"bio":               "",
+				"avatar_path":       "",
+				"location":          "",
+				"website":           "",
+				"pronouns":          "",
+				"purged_at":         time.Now(),
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		purged = true
+
+		if err := tx.Unscoped().Model(&model.Post{}).Where("user_id = ?", userId).Pluck("id", &postIds).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.DataExport{}).Where("user_id = ? AND file_path <> ''", userId).Pluck("file_path", &exportPaths).Error; err != nil {
+			return err
+		}
+
+		// children before parents, the tables are linked by foreign keys
+		deletes := []struct {
+			model interface{}
+			query string
+			args  []interface{}
+		}{
+			{&model.Like{}, "user_id = ? OR post_id IN ?", []interface{}{userId, postIds}},
+			{&model.Comment{}, "user_id = ? OR post_id IN ?", []interface{}{userId, postIds}},
+			{&model.Report{}, "post_id IN ?", []interface{}{postIds}},
+			{&model.PostRevision{}, "post_id IN ? OR editor_id = ?", []interface{}{postIds, userId}},
+			{&model.Post{}, "user_id = ?", []interface{}{userId}},
+			{&model.TwoFactor{}, "user_id = ?", []interface{}{userId}},
+			{&model.RecoveryCode{}, "user_id = ?", []interface{}{userId}},
+			{&model.UserToken{}, "user_id = ?", []interface{}{userId}},
+			{&model.IdentityLink{}, "user_id = ?", []interface{}{userId}},
+			{&model.UserRole{}, "user_id = ?", []interface{}{userId}},
+			{&model.DataExport{}, "user_id = ?", []interface{}{userId}},
+			{&model.UserNameHistory{}, "user_id = ?", []interface{}{userId}},
+			{&model.Block{}, "blocker_id = ? OR blocked_id = ?", []interface{}{userId, userId}},
+			{&model.Mute{}, "muter_id = ? OR muted_id = ?", []interface{}{userId, userId}},
+			{&model.FollowRequest{}, "requester_id = ? OR target_id = ?", []interface{}{userId, userId}},
+			{&model.CloseFriend{}, "user_id = ? OR friend_id = ?", []interface{}{userId, userId}},
+		}
+		for _, d := range deletes {
+			if err := tx.Unscoped().Where(d.query, d.args...).Delete(d.model).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Exec("DELETE FROM following WHERE user_id = ? OR friend_id = ?", userId, userId).Error
+	})
+	if err != nil || !purged {
+		return err
+	}
+
+	for _, postId := range postIds {
+		if err := uaps.invalidatePostCache(ctx, postId); err != nil {
+			uaps.Logger.Error("failed to invalidate post cache", zap.Error(err), zap.Int64("PostId", postId))
+		}
+	}
+	for _, path := range exportPaths {
+		if err := removeDataExportFile(path); err != nil {
+			uaps.Logger.Error("failed to remove data export", zap.Error(err), zap.String("path", path))
+		}
+	}
+	uaps.Logger.Info("account purged", zap.Uint("userID", userId), zap.Int("posts", len(postIds)))
+	return nil
+}
@@ -0,0 +1,169 @@
+package user_and_post_service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/khailequang334/social_network/configs"
+	"github.com/khailequang334/social_network/internal/identity"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"github.com/khailequang334/social_network/internal/password"
+	"go.uber.org/zap"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newTestService runs the service against an in-memory sqlite database and miniredis
+func newTestService(t *testing.T) *UserAndPostService {
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())), &gorm.Config{
+		TranslateError: true,
+		Logger:         logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.AutoMigrate(
+		&model.User{}, &model.Post{}, &model.Like{}, &model.Comment{}, &model.Report{}, &model.PostRevision{},
+		&model.TwoFactor{}, &model.RecoveryCode{}, &model.UserToken{}, &model.IdentityLink{},
+		&model.Role{}, &model.Permission{}, &model.UserRole{}, &model.DataExport{}, &model.UserNameHistory{},
+		&model.Block{}, &model.Mute{}, &model.FollowRequest{}, &model.CloseFriend{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	mr := miniredis.RunT(t)
+	rd := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rd.Close() })
+
+	return &UserAndPostService{
+		DB:     db,
+		Redis:  rd,
+		Logger: zap.NewNop(),
+		LoginProtection: configs.LoginProtectionConfig{
+			FailureWindow:    time.Hour,
+			LockoutThreshold: 10,
+			LockoutDuration:  time.Hour,
+		},
+		Passwords: password.NewBcryptHasher(4),
+	}
+}
+
+func TestAuthenticatePurgedAccount(t *testing.T) {
+	uaps := newTestService(t)
+	ctx := context.Background()
+
+	hashedPassword, err := uaps.Passwords.Hash("correct horse battery staple")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deactivatedAt := time.Now().Add(-48 * time.Hour)
+	user := model.User{
+		HashedPassword: hashedPassword,
+		FirstName:      "Alice",
+		LastName:       "Doe",
+		Email:          "alice@example.com",
+		UserName:       "alice",
+		DeactivatedAt:  &deactivatedAt,
+	}
+	if err := uaps.DB.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := uaps.purgeAccount(ctx, user.ID, time.Now().Add(-24*time.Hour)); err != nil {
+		t.Fatalf("purgeAccount: %v", err)
+	}
+
+	var purged model.User
+	if err := uaps.DB.First(&purged, user.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if purged.PurgedAt == nil || purged.HashedPassword != "" {
+		t.Fatalf("account is not purged: %+v", purged)
+	}
+
+	for _, userName := range []string{"alice", purged.UserName} {
+		response, err := uaps.AuthenticateUser(ctx, &user_and_post.AuthenticateUserRequest{
+			UserName:     userName,
+			UserPassword: "",
+			ClientIp:     "192.0.2.1",
+		})
+		if err != nil {
+			t.Fatalf("AuthenticateUser %s: %v", userName, err)
+		}
+		if response.GetStatus() != user_and_post.AuthenticateUserResponse_USER_NOT_FOUND {
+			t.Errorf("AuthenticateUser %s returned %s, want USER_NOT_FOUND", userName, response.GetStatus())
+		}
+	}
+}
+
+func TestGetCachedPostOfDeactivatedAccount(t *testing.T) {
+	uaps := newTestService(t)
+	ctx := context.Background()
+
+	user := model.User{FirstName: "Bob", Email: "bob@example.com", UserName: "bob"}
+	if err := uaps.DB.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	post := model.Post{UserID: user.ID, ContentText: "hello", Audience: model.AudiencePublic}
+	if err := uaps.DB.Create(&post).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	request := &user_and_post.GetPostRequest{PostId: int64(post.ID)}
+	response, err := uaps.GetPost(ctx, request)
+	if err != nil {
+		t.Fatalf("GetPost: %v", err)
+	}
+	if response.GetStatus() != user_and_post.GetPostResponse_OK {
+		t.Fatalf("GetPost returned %s before the deactivation", response.GetStatus())
+	}
+
+	// deactivate without dropping the cached post, as when the invalidation fails
+	if err := uaps.DB.Model(&user).Update("deactivated_at", time.Now()).Error; err != nil {
+		t.Fatal(err)
+	}
+	response, err = uaps.GetPost(ctx, request)
+	if err != nil {
+		t.Fatalf("GetPost: %v", err)
+	}
+	if response.GetStatus() != user_and_post.GetPostResponse_POST_NOT_FOUND {
+		t.Errorf("GetPost returned %s for a post of a deactivated account, want POST_NOT_FOUND", response.GetStatus())
+	}
+}
+
+func TestCloseFriendDeactivatedAccount(t *testing.T) {
+	uaps := newTestService(t)
+
+	deactivatedAt := time.Now()
+	alice := model.User{FirstName: "Alice", Email: "alice@example.com", UserName: "alice"}
+	bob := model.User{FirstName: "Bob", Email: "bob@example.com", UserName: "bob", DeactivatedAt: &deactivatedAt}
+	if err := uaps.DB.Create(&alice).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := uaps.DB.Create(&bob).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := identity.WithCallerId(context.Background(), int64(alice.ID))
+	response, err := uaps.AddCloseFriend(ctx, &user_and_post.AddCloseFriendRequest{
+		UserId:       int64(alice.ID),
+		TargetUserId: int64(bob.ID),
+	})
+	if err != nil {
+		t.Fatalf("AddCloseFriend: %v", err)
+	}
+	if response.GetStatus() != user_and_post.AddCloseFriendResponse_USER_NOT_FOUND {
+		t.Errorf("AddCloseFriend returned %s for a deactivated account, want USER_NOT_FOUND", response.GetStatus())
+	}
+}
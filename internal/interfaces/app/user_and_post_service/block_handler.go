@@ -0,0 +1,221 @@
+package user_and_post_service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/khailequang334/social_network/internal/identity"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BlockUser also removes the follow edges, pending follow requests and close
+// friends entries between the two users, in both directions
+func (uaps *UserAndPostService) BlockUser(ctx context.Context, request *user_and_post.BlockUserRequest) (*user_and_post.BlockUserResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.BlockUserResponse{Status: user_and_post.BlockUserResponse_FORBIDDEN}, nil
+	}
+	if request.GetUserId() == request.GetTargetUserId() {
+		return &user_and_post.BlockUserResponse{Status: user_and_post.BlockUserResponse_INVALID_TARGET}, nil
+	}
+	if err := uaps.ensureUserExist(request.GetTargetUserId()); err != nil {
+		return &user_and_post.BlockUserResponse{Status: user_and_post.BlockUserResponse_USER_NOT_FOUND}, nil
+	}
+
+	created := false
+	err := uaps.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.Block{
+			BlockerID: uint(request.GetUserId()),
+			BlockedID: uint(request.GetTargetUserId()),
+		})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		created = true
+		err := tx.Where("(requester_id = ? AND target_id = ?) OR (requester_id = ? AND target_id = ?)",
+			request.GetUserId(), request.GetTargetUserId(), request.GetTargetUserId(), request.GetUserId()).Delete(&model.FollowRequest{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("(user_id = ? AND friend_id = ?) OR (user_id = ? AND friend_id = ?)",
+			request.GetUserId(), request.GetTargetUserId(), request.GetTargetUserId(), request.GetUserId()).Delete(&model.CloseFriend{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Exec("DELETE FROM following WHERE (user_id = ? AND friend_id = ?) OR (user_id = ? AND friend_id = ?)",
+			request.GetUserId(), request.GetTargetUserId(), request.GetTargetUserId(), request.GetUserId()).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		return &user_and_post.BlockUserResponse{Status: user_and_post.BlockUserResponse_ALREADY_BLOCKED}, nil
+	}
+
+	uaps.invalidateNewsfeeds(ctx, request.GetUserId(), request.GetTargetUserId())
+	uaps.markSuggestionsStale(ctx, request.GetUserId(), request.GetTargetUserId())
+	return &user_and_post.BlockUserResponse{Status: user_and_post.BlockUserResponse_OK}, nil
+}
+
+// UnblockUser does not restore the follow edges removed by the block
+func (uaps *UserAndPostService) UnblockUser(ctx context.Context, request *user_and_post.UnblockUserRequest) (*user_and_post.UnblockUserResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.UnblockUserResponse{Status: user_and_post.UnblockUserResponse_FORBIDDEN}, nil
+	}
+
+	result := uaps.DB.Where("blocker_id = ? AND blocked_id = ?", request.GetUserId(), request.GetTargetUserId()).Delete(&model.Block{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &user_and_post.UnblockUserResponse{Status: user_and_post.UnblockUserResponse_NOT_BLOCKED}, nil
+	}
+
+	uaps.invalidateNewsfeeds(ctx, request.GetUserId(), request.GetTargetUserId())
+	uaps.markSuggestionsStale(ctx, request.GetUserId(), request.GetTargetUserId())
+	return &user_and_post.UnblockUserResponse{Status: user_and_post.UnblockUserResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) ListBlockedUsers(ctx context.Context, request *user_and_post.ListBlockedUsersRequest) (*user_and_post.ListBlockedUsersResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.ListBlockedUsersResponse{Status: user_and_post.ListBlockedUsersResponse_FORBIDDEN}, nil
+	}
+
+	users, err := uaps.listRelatedUsers("user_block", "blocker_id", "blocked_id", request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &user_and_post.ListBlockedUsersResponse{Status: user_and_post.ListBlockedUsersResponse_OK, Users: users}, nil
+}
+
+func (uaps *UserAndPostService) MuteUser(ctx context.Context, request *user_and_post.MuteUserRequest) (*user_and_post.MuteUserResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.MuteUserResponse{Status: user_and_post.MuteUserResponse_FORBIDDEN}, nil
+	}
+	if request.GetUserId() == request.GetTargetUserId() {
+		return &user_and_post.MuteUserResponse{Status: user_and_post.MuteUserResponse_INVALID_TARGET}, nil
+	}
+	if err := uaps.ensureUserExist(request.GetTargetUserId()); err != nil {
+		return &user_and_post.MuteUserResponse{Status: user_and_post.MuteUserResponse_USER_NOT_FOUND}, nil
+	}
+
+	result := uaps.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.Mute{
+		MuterID: uint(request.GetUserId()),
+		MutedID: uint(request.GetTargetUserId()),
+	})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &user_and_post.MuteUserResponse{Status: user_and_post.MuteUserResponse_ALREADY_MUTED}, nil
+	}
+
+	uaps.invalidateNewsfeeds(ctx, request.GetUserId())
+	return &user_and_post.MuteUserResponse{Status: user_and_post.MuteUserResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) UnmuteUser(ctx context.Context, request *user_and_post.UnmuteUserRequest) (*user_and_post.UnmuteUserResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.UnmuteUserResponse{Status: user_and_post.UnmuteUserResponse_FORBIDDEN}, nil
+	}
+
+	result := uaps.DB.Where("muter_id = ? AND muted_id = ?", request.GetUserId(), request.GetTargetUserId()).Delete(&model.Mute{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &user_and_post.UnmuteUserResponse{Status: user_and_post.UnmuteUserResponse_NOT_MUTED}, nil
+	}
+
+	uaps.invalidateNewsfeeds(ctx, request.GetUserId())
+	return &user_and_post.UnmuteUserResponse{Status: user_and_post.UnmuteUserResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) ListMutedUsers(ctx context.Context, request *user_and_post.ListMutedUsersRequest) (*user_and_post.ListMutedUsersResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.ListMutedUsersResponse{Status: user_and_post.ListMutedUsersResponse_FORBIDDEN}, nil
+	}
+
+	users, err := uaps.listRelatedUsers("user_mute", "muter_id", "muted_id", request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &user_and_post.ListMutedUsersResponse{Status: user_and_post.ListMutedUsersResponse_OK, Users: users}, nil
+}
+
+// listRelatedUsers lists the users on the target side of a relation table, newest first
+func (uaps *UserAndPostService) listRelatedUsers(table string, ownerColumn string, targetColumn string, userId int64) ([]*user_and_post.RelatedUser, error) {
+	var rows []struct {
+		UserId    int64
+		UserName  string
+		CreatedAt time.Time
+	}
+	err := uaps.DB.Table(table).
+		Select("user.id AS user_id, user.user_name, "+table+".created_at").
+		Joins("JOIN user ON user.id = "+table+"."+targetColumn).
+		Where(table+"."+ownerColumn+" = ?", userId).
+		Order(table + ".created_at DESC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*user_and_post.RelatedUser, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, &user_and_post.RelatedUser{
+			UserId:    row.UserId,
+			UserName:  row.UserName,
+			SinceTime: timestamppb.New(row.CreatedAt),
+		})
+	}
+	return users, nil
+}
+
+// isBlockedBetween reports whether either user blocked the other
+func (uaps *UserAndPostService) isBlockedBetween(userId int64, otherUserId int64) (bool, error) {
+	var count int64
+	err := uaps.DB.Model(&model.Block{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userId, otherUserId, otherUserId, userId).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// hiddenFromCaller reports whether content of the author must be hidden from
+// the caller of the rpc, because of a block or because the author is private
+// and the caller does not follow them. Anonymous callers only see public accounts
+func (uaps *UserAndPostService) hiddenFromCaller(ctx context.Context, authorId int64) (bool, error) {
+	callerId, ok := identity.CallerIdFromContext(ctx)
+	if ok && callerId == authorId {
+		return false, nil
+	}
+
+	var author model.User
+	if err := uaps.DB.Select("id", "private").First(&author, authorId).Error; err != nil {
+		return false, err
+	}
+	if !ok {
+		return author.Private, nil
+	}
+	if author.Private {
+		following, err := uaps.isFollowing(callerId, authorId)
+		if err != nil || !following {
+			return !following, err
+		}
+	}
+	return uaps.isBlockedBetween(callerId, authorId)
+}
+
+// invalidateNewsfeeds drops cached newsfeeds so they are rebuilt with the
+// current relations, failures are only logged as the caches expire on their own
+func (uaps *UserAndPostService) invalidateNewsfeeds(ctx context.Context, userIds ...int64) {
+	for _, userId := range userIds {
+		if err := uaps.Redis.Del(ctx, "newsfeed:"+strconv.FormatInt(userId, 10)).Err(); err != nil {
+			uaps.Logger.Error("failed to invalidate newsfeed cache", zap.Error(err), zap.Int64("UserId", userId))
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package user_and_post_service
+
+import (
+	"context"
+
+	"github.com/khailequang334/social_network/internal/authz"
+	"github.com/khailequang334/social_network/internal/identity"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MaxBulkFollowEdges bounds one BulkFollow or BulkUnfollow call
+const MaxBulkFollowEdges = 1000
+
+type followPair struct {
+	followerId int64
+	followeeId int64
+}
+
+func (uaps *UserAndPostService) BulkFollow(ctx context.Context, request *user_and_post.BulkFollowRequest) (*user_and_post.BulkFollowResponse, error) {
+	return uaps.bulkFollow(ctx, request, false)
+}
+
+func (uaps *UserAndPostService) BulkUnfollow(ctx context.Context, request *user_and_post.BulkFollowRequest) (*user_and_post.BulkFollowResponse, error) {
+	return uaps.bulkFollow(ctx, request, true)
+}
+
+func (uaps *UserAndPostService) bulkFollow(ctx context.Context, request *user_and_post.BulkFollowRequest, unfollow bool) (*user_and_post.BulkFollowResponse, error) {
+	if len(request.GetEdges()) > MaxBulkFollowEdges {
+		return &user_and_post.BulkFollowResponse{Status: user_and_post.BulkFollowResponse_TOO_MANY_EDGES}, nil
+	}
+
+	// users may bulk edit their own follows, anything else is a migration
+	callerId, _ := identity.CallerIdFromContext(ctx)
+	ownEdges := true
+	for _, edge := range request.GetEdges() {
+		if edge.GetFollowerId() != callerId {
+			ownEdges = false
+			break
+		}
+	}
+	if !ownEdges {
+		allowed, err := uaps.callerHasPermission(ctx, authz.PermissionBulkFollow)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return &user_and_post.BulkFollowResponse{Status: user_and_post.BulkFollowResponse_FORBIDDEN}, nil
+		}
+	}
+
+	results, err := uaps.ApplyFollowEdges(ctx, request.GetEdges(), unfollow)
+	if err != nil {
+		return nil, err
+	}
+	return &user_and_post.BulkFollowResponse{Status: user_and_post.BulkFollowResponse_OK, Results: results}, nil
+}
+
+// ApplyFollowEdges follows, or unfollows, every edge in one transaction and
+// reports the outcome of each. It reads the existing edges and blocks of the
+// whole batch with one query each, FollowUser and UnfollowUser call it with a
+// single edge. Follows of private accounts become follow requests
+func (uaps *UserAndPostService) ApplyFollowEdges(ctx context.Context, edges []*user_and_post.FollowEdge, unfollow bool) ([]*user_and_post.FollowEdgeResult, error) {
+	results := make([]*user_and_post.FollowEdgeResult, len(edges))
+	if len(edges) == 0 {
+		return results, nil
+	}
+
+	seenIds := make(map[int64]bool)
+	userIds := make([]int64, 0, 2*len(edges))
+	for _, edge := range edges {
+		for _, id := range []int64{edge.GetFollowerId(), edge.GetFolloweeId()} {
+			if !seenIds[id] {
+				seenIds[id] = true
+				userIds = append(userIds, id)
+			}
+		}
+	}
+
+	var changed, requests []followPair
+	err := uaps.DB.Transaction(func(tx *gorm.DB) error {
+		changed, requests = nil, nil
+
+		var users []model.User
+		if err := tx.Select("id", "private").Where("id IN ? AND deactivated_at IS NULL AND purged_at IS NULL", userIds).Find(&users).Error; err != nil {
+			return err
+		}
+		private := make(map[int64]bool, len(users))
+		for _, user := range users {
+			private[int64(user.ID)] = user.Private
+		}
+
+		existing, err := pluckPairs(tx.Table("following"), "user_id", "friend_id", userIds)
+		if err != nil {
+			return err
+		}
+		blocks, err := pluckPairs(tx.Model(&model.Block{}), "blocker_id", "blocked_id", userIds)
+		if err != nil {
+			return err
+		}
+
+		var follows []followPair
+		for i, edge := range edges {
+			pair := followPair{followerId: edge.GetFollowerId(), followeeId: edge.GetFolloweeId()}
+			outcome := user_and_post.FollowEdgeResult_APPLIED
+			_, followerFound := private[pair.followerId]
+			followeePrivate, followeeFound := private[pair.followeeId]
+			switch {
+			case pair.followerId == pair.followeeId:
+				outcome = user_and_post.FollowEdgeResult_INVALID_EDGE
+			case !followerFound || !followeeFound:
+				outcome = user_and_post.FollowEdgeResult_USER_NOT_FOUND
+			case unfollow && !existing[pair]:
+				outcome = user_and_post.FollowEdgeResult_ALREADY_APPLIED
+			case unfollow:
+				changed = append(changed, pair)
+			case existing[pair]:
+				outcome = user_and_post.FollowEdgeResult_ALREADY_APPLIED
+			case blocks[pair] || blocks[followPair{followerId: pair.followeeId, followeeId: pair.followerId}]:
+				outcome = user_and_post.FollowEdgeResult_BLOCKED
+			case followeePrivate:
+				outcome = user_and_post.FollowEdgeResult_REQUESTED
+				requests = append(requests, pair)
+			default:
+				follows = append(follows, pair)
+				changed = append(changed, pair)
+			}
+			// a repeated edge only applies once
+			if outcome == user_and_post.FollowEdgeResult_APPLIED {
+				existing[pair] = !unfollow
+			}
+			results[i] = &user_and_post.FollowEdgeResult{Edge: edge, Outcome: outcome}
+		}
+
+		if unfollow {
+			if len(changed) == 0 {
+				return nil
+			}
+			return tx.Exec("DELETE FROM following WHERE (user_id, friend_id) IN ?", pairValues(changed)).Error
+		}
+		if len(follows) > 0 {
+			rows := make([]map[string]interface{}, 0, len(follows))
+			for _, pair := range follows {
+				rows = append(rows, map[string]interface{}{"user_id": pair.followerId, "friend_id": pair.followeeId})
+			}
+			if err := tx.Table("following").Create(rows).Error; err != nil {
+				return err
+			}
+		}
+		if len(requests) > 0 {
+			rows := make([]model.FollowRequest, 0, len(requests))
+			for _, pair := range requests {
+				rows = append(rows, model.FollowRequest{RequesterID: uint(pair.followerId), TargetID: uint(pair.followeeId)})
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the followers' newsfeeds change, suggestions skip followed and
+	// requested users on one side and followers on the other
+	followerIds := make([]int64, 0, len(changed))
+	staleIds := make([]int64, 0, 2*len(changed)+len(requests))
+	for _, pair := range changed {
+		followerIds = append(followerIds, pair.followerId)
+		staleIds = append(staleIds, pair.followerId, pair.followeeId)
+	}
+	for _, pair := range requests {
+		staleIds = append(staleIds, pair.followerId)
+	}
+	uaps.invalidateNewsfeeds(ctx, followerIds...)
+	uaps.markSuggestionsStale(ctx, staleIds...)
+	uaps.Logger.Info("bulk follow edges applied",
+		zap.Bool("unfollow", unfollow),
+		zap.Int("edges", len(edges)),
+		zap.Int("changed", len(changed)))
+	return results, nil
+}
+
+// pluckPairs returns the (from, to) pairs of a relation table between the given users
+func pluckPairs(query *gorm.DB, fromColumn string, toColumn string, userIds []int64) (map[followPair]bool, error) {
+	var rows []struct {
+		FromId int64
+		ToId   int64
+	}
+	err := query.Select(fromColumn+" AS from_id, "+toColumn+" AS to_id").
+		Where(fromColumn+" IN ? AND "+toColumn+" IN ?", userIds, userIds).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	pairs := make(map[followPair]bool, len(rows))
+	for _, row := range rows {
+		pairs[followPair{followerId: row.FromId, followeeId: row.ToId}] = true
+	}
+	return pairs, nil
+}
+
+func pairValues(pairs []followPair) [][]interface{} {
+	values := make([][]interface{}, 0, len(pairs))
+	for _, pair := range pairs {
+		values = append(values, []interface{}{pair.followerId, pair.followeeId})
+	}
+	return values
+}
@@ -0,0 +1,80 @@
+package user_and_post_service
+
+import (
+	"context"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"gorm.io/gorm/clause"
+)
+
+// AddCloseFriend shares the posts of the user with the close friends audience
+// with the target, the target does not have to follow the user
+func (uaps *UserAndPostService) AddCloseFriend(ctx context.Context, request *user_and_post.AddCloseFriendRequest) (*user_and_post.AddCloseFriendResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.AddCloseFriendResponse{Status: user_and_post.AddCloseFriendResponse_FORBIDDEN}, nil
+	}
+	if request.GetUserId() == request.GetTargetUserId() {
+		return &user_and_post.AddCloseFriendResponse{Status: user_and_post.AddCloseFriendResponse_INVALID_TARGET}, nil
+	}
+	if err := uaps.ensureUserExist(request.GetTargetUserId()); err != nil {
+		return &user_and_post.AddCloseFriendResponse{Status: user_and_post.AddCloseFriendResponse_USER_NOT_FOUND}, nil
+	}
+	blocked, err := uaps.isBlockedBetween(request.GetUserId(), request.GetTargetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return &user_and_post.AddCloseFriendResponse{Status: user_and_post.AddCloseFriendResponse_BLOCKED}, nil
+	}
+
+	result := uaps.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.CloseFriend{
+		UserID:   uint(request.GetUserId()),
+		FriendID: uint(request.GetTargetUserId()),
+	})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &user_and_post.AddCloseFriendResponse{Status: user_and_post.AddCloseFriendResponse_ALREADY_CLOSE_FRIEND}, nil
+	}
+
+	uaps.invalidateNewsfeeds(ctx, request.GetTargetUserId())
+	return &user_and_post.AddCloseFriendResponse{Status: user_and_post.AddCloseFriendResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) RemoveCloseFriend(ctx context.Context, request *user_and_post.RemoveCloseFriendRequest) (*user_and_post.RemoveCloseFriendResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.RemoveCloseFriendResponse{Status: user_and_post.RemoveCloseFriendResponse_FORBIDDEN}, nil
+	}
+
+	result := uaps.DB.Where("user_id = ? AND friend_id = ?", request.GetUserId(), request.GetTargetUserId()).Delete(&model.CloseFriend{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &user_and_post.RemoveCloseFriendResponse{Status: user_and_post.RemoveCloseFriendResponse_NOT_CLOSE_FRIEND}, nil
+	}
+
+	uaps.invalidateNewsfeeds(ctx, request.GetTargetUserId())
+	return &user_and_post.RemoveCloseFriendResponse{Status: user_and_post.RemoveCloseFriendResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) ListCloseFriends(ctx context.Context, request *user_and_post.ListCloseFriendsRequest) (*user_and_post.ListCloseFriendsResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.ListCloseFriendsResponse{Status: user_and_post.ListCloseFriendsResponse_FORBIDDEN}, nil
+	}
+
+	users, err := uaps.listRelatedUsers("close_friend", "user_id", "friend_id", request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &user_and_post.ListCloseFriendsResponse{Status: user_and_post.ListCloseFriendsResponse_OK, Users: users}, nil
+}
+
+// isCloseFriend reports whether the user shares close friends posts with the friend
+func (uaps *UserAndPostService) isCloseFriend(userId int64, friendId int64) (bool, error) {
+	var count int64
+	err := uaps.DB.Model(&model.CloseFriend{}).Where("user_id = ? AND friend_id = ?", userId, friendId).Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,54 @@
+package user_and_post_service
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// pageCursor is the position of the last row of a page in a list sorted by
+// time then id, both descending. It is handed out base64 encoded so clients
+// treat it as opaque
+type pageCursor struct {
+	Time time.Time
+	Id   int64
+}
+
+func (cursor pageCursor) encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", cursor.Time.UnixNano(), cursor.Id)))
+}
+
+// decodePageCursor returns nil for an empty cursor, the first page
+func decodePageCursor(encoded string) (*pageCursor, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	var nanos, id int64
+	if _, err := fmt.Sscanf(string(raw), "%d:%d", &nanos, &id); err != nil {
+		return nil, errInvalidCursor
+	}
+	return &pageCursor{Time: time.Unix(0, nanos), Id: id}, nil
+}
+
+// pageLimit clamps a requested page size, zero or less asks for the default
+func pageLimit(requested int32, defaultSize int, maxSize int) int {
+	if requested <= 0 {
+		return defaultSize
+	} else if int(requested) > maxSize {
+		return maxSize
+	}
+	return int(requested)
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
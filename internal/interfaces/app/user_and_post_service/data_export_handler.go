@@ -0,0 +1,392 @@
+package user_and_post_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"github.com/khailequang334/social_network/internal/takeout"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	dataExportChunkSize = 64 * 1024
+	// an export still running after this long is assumed to have died with its process
+	dataExportRunTimeout = time.Hour
+)
+
+// RequestDataExport queues a takeout archive, the archive is built in the background
+func (uaps *UserAndPostService) RequestDataExport(ctx context.Context, request *user_and_post.RequestDataExportRequest) (*user_and_post.RequestDataExportResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.RequestDataExportResponse{Status: user_and_post.RequestDataExportResponse_FORBIDDEN}, nil
+	}
+
+	var export model.DataExport
+	err := uaps.DB.Where("user_id = ? AND state IN ?", request.GetUserId(), []string{model.DataExportStatePending, model.DataExportStateRunning}).
+		Order("id DESC").First(&export).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		export = model.DataExport{UserID: uint(request.GetUserId()), State: model.DataExportStatePending}
+		if err := uaps.DB.Create(&export).Error; err != nil {
+			return nil, err
+		}
+		uaps.queueDataExports()
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &user_and_post.RequestDataExportResponse{
+		Status:   user_and_post.RequestDataExportResponse_OK,
+		ExportId: int64(export.ID),
+	}, nil
+}
+
+func (uaps *UserAndPostService) GetDataExport(ctx context.Context, request *user_and_post.GetDataExportRequest) (*user_and_post.GetDataExportResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.GetDataExportResponse{Status: user_and_post.GetDataExportResponse_FORBIDDEN}, nil
+	}
+
+	var export model.DataExport
+	err := uaps.DB.Where("id = ? AND user_id = ?", request.GetExportId(), request.GetUserId()).First(&export).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.GetDataExportResponse{Status: user_and_post.GetDataExportResponse_EXPORT_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &user_and_post.GetDataExportResponse{
+		Status: user_and_post.GetDataExportResponse_OK,
+		Export: toDataExportInfo(&export),
+	}, nil
+}
+
+// DownloadDataExport streams the archive of a ready export in chunks after a first status message
+func (uaps *UserAndPostService) DownloadDataExport(request *user_and_post.DownloadDataExportRequest, stream user_and_post.UserAndPost_DownloadDataExportServer) error {
+	sendStatus := func(status user_and_post.DownloadDataExportResponse_DownloadDataExportStatus) error {
+		return stream.Send(&user_and_post.DownloadDataExportResponse{Status: status})
+	}
+
+	if !isCaller(stream.Context(), request.UserId) {
+		return sendStatus(user_and_post.DownloadDataExportResponse_FORBIDDEN)
+	}
+
+	var export model.DataExport
+	err := uaps.DB.Where("id = ? AND user_id = ?", request.GetExportId(), request.GetUserId()).First(&export).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return sendStatus(user_and_post.DownloadDataExportResponse_EXPORT_NOT_FOUND)
+	} else if err != nil {
+		return err
+	}
+	switch toDataExportInfo(&export).GetState() {
+	case user_and_post.DataExportInfo_READY:
+	case user_and_post.DataExportInfo_EXPIRED:
+		return sendStatus(user_and_post.DownloadDataExportResponse_EXPORT_NOT_FOUND)
+	default:
+		return sendStatus(user_and_post.DownloadDataExportResponse_NOT_READY)
+	}
+
+	file, err := os.Open(export.FilePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := sendStatus(user_and_post.DownloadDataExportResponse_OK); err != nil {
+		return err
+	}
+	buf := make([]byte, dataExportChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&user_and_post.DownloadDataExportResponse{Chunk: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func toDataExportInfo(export *model.DataExport) *user_and_post.DataExportInfo {
+	info := &user_and_post.DataExportInfo{
+		ExportId:    int64(export.ID),
+		CreatedTime: timestamppb.New(export.CreatedAt),
+		SizeBytes:   export.SizeBytes,
+	}
+	switch export.State {
+	case model.DataExportStatePending:
+		info.State = user_and_post.DataExportInfo_PENDING
+	case model.DataExportStateRunning:
+		info.State = user_and_post.DataExportInfo_RUNNING
+	case model.DataExportStateReady:
+		info.State = user_and_post.DataExportInfo_READY
+		if export.FilePath == "" || (export.ExpiresAt != nil && export.ExpiresAt.Before(time.Now())) {
+			info.State = user_and_post.DataExportInfo_EXPIRED
+		}
+	default:
+		info.State = user_and_post.DataExportInfo_FAILED
+	}
+	if export.CompletedAt != nil {
+		info.CompletedTime = timestamppb.New(*export.CompletedAt)
+	}
+	if export.ExpiresAt != nil {
+		info.ExpiresTime = timestamppb.New(*export.ExpiresAt)
+	}
+	return info
+}
+
+// queueDataExports wakes up ProcessDataExports without waiting for the next poll
+func (uaps *UserAndPostService) queueDataExports() {
+	select {
+	case uaps.dataExportQueued <- struct{}{}:
+	default:
+	}
+}
+
+// ProcessDataExports builds pending exports and deletes expired archives,
+// whenever one is requested and every PollInterval until ctx is done
+func (uaps *UserAndPostService) ProcessDataExports(ctx context.Context) {
+	if uaps.DataExport.PollInterval <= 0 {
+		uaps.Logger.Warn("data exports disabled, poll_interval is not set")
+		return
+	}
+
+	ticker := time.NewTicker(uaps.DataExport.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := uaps.runPendingDataExports(ctx); err != nil {
+			uaps.Logger.Error("failed to run data exports", zap.Error(err))
+		}
+		if err := uaps.cleanUpDataExports(); err != nil {
+			uaps.Logger.Error("failed to clean up data exports", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-uaps.dataExportQueued:
+		}
+	}
+}
+
+func (uaps *UserAndPostService) runPendingDataExports(ctx context.Context) error {
+	for ctx.Err() == nil {
+		var export model.DataExport
+		err := uaps.DB.Where("state = ?", model.DataExportStatePending).Order("id").First(&export).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		// claim the export, another replica may have picked it up already
+		result := uaps.DB.Model(&model.DataExport{}).
+			Where("id = ? AND state = ?", export.ID, model.DataExportStatePending).
+			Update("state", model.DataExportStateRunning)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		if err := uaps.buildDataExport(&export); err != nil {
+			uaps.Logger.Error("failed to build data export", zap.Error(err), zap.Uint("exportID", export.ID))
+			if err := uaps.DB.Model(&export).Update("state", model.DataExportStateFailed).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return ctx.Err()
+}
+
+func (uaps *UserAndPostService) buildDataExport(export *model.DataExport) error {
+	archive, err := uaps.collectArchive(export.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(uaps.DataExport.Dir, 0o700); err != nil {
+		return err
+	}
+	path := filepath.Join(uaps.DataExport.Dir, fmt.Sprintf("export-%d-%d.json", export.UserID, export.ID))
+	// written next to the final name and renamed, a download never sees half an archive
+	file, err := os.CreateTemp(uaps.DataExport.Dir, "export-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if err := takeout.Write(file, archive); err != nil {
+		file.Close()
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(file.Name(), path); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(uaps.DataExport.TTL)
+	return uaps.DB.Model(export).Updates(map[string]interface{}{
+		"state":        model.DataExportStateReady,
+		"file_path":    path,
+		"size_bytes":   info.Size(),
+		"completed_at": now,
+		"expires_at":   expiresAt,
+	}).Error
+}
+
+// collectArchive reads everything stored about the user into a takeout archive
+func (uaps *UserAndPostService) collectArchive(userId uint) (*takeout.Archive, error) {
+	var user model.User
+	if err := uaps.DB.First(&user, userId).Error; err != nil {
+		return nil, err
+	}
+
+	archive := &takeout.Archive{
+		FormatVersion: takeout.FormatVersion,
+		ExportedAt:    time.Now(),
+		Profile: takeout.Profile{
+			UserId:          int64(user.ID),
+			UserName:        user.UserName,
+			Email:           user.Email,
+			EmailVerifiedAt: user.EmailVerifiedAt,
+			FirstName:       user.FirstName,
+			LastName:        user.LastName,
+			DateOfBirth:     user.DateOfBirth,
+			Bio:             user.Bio,
+			AvatarPath:      user.AvatarPath,
+			Location:        user.Location,
+			Website:         user.Website,
+			Pronouns:        user.Pronouns,
+			Private:         user.Private,
+			Visibility: takeout.ProfileVisibility{
+				Bio:      visibilityName(user.BioVisibility),
+				Avatar:   visibilityName(user.AvatarVisibility),
+				Location: visibilityName(user.LocationVisibility),
+				Website:  visibilityName(user.WebsiteVisibility),
+				Pronouns: visibilityName(user.PronounsVisibility),
+			},
+		},
+		Posts:     []takeout.Post{},
+		Comments:  []takeout.Comment{},
+		Likes:     []takeout.Like{},
+		Followers: []takeout.Follow{},
+		Following: []takeout.Follow{},
+	}
+
+	var posts []model.Post
+	if err := uaps.DB.Where("user_id = ?", userId).Order("id").Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	for _, post := range posts {
+		archive.Posts = append(archive.Posts, takeout.Post{
+			PostId:           int64(post.ID),
+			ContentText:      post.ContentText,
+			ContentImagePath: post.ContentImagePath,
+			Visible:          post.Audience != model.AudienceOnlyMe,
+			CreatedAt:        post.CreatedAt,
+			UpdatedAt:        post.UpdatedAt,
+			Audience:         audienceName(post.Audience),
+			PinnedAt:         post.PinnedAt,
+			EditedAt:         post.EditedAt,
+		})
+	}
+
+	var comments []model.Comment
+	if err := uaps.DB.Where("user_id = ?", userId).Order("id").Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	for _, comment := range comments {
+		archive.Comments = append(archive.Comments, takeout.Comment{
+			CommentId: int64(comment.ID),
+			PostId:    int64(comment.PostID),
+			Content:   comment.Content,
+			CreatedAt: comment.CreatedAt,
+		})
+	}
+
+	var likedPostIds []int64
+	if err := uaps.DB.Table("like").Where("user_id = ?", userId).Order("post_id").Pluck("post_id", &likedPostIds).Error; err != nil {
+		return nil, err
+	}
+	for _, postId := range likedPostIds {
+		archive.Likes = append(archive.Likes, takeout.Like{PostId: postId})
+	}
+
+	err := uaps.DB.Table("user").Select("user.id AS user_id, user.user_name, following.created_at AS followed_at").
+		Joins("JOIN following ON following.user_id = user.id").
+		Where("following.friend_id = ?", userId).Order("user.id").
+		Scan(&archive.Followers).Error
+	if err != nil {
+		return nil, err
+	}
+	err = uaps.DB.Table("user").Select("user.id AS user_id, user.user_name, following.created_at AS followed_at").
+		Joins("JOIN following ON following.friend_id = user.id").
+		Where("following.user_id = ?", userId).Order("user.id").
+		Scan(&archive.Following).Error
+	if err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// cleanUpDataExports deletes expired archives and fails exports that stopped running
+func (uaps *UserAndPostService) cleanUpDataExports() error {
+	err := uaps.DB.Model(&model.DataExport{}).
+		Where("state = ? AND updated_at < ?", model.DataExportStateRunning, time.Now().Add(-dataExportRunTimeout)).
+		Update("state", model.DataExportStateFailed).Error
+	if err != nil {
+		return err
+	}
+
+	var expired []model.DataExport
+	err = uaps.DB.Where("state = ? AND expires_at < ? AND file_path <> ''", model.DataExportStateReady, time.Now()).Find(&expired).Error
+	if err != nil {
+		return err
+	}
+	for _, export := range expired {
+		if err := removeDataExportFile(export.FilePath); err != nil {
+			return err
+		}
+		// the row stays so the export is reported as expired rather than unknown
+		if err := uaps.DB.Model(&export).Update("file_path", "").Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func removeDataExportFile(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func visibilityName(visibility model.Visibility) string {
+	return strings.ToLower(user_and_post.Visibility(visibility).String())
+}
+
+func audienceName(audience model.Audience) string {
+	return strings.ToLower(user_and_post.Post_Audience(audience).String())
+}
@@ -0,0 +1,120 @@
+package user_and_post_service
+
+import (
+	"context"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (uaps *UserAndPostService) ListFollowRequests(ctx context.Context, request *user_and_post.ListFollowRequestsRequest) (*user_and_post.ListFollowRequestsResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.ListFollowRequestsResponse{Status: user_and_post.ListFollowRequestsResponse_FORBIDDEN}, nil
+	}
+
+	requesters, err := uaps.listRelatedUsers("follow_request", "target_id", "requester_id", request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &user_and_post.ListFollowRequestsResponse{Status: user_and_post.ListFollowRequestsResponse_OK, Requesters: requesters}, nil
+}
+
+func (uaps *UserAndPostService) ApproveFollowRequest(ctx context.Context, request *user_and_post.ApproveFollowRequestRequest) (*user_and_post.ApproveFollowRequestResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.ApproveFollowRequestResponse{Status: user_and_post.ApproveFollowRequestResponse_FORBIDDEN}, nil
+	}
+
+	found := false
+	err := uaps.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("requester_id = ? AND target_id = ?", request.GetRequesterUserId(), request.GetUserId()).Delete(&model.FollowRequest{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		found = true
+		return addFollow(tx, request.GetRequesterUserId(), request.GetUserId())
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return &user_and_post.ApproveFollowRequestResponse{Status: user_and_post.ApproveFollowRequestResponse_REQUEST_NOT_FOUND}, nil
+	}
+
+	uaps.invalidateNewsfeeds(ctx, request.GetRequesterUserId())
+	uaps.markSuggestionsStale(ctx, request.GetRequesterUserId(), request.GetUserId())
+	return &user_and_post.ApproveFollowRequestResponse{Status: user_and_post.ApproveFollowRequestResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) RejectFollowRequest(ctx context.Context, request *user_and_post.RejectFollowRequestRequest) (*user_and_post.RejectFollowRequestResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.RejectFollowRequestResponse{Status: user_and_post.RejectFollowRequestResponse_FORBIDDEN}, nil
+	}
+
+	result := uaps.DB.Where("requester_id = ? AND target_id = ?", request.GetRequesterUserId(), request.GetUserId()).Delete(&model.FollowRequest{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &user_and_post.RejectFollowRequestResponse{Status: user_and_post.RejectFollowRequestResponse_REQUEST_NOT_FOUND}, nil
+	}
+	return &user_and_post.RejectFollowRequestResponse{Status: user_and_post.RejectFollowRequestResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) CancelFollowRequest(ctx context.Context, request *user_and_post.CancelFollowRequestRequest) (*user_and_post.CancelFollowRequestResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.CancelFollowRequestResponse{Status: user_and_post.CancelFollowRequestResponse_FORBIDDEN}, nil
+	}
+
+	result := uaps.DB.Where("requester_id = ? AND target_id = ?", request.GetUserId(), request.GetTargetUserId()).Delete(&model.FollowRequest{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &user_and_post.CancelFollowRequestResponse{Status: user_and_post.CancelFollowRequestResponse_REQUEST_NOT_FOUND}, nil
+	}
+	return &user_and_post.CancelFollowRequestResponse{Status: user_and_post.CancelFollowRequestResponse_OK}, nil
+}
+
+// requestFollow records a pending follow of a private account, asking twice
+// keeps the first request
+func (uaps *UserAndPostService) requestFollow(requesterId int64, targetId int64) error {
+	return uaps.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.FollowRequest{
+		RequesterID: uint(requesterId),
+		TargetID:    uint(targetId),
+	}).Error
+}
+
+// approveAllFollowRequests turns every pending request to follow the user into
+// a follow edge and returns the ids of the new followers
+func approveAllFollowRequests(tx *gorm.DB, userId uint) ([]int64, error) {
+	var requesterIds []int64
+	if err := tx.Model(&model.FollowRequest{}).Where("target_id = ?", userId).Pluck("requester_id", &requesterIds).Error; err != nil {
+		return nil, err
+	}
+	for _, requesterId := range requesterIds {
+		if err := addFollow(tx, requesterId, int64(userId)); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Where("target_id = ?", userId).Delete(&model.FollowRequest{}).Error; err != nil {
+		return nil, err
+	}
+	return requesterIds, nil
+}
+
+// addFollow creates the follow edge unless it already exists
+func addFollow(tx *gorm.DB, userId int64, followingUserId int64) error {
+	// DoNothing renders an empty update list for map inserts, so the conflict
+	// is resolved by rewriting a key column with its own value
+	return tx.Table("following").Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns([]string{"user_id"})}).
+		Create(map[string]interface{}{"user_id": userId, "friend_id": followingUserId}).Error
+}
+
+// isFollowing reports whether userId follows followingUserId
+func (uaps *UserAndPostService) isFollowing(userId int64, followingUserId int64) (bool, error) {
+	var count int64
+	err := uaps.DB.Table("following").Where("user_id = ? AND friend_id = ?", userId, followingUserId).Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,303 @@
+package user_and_post_service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultSuggestionPageSize = 20
+	// defaultMaxSuggestions applies when max_suggestions is not configured
+	defaultMaxSuggestions = 100
+	// suggestionCandidatePool bounds how many candidates each graph query returns
+	suggestionCandidatePool = 500
+	// suggestionRefreshBatch bounds how many users one refresh tick recomputes
+	suggestionRefreshBatch = 100
+	// staleSuggestionsKey is the set of users whose follow graph changed since
+	// their suggestions were computed
+	staleSuggestionsKey = "suggestions:stale"
+
+	friendsOfFriendsWeight = 3
+	mutualFollowersWeight  = 2
+	// maxActivityBoost caps what recent posts add, activity only breaks ties
+	// between candidates the graph ranks alike
+	maxActivityBoost = 1.0
+)
+
+// suggestion is a ranked candidate as cached in redis, user names are looked
+// up when serving so renames and deleted accounts show right away
+type suggestion struct {
+	UserId           int64   `json:"user_id"`
+	Score            float64 `json:"score"`
+	FriendsOfFriends int64   `json:"friends_of_friends"`
+	MutualFollowers  int64   `json:"mutual_followers"`
+}
+
+func suggestionsKey(userId int64) string {
+	return "suggestions:" + strconv.FormatInt(userId, 10)
+}
+
+// SuggestFollows serves the cached suggestions of the caller, computing them
+// on a cache miss. Relations that changed since the cache was filled are
+// filtered out here, the refresh worker catches up on the ranking
+func (uaps *UserAndPostService) SuggestFollows(ctx context.Context, request *user_and_post.SuggestFollowsRequest) (*user_and_post.SuggestFollowsResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.SuggestFollowsResponse{Status: user_and_post.SuggestFollowsResponse_FORBIDDEN}, nil
+	}
+
+	suggestions, err := uaps.cachedSuggestions(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	candidateIds := make([]int64, 0, len(suggestions))
+	for _, s := range suggestions {
+		candidateIds = append(candidateIds, s.UserId)
+	}
+	excluded, err := uaps.excludedSuggestions(request.GetUserId(), candidateIds)
+	if err != nil {
+		return nil, err
+	}
+	var users []model.User
+	if len(candidateIds) > 0 {
+		if err := uaps.DB.Scopes(visibleUsers).Select("id", "user_name").Where("id IN ?", candidateIds).Find(&users).Error; err != nil {
+			return nil, err
+		}
+	}
+	userNames := make(map[int64]string, len(users))
+	for _, user := range users {
+		userNames[int64(user.ID)] = user.UserName
+	}
+
+	limit := pageLimit(request.GetLimit(), defaultSuggestionPageSize, uaps.maxSuggestions())
+	response := &user_and_post.SuggestFollowsResponse{Status: user_and_post.SuggestFollowsResponse_OK}
+	for _, s := range suggestions {
+		if len(response.Suggestions) == limit {
+			break
+		}
+		userName, ok := userNames[s.UserId]
+		if !ok || excluded[s.UserId] {
+			continue
+		}
+		response.Suggestions = append(response.Suggestions, &user_and_post.FollowSuggestion{
+			UserId:           s.UserId,
+			UserName:         userName,
+			FriendsOfFriends: s.FriendsOfFriends,
+			MutualFollowers:  s.MutualFollowers,
+		})
+	}
+	return response, nil
+}
+
+func (uaps *UserAndPostService) cachedSuggestions(ctx context.Context, userId int64) ([]suggestion, error) {
+	data, err := uaps.Redis.Get(ctx, suggestionsKey(userId)).Bytes()
+	if err == nil {
+		var suggestions []suggestion
+		if err := json.Unmarshal(data, &suggestions); err == nil {
+			return suggestions, nil
+		}
+		uaps.Logger.Error("failed to decode cached suggestions", zap.Int64("UserId", userId))
+	} else if !errors.Is(err, redis.Nil) {
+		uaps.Logger.Error("failed to read cached suggestions", zap.Error(err), zap.Int64("UserId", userId))
+	}
+	return uaps.refreshSuggestions(ctx, userId)
+}
+
+// refreshSuggestions recomputes and caches the suggestions of the user
+func (uaps *UserAndPostService) refreshSuggestions(ctx context.Context, userId int64) ([]suggestion, error) {
+	suggestions, err := uaps.computeSuggestions(userId)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(suggestions)
+	if err != nil {
+		return nil, err
+	}
+	if err := uaps.Redis.Set(ctx, suggestionsKey(userId), data, uaps.FollowSuggestions.CacheTTL).Err(); err != nil {
+		uaps.Logger.Error("failed to cache suggestions", zap.Error(err), zap.Int64("UserId", userId))
+	}
+	return suggestions, nil
+}
+
+// computeSuggestions ranks the users followed by the people the user follows
+// and the users followed by the user's own followers. Each candidate scores
+// by both counts, plus a small boost for posting within the activity window
+func (uaps *UserAndPostService) computeSuggestions(userId int64) ([]suggestion, error) {
+	type candidateCount struct {
+		UserId int64
+		Count  int64
+	}
+	var friendsOfFriends, mutualFollowers []candidateCount
+	err := uaps.DB.Raw(`SELECT f2.friend_id AS user_id, COUNT(*) AS count
+		FROM following f1 JOIN following f2 ON f2.user_id = f1.friend_id
+		WHERE f1.user_id = ? AND f2.friend_id <> ?
+		GROUP BY f2.friend_id ORDER BY count DESC LIMIT ?`, userId, userId, suggestionCandidatePool).
+		Scan(&friendsOfFriends).Error
+	if err != nil {
+		return nil, err
+	}
+	err = uaps.DB.Raw(`SELECT f2.friend_id AS user_id, COUNT(*) AS count
+		FROM following f1 JOIN following f2 ON f2.user_id = f1.user_id
+		WHERE f1.friend_id = ? AND f2.friend_id <> ?
+		GROUP BY f2.friend_id ORDER BY count DESC LIMIT ?`, userId, userId, suggestionCandidatePool).
+		Scan(&mutualFollowers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make(map[int64]*suggestion)
+	candidate := func(id int64) *suggestion {
+		if candidates[id] == nil {
+			candidates[id] = &suggestion{UserId: id}
+		}
+		return candidates[id]
+	}
+	for _, row := range friendsOfFriends {
+		candidate(row.UserId).FriendsOfFriends = row.Count
+	}
+	for _, row := range mutualFollowers {
+		candidate(row.UserId).MutualFollowers = row.Count
+	}
+	if len(candidates) == 0 {
+		return []suggestion{}, nil
+	}
+
+	candidateIds := make([]int64, 0, len(candidates))
+	for id := range candidates {
+		candidateIds = append(candidateIds, id)
+	}
+	excluded, err := uaps.excludedSuggestions(userId, candidateIds)
+	if err != nil {
+		return nil, err
+	}
+	recentPosts, err := countByUser(uaps.DB.Model(&model.Post{}).Where("created_at > ?", time.Now().Add(-uaps.FollowSuggestions.ActivityWindow)), "user_id", candidateIds)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]suggestion, 0, len(candidates))
+	for id, s := range candidates {
+		if excluded[id] {
+			continue
+		}
+		// log keeps a prolific poster from outranking a better connected user
+		activity := math.Min(math.Log1p(float64(recentPosts[id]))/math.Log1p(10), 1) * maxActivityBoost
+		s.Score = float64(friendsOfFriendsWeight*s.FriendsOfFriends+mutualFollowersWeight*s.MutualFollowers) + activity
+		suggestions = append(suggestions, *s)
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].UserId < suggestions[j].UserId
+	})
+	if len(suggestions) > uaps.maxSuggestions() {
+		suggestions = suggestions[:uaps.maxSuggestions()]
+	}
+	return suggestions, nil
+}
+
+func (uaps *UserAndPostService) maxSuggestions() int {
+	if uaps.FollowSuggestions.MaxSuggestions <= 0 {
+		return defaultMaxSuggestions
+	}
+	return uaps.FollowSuggestions.MaxSuggestions
+}
+
+// excludedSuggestions returns the candidates the user already follows, asked
+// to follow or is separated from by a block in either direction
+func (uaps *UserAndPostService) excludedSuggestions(userId int64, candidateIds []int64) (map[int64]bool, error) {
+	excluded := make(map[int64]bool)
+	if len(candidateIds) == 0 {
+		return excluded, nil
+	}
+	var ids []int64
+	err := uaps.DB.Raw(`SELECT friend_id FROM following WHERE user_id = ? AND friend_id IN ?
+		UNION SELECT target_id FROM follow_request WHERE requester_id = ? AND target_id IN ?
+		UNION SELECT blocked_id FROM user_block WHERE blocker_id = ? AND blocked_id IN ?
+		UNION SELECT blocker_id FROM user_block WHERE blocked_id = ? AND blocker_id IN ?`,
+		userId, candidateIds, userId, candidateIds, userId, candidateIds, userId, candidateIds).
+		Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		excluded[id] = true
+	}
+	return excluded, nil
+}
+
+// markSuggestionsStale queues the users for the refresh worker after their
+// follow graph changed. Failures are only logged, the cache expires on its own
+func (uaps *UserAndPostService) markSuggestionsStale(ctx context.Context, userIds ...int64) {
+	if len(userIds) == 0 {
+		return
+	}
+	members := make([]interface{}, 0, len(userIds))
+	for _, userId := range userIds {
+		members = append(members, userId)
+	}
+	if err := uaps.Redis.SAdd(ctx, staleSuggestionsKey, members...).Err(); err != nil {
+		uaps.Logger.Error("failed to mark suggestions stale", zap.Error(err))
+	}
+}
+
+// RefreshFollowSuggestions recomputes the suggestions of users whose follow
+// graph changed, every RefreshInterval until ctx is done. Users without cached
+// suggestions are skipped, they are computed on their next request
+func (uaps *UserAndPostService) RefreshFollowSuggestions(ctx context.Context) {
+	if uaps.FollowSuggestions.RefreshInterval <= 0 {
+		uaps.Logger.Warn("follow suggestion refresh disabled, refresh_interval is not set")
+		return
+	}
+
+	ticker := time.NewTicker(uaps.FollowSuggestions.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := uaps.refreshStaleSuggestions(ctx); err != nil {
+			uaps.Logger.Error("failed to refresh follow suggestions", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (uaps *UserAndPostService) refreshStaleSuggestions(ctx context.Context) error {
+	members, err := uaps.Redis.SPopN(ctx, staleSuggestionsKey, suggestionRefreshBatch).Result()
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		userId, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		cached, err := uaps.Redis.Exists(ctx, suggestionsKey(userId)).Result()
+		if err != nil {
+			return err
+		}
+		if cached == 0 {
+			continue
+		}
+		if _, err := uaps.refreshSuggestions(ctx, userId); err != nil {
+			// put it back so the next tick retries
+			uaps.markSuggestionsStale(ctx, userId)
+			return err
+		}
+	}
+	return nil
+}
@@ -3,16 +3,24 @@ package user_and_post_service
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
 	"github.com/khailequang334/social_network/internal/model"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
+const (
+	defaultFollowPageSize = 50
+	maxFollowPageSize     = 100
+)
+
+// ensureUserExist treats deleted accounts, pending purge or anonymized, as missing
 func (uaps *UserAndPostService) ensureUserExist(userId int64) error {
 	var user model.User
-	err := uaps.DB.Table("user").Where("id = ?", userId).First(&user).Error
+	err := uaps.DB.Table("user").Where("id = ? AND deactivated_at IS NULL AND purged_at IS NULL", userId).First(&user).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		// User not found
 		uaps.Logger.Error("User not found", zap.Int64("userID", userId))
@@ -26,118 +34,141 @@ func (uaps *UserAndPostService) ensureUserExist(userId int64) error {
 }
 
 func (uaps *UserAndPostService) FollowUser(ctx context.Context, request *user_and_post.FollowUserRequest) (*user_and_post.FollowUserResponse, error) {
-	// Ensure the user exists
-	err := uaps.ensureUserExist(request.UserId)
-	if err != nil {
-		return &user_and_post.FollowUserResponse{Status: user_and_post.FollowUserResponse_USER_NOT_FOUND}, nil
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.FollowUserResponse{Status: user_and_post.FollowUserResponse_FORBIDDEN}, nil
 	}
 
-	// Ensure the following user exists
-	err = uaps.ensureUserExist(request.FollowingUserId)
+	// a single follow goes through the same rules as a bulk one
+	results, err := uaps.ApplyFollowEdges(ctx, []*user_and_post.FollowEdge{{
+		FollowerId: request.UserId,
+		FolloweeId: request.FollowingUserId,
+	}}, false)
 	if err != nil {
-		return &user_and_post.FollowUserResponse{Status: user_and_post.FollowUserResponse_USER_NOT_FOUND}, nil
+		return nil, err
 	}
 
-	// Preload user with their following and follower relationships
-	var user model.User
-	err = uaps.DB.Preload("Following").Preload("Follower").First(&user, request.UserId).Error
-	if err != nil {
-		return nil, errors.New("error fetching user")
+	switch results[0].GetOutcome() {
+	case user_and_post.FollowEdgeResult_INVALID_EDGE:
+		return &user_and_post.FollowUserResponse{Status: user_and_post.FollowUserResponse_CANNOT_FOLLOW_SELF}, nil
+	case user_and_post.FollowEdgeResult_USER_NOT_FOUND:
+		return &user_and_post.FollowUserResponse{Status: user_and_post.FollowUserResponse_USER_NOT_FOUND}, nil
+	case user_and_post.FollowEdgeResult_ALREADY_APPLIED:
+		return &user_and_post.FollowUserResponse{Status: user_and_post.FollowUserResponse_ALREADY_FOLLOWED}, nil
+	case user_and_post.FollowEdgeResult_BLOCKED:
+		return &user_and_post.FollowUserResponse{Status: user_and_post.FollowUserResponse_BLOCKED}, nil
+	case user_and_post.FollowEdgeResult_REQUESTED:
+		return &user_and_post.FollowUserResponse{Status: user_and_post.FollowUserResponse_REQUESTED}, nil
 	}
-	uaps.Logger.Debug("Returned user", zap.Any("user", user))
+	uaps.Logger.Info("following new user")
+	return &user_and_post.FollowUserResponse{Status: user_and_post.FollowUserResponse_OK}, nil
+}
 
-	// Check if the user is already being followed
-	var alreadyFollowed bool
-	for _, following := range user.Following {
-		if following.ID == uint(request.FollowingUserId) {
-			alreadyFollowed = true
-			break
-		}
-	}
-	if alreadyFollowed {
-		return &user_and_post.FollowUserResponse{Status: user_and_post.FollowUserResponse_ALREADY_FOLLOWED}, nil
+func (uaps *UserAndPostService) UnfollowUser(ctx context.Context, request *user_and_post.UnfollowUserRequest) (*user_and_post.UnfollowUserResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.UnfollowUserResponse{Status: user_and_post.UnfollowUserResponse_FORBIDDEN}, nil
 	}
 
-	// Fetch the user to follow
-	var followingUser model.User
-	err = uaps.DB.First(&followingUser, request.FollowingUserId).Error
+	results, err := uaps.ApplyFollowEdges(ctx, []*user_and_post.FollowEdge{{
+		FollowerId: request.UserId,
+		FolloweeId: request.FollowingUserId,
+	}}, true)
 	if err != nil {
-		return nil, errors.New("error fetching following user")
+		return nil, err
 	}
 
-	// Append following user to user's following relationship
-	err = uaps.DB.Model(&user).Association("Following").Append(&followingUser)
-	if err != nil {
-		return nil, errors.New("error appending following user")
+	switch results[0].GetOutcome() {
+	case user_and_post.FollowEdgeResult_USER_NOT_FOUND:
+		return &user_and_post.UnfollowUserResponse{Status: user_and_post.UnfollowUserResponse_USER_NOT_FOUND}, nil
+	case user_and_post.FollowEdgeResult_INVALID_EDGE, user_and_post.FollowEdgeResult_ALREADY_APPLIED:
+		return &user_and_post.UnfollowUserResponse{Status: user_and_post.UnfollowUserResponse_NOT_FOLLOWED}, nil
 	}
+	uaps.Logger.Info("unfollowing user")
+	return &user_and_post.UnfollowUserResponse{Status: user_and_post.UnfollowUserResponse_OK}, nil
+}
 
-	// Append user to following user's follower relationship
-	err = uaps.DB.Model(&followingUser).Association("Follower").Append(&user)
+func (uaps *UserAndPostService) GetFollowerList(ctx context.Context, req *user_and_post.GetFollowerListRequest) (*user_and_post.GetFollowerListResponse, error) {
+	err := uaps.ensureUserExist(req.UserId)
 	if err != nil {
-		return nil, errors.New("error appending follower user")
+		return &user_and_post.GetFollowerListResponse{Status: user_and_post.GetFollowerListResponse_USER_NOT_FOUND}, nil
 	}
 
-	uaps.Logger.Info("following new user")
-	return &user_and_post.FollowUserResponse{Status: user_and_post.FollowUserResponse_OK}, nil
-
+	followers, nextCursor, err := uaps.listFollows("friend_id", "user_id", req.GetUserId(), req.GetLimit(), req.GetCursor(), req.GetUserNamePrefix())
+	if errors.Is(err, errInvalidCursor) {
+		return &user_and_post.GetFollowerListResponse{Status: user_and_post.GetFollowerListResponse_INVALID_CURSOR}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &user_and_post.GetFollowerListResponse{
+		Status:     user_and_post.GetFollowerListResponse_OK,
+		Followers:  followers,
+		NextCursor: nextCursor,
+	}, nil
 }
 
-func (uaps *UserAndPostService) UnfollowUser(ctx context.Context, request *user_and_post.UnfollowUserRequest) (*user_and_post.UnfollowUserResponse, error) {
-	err := uaps.ensureUserExist(request.UserId)
-	if err != nil {
-		return &user_and_post.UnfollowUserResponse{Status: user_and_post.UnfollowUserResponse_USER_NOT_FOUND}, nil
-	}
-	err = uaps.ensureUserExist(request.FollowingUserId)
+func (uaps *UserAndPostService) GetFollowingList(ctx context.Context, req *user_and_post.GetFollowingListRequest) (*user_and_post.GetFollowingListResponse, error) {
+	err := uaps.ensureUserExist(req.UserId)
 	if err != nil {
-		return &user_and_post.UnfollowUserResponse{Status: user_and_post.UnfollowUserResponse_USER_NOT_FOUND}, nil
+		return &user_and_post.GetFollowingListResponse{Status: user_and_post.GetFollowingListResponse_USER_NOT_FOUND}, nil
 	}
 
-	var user model.User
-	uaps.DB.Preload("Following").Preload("Follower").Find(&user, request.UserId)
-	uaps.Logger.Debug("returned user", zap.Any("user", user))
-
-	var alreadyFollowed bool
-	for _, following := range user.Following {
-		if following.ID == uint(request.FollowingUserId) {
-			alreadyFollowed = true
-			break
-		}
-	}
-
-	if alreadyFollowed {
-		uaps.Logger.Info("unfollowing user")
-		var followingUser model.User
-		uaps.DB.Where(&model.User{ID: uint(request.FollowingUserId)}).First(&followingUser)
-
-		err := uaps.DB.Model(&user).Association("Following").Delete(&followingUser)
-		if err != nil {
-			return nil, err
-		}
-		err = uaps.DB.Model(&followingUser).Association("Follower").Delete(&user)
-		if err != nil {
-			return nil, err
-		}
-		return &user_and_post.UnfollowUserResponse{Status: user_and_post.UnfollowUserResponse_OK}, nil
-	} else {
-		return &user_and_post.UnfollowUserResponse{Status: user_and_post.UnfollowUserResponse_NOT_FOLLOWED}, nil
+	following, nextCursor, err := uaps.listFollows("user_id", "friend_id", req.GetUserId(), req.GetLimit(), req.GetCursor(), req.GetUserNamePrefix())
+	if errors.Is(err, errInvalidCursor) {
+		return &user_and_post.GetFollowingListResponse{Status: user_and_post.GetFollowingListResponse_INVALID_CURSOR}, nil
+	} else if err != nil {
+		return nil, err
 	}
+	return &user_and_post.GetFollowingListResponse{
+		Status:     user_and_post.GetFollowingListResponse_OK,
+		Following:  following,
+		NextCursor: nextCursor,
+	}, nil
 }
 
-func (uaps *UserAndPostService) GetFollowerList(ctx context.Context, req *user_and_post.GetFollowerListRequest) (*user_and_post.GetFollowerListResponse, error) {
-	err := uaps.ensureUserExist(req.UserId)
+// listFollows pages through the follow edges where ownerColumn is the user,
+// returning the users on the otherColumn side, most recent follow first
+func (uaps *UserAndPostService) listFollows(ownerColumn string, otherColumn string, userId int64, limit int32, cursor string, userNamePrefix string) ([]*user_and_post.FollowInfo, string, error) {
+	after, err := decodePageCursor(cursor)
 	if err != nil {
-		return &user_and_post.GetFollowerListResponse{Status: user_and_post.GetFollowerListResponse_USER_NOT_FOUND}, nil
+		return nil, "", err
+	}
+	pageSize := pageLimit(limit, defaultFollowPageSize, maxFollowPageSize)
+
+	query := uaps.DB.Table("following").
+		Select("user.id AS user_id, user.user_name, following.created_at").
+		Joins("JOIN user ON user.id = following."+otherColumn).
+		Where("following."+ownerColumn+" = ?", userId).
+		Scopes(visibleUsers).
+		Order("following.created_at DESC, user.id DESC").
+		// one more row than asked tells whether there is a next page
+		Limit(pageSize + 1)
+	if after != nil {
+		query = query.Where("following.created_at < ? OR (following.created_at = ? AND user.id < ?)", after.Time, after.Time, after.Id)
+	}
+	if userNamePrefix != "" {
+		query = query.Where("user.user_name LIKE ?", escapeLike(userNamePrefix)+"%")
+	}
+	var rows []struct {
+		UserId    int64
+		UserName  string
+		CreatedAt time.Time
+	}
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, "", err
 	}
 
-	var user model.User
-	uaps.DB.Preload("Follower").Find(&user, req.UserId)
-	uaps.Logger.Debug("returned user", zap.Any("user", user))
-	var followersInfo []*user_and_post.GetFollowerListResponse_FollowerInfo
-	for _, follower := range user.Follower {
-		followersInfo = append(followersInfo, &user_and_post.GetFollowerListResponse_FollowerInfo{
-			UserId:   int64(follower.ID),
-			UserName: follower.UserName,
+	nextCursor := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		last := rows[len(rows)-1]
+		nextCursor = pageCursor{Time: last.CreatedAt, Id: last.UserId}.encode()
+	}
+	follows := make([]*user_and_post.FollowInfo, 0, len(rows))
+	for _, row := range rows {
+		follows = append(follows, &user_and_post.FollowInfo{
+			UserId:       row.UserId,
+			UserName:     row.UserName,
+			FollowedTime: timestamppb.New(row.CreatedAt),
 		})
 	}
-	return &user_and_post.GetFollowerListResponse{Status: user_and_post.GetFollowerListResponse_OK, Followers: followersInfo}, nil
+	return follows, nextCursor, nil
 }
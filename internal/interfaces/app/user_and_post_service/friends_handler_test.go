@@ -0,0 +1,76 @@
+package user_and_post_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/khailequang334/social_network/internal/identity"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+)
+
+func TestFollowAndUnfollowUser(t *testing.T) {
+	uaps := newTestService(t)
+
+	alice := model.User{FirstName: "Alice", Email: "alice@example.com", UserName: "alice"}
+	bob := model.User{FirstName: "Bob", Email: "bob@example.com", UserName: "bob"}
+	carol := model.User{FirstName: "Carol", Email: "carol@example.com", UserName: "carol", Private: true}
+	for _, user := range []*model.User{&alice, &bob, &carol} {
+		if err := uaps.DB.Create(user).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx := identity.WithCallerId(context.Background(), int64(alice.ID))
+
+	follows := []struct {
+		followingUserId int64
+		want            user_and_post.FollowUserResponse_FollowStatus
+	}{
+		{int64(bob.ID), user_and_post.FollowUserResponse_OK},
+		{int64(bob.ID), user_and_post.FollowUserResponse_ALREADY_FOLLOWED},
+		{int64(carol.ID), user_and_post.FollowUserResponse_REQUESTED},
+		{int64(alice.ID), user_and_post.FollowUserResponse_CANNOT_FOLLOW_SELF},
+		{int64(carol.ID) + 1, user_and_post.FollowUserResponse_USER_NOT_FOUND},
+	}
+	for _, follow := range follows {
+		response, err := uaps.FollowUser(ctx, &user_and_post.FollowUserRequest{
+			UserId:          int64(alice.ID),
+			FollowingUserId: follow.followingUserId,
+		})
+		if err != nil {
+			t.Fatalf("FollowUser %d: %v", follow.followingUserId, err)
+		}
+		if response.GetStatus() != follow.want {
+			t.Errorf("FollowUser %d returned %s, want %s", follow.followingUserId, response.GetStatus(), follow.want)
+		}
+	}
+
+	following, err := uaps.isFollowing(int64(alice.ID), int64(bob.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !following {
+		t.Fatal("alice does not follow bob after FollowUser")
+	}
+
+	unfollows := []struct {
+		followingUserId int64
+		want            user_and_post.UnfollowUserResponse_UnfollowStatus
+	}{
+		{int64(bob.ID), user_and_post.UnfollowUserResponse_OK},
+		{int64(bob.ID), user_and_post.UnfollowUserResponse_NOT_FOLLOWED},
+		{int64(carol.ID), user_and_post.UnfollowUserResponse_NOT_FOLLOWED},
+	}
+	for _, unfollow := range unfollows {
+		response, err := uaps.UnfollowUser(ctx, &user_and_post.UnfollowUserRequest{
+			UserId:          int64(alice.ID),
+			FollowingUserId: unfollow.followingUserId,
+		})
+		if err != nil {
+			t.Fatalf("UnfollowUser %d: %v", unfollow.followingUserId, err)
+		}
+		if response.GetStatus() != unfollow.want {
+			t.Errorf("UnfollowUser %d returned %s, want %s", unfollow.followingUserId, response.GetStatus(), unfollow.want)
+		}
+	}
+}
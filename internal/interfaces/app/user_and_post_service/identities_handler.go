@@ -0,0 +1,255 @@
+package user_and_post_service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"github.com/khailequang334/social_network/internal/validation"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const provisionUserNameAttempts = 5
+
+// identityUserNameBase turns the preferred username or the email local part
+// into something validation.UserName accepts
+func identityUserNameBase(request *user_and_post.LoginWithIdentityRequest) string {
+	source := request.GetPreferredUserName()
+	if source == "" {
+		source, _, _ = strings.Cut(request.GetEmail(), "@")
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(source) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		case r == '.' && !strings.HasSuffix(b.String(), "."):
+			b.WriteRune(r)
+		}
+	}
+	// leave room for the random suffix added when the name is taken
+	base := strings.Trim(b.String(), ".")
+	if len(base) > validation.UserNameMaxLength-5 {
+		base = strings.TrimRight(base[:validation.UserNameMaxLength-5], ".")
+	}
+	if len(base) < validation.UserNameMinLength {
+		base = "user"
+	}
+	return base
+}
+
+func truncateName(name string) string {
+	if utf8.RuneCountInString(name) <= validation.NameMaxLength {
+		return name
+	}
+	return string([]rune(name)[:validation.NameMaxLength])
+}
+
+// provisionIdentityUser creates the account on the first login with an
+// external identity, the user can set a password later through the reset flow
+func (uaps *UserAndPostService) provisionIdentityUser(ctx context.Context, request *user_and_post.LoginWithIdentityRequest) (uint, user_and_post.LoginWithIdentityResponse_LoginWithIdentityStatus, error) {
+	if validation.Email(request.GetEmail()) != nil {
+		return 0, user_and_post.LoginWithIdentityResponse_INVALID_EMAIL, nil
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return 0, user_and_post.LoginWithIdentityResponse_OK, err
+	}
+	info := &user_and_post.UserDetailInfo{
+		FirstName:    truncateName(request.GetFirstName()),
+		LastName:     truncateName(request.GetLastName()),
+		Email:        request.GetEmail(),
+		UserPassword: base64.RawURLEncoding.EncodeToString(raw),
+	}
+
+	base := identityUserNameBase(request)
+	for attempt := 0; attempt < provisionUserNameAttempts; attempt++ {
+		info.UserName = base
+		if attempt > 0 {
+			suffix, err := rand.Int(rand.Reader, big.NewInt(10000))
+			if err != nil {
+				return 0, user_and_post.LoginWithIdentityResponse_OK, err
+			}
+			info.UserName = fmt.Sprintf("%s_%04d", base, suffix.Int64())
+		}
+		if info.FirstName == "" {
+			info.FirstName = info.UserName
+		}
+
+		result, err := uaps.createUser(ctx, info, request.GetEmailVerified())
+		if err != nil {
+			return 0, user_and_post.LoginWithIdentityResponse_OK, err
+		}
+		switch result.GetStatus() {
+		case user_and_post.UserResult_OK:
+			return uint(result.GetInfo().GetUserId()), user_and_post.LoginWithIdentityResponse_OK, nil
+		case user_and_post.UserResult_EMAIL_TAKEN:
+			return 0, user_and_post.LoginWithIdentityResponse_EMAIL_TAKEN, nil
+		case user_and_post.UserResult_USERNAME_TAKEN:
+			continue
+		default:
+			return 0, user_and_post.LoginWithIdentityResponse_OK, fmt.Errorf("can not provision user from identity: %v", result.GetFieldErrors())
+		}
+	}
+	return 0, user_and_post.LoginWithIdentityResponse_OK, fmt.Errorf("can not find a free username for %s", base)
+}
+
+// LoginWithIdentity logs in the user linked to the identity, creating the account on first login
+func (uaps *UserAndPostService) LoginWithIdentity(ctx context.Context, request *user_and_post.LoginWithIdentityRequest) (*user_and_post.LoginWithIdentityResponse, error) {
+	var link model.IdentityLink
+	created := false
+	err := uaps.DB.Where("issuer = ? AND subject = ?", request.GetIssuer(), request.GetSubject()).First(&link).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		userId, status, err := uaps.provisionIdentityUser(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		if status != user_and_post.LoginWithIdentityResponse_OK {
+			return &user_and_post.LoginWithIdentityResponse{Status: status}, nil
+		}
+
+		link = model.IdentityLink{
+			UserID:  userId,
+			Issuer:  request.GetIssuer(),
+			Subject: request.GetSubject(),
+			Email:   request.GetEmail(),
+		}
+		err = uaps.DB.Create(&link).Error
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			// a concurrent first login won the race, its account is the one to use
+			uaps.Logger.Warn("identity linked concurrently, provisioned user left unlinked", zap.Uint("userID", userId))
+			err = uaps.DB.Where("issuer = ? AND subject = ?", request.GetIssuer(), request.GetSubject()).First(&link).Error
+		} else {
+			created = true
+		}
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	var user model.User
+	if err := uaps.DB.First(&user, link.UserID).Error; err != nil {
+		return nil, err
+	}
+	if user.SuspendedAt != nil {
+		return &user_and_post.LoginWithIdentityResponse{Status: user_and_post.LoginWithIdentityResponse_ACCOUNT_SUSPENDED}, nil
+	}
+
+	// the local second factor applies to every way of logging in
+	twoFactor, err := uaps.getConfirmedSecondFactor(link.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor != nil {
+		challengeId, err := uaps.createSecondFactorChallenge(ctx, link.UserID)
+		if err != nil {
+			return nil, err
+		}
+		return &user_and_post.LoginWithIdentityResponse{
+			Status:      user_and_post.LoginWithIdentityResponse_SECOND_FACTOR_REQUIRED,
+			ChallengeId: challengeId,
+		}, nil
+	}
+
+	if err := uaps.restoreAccount(ctx, link.UserID); err != nil {
+		return nil, err
+	}
+	return &user_and_post.LoginWithIdentityResponse{
+		Status:  user_and_post.LoginWithIdentityResponse_OK,
+		UserId:  int64(link.UserID),
+		Created: created,
+	}, nil
+}
+
+func (uaps *UserAndPostService) LinkIdentity(ctx context.Context, request *user_and_post.LinkIdentityRequest) (*user_and_post.LinkIdentityResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.LinkIdentityResponse{Status: user_and_post.LinkIdentityResponse_FORBIDDEN}, nil
+	}
+
+	var user model.User
+	err := uaps.DB.First(&user, request.UserId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.LinkIdentityResponse{Status: user_and_post.LinkIdentityResponse_USER_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var existing model.IdentityLink
+	err = uaps.DB.Where("issuer = ? AND subject = ?", request.GetIssuer(), request.GetSubject()).First(&existing).Error
+	if err == nil {
+		if existing.UserID == user.ID {
+			return &user_and_post.LinkIdentityResponse{Status: user_and_post.LinkIdentityResponse_OK}, nil
+		}
+		return &user_and_post.LinkIdentityResponse{Status: user_and_post.LinkIdentityResponse_ALREADY_LINKED}, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	err = uaps.DB.Create(&model.IdentityLink{
+		UserID:  user.ID,
+		Issuer:  request.GetIssuer(),
+		Subject: request.GetSubject(),
+		Email:   request.GetEmail(),
+	}).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &user_and_post.LinkIdentityResponse{Status: user_and_post.LinkIdentityResponse_ALREADY_LINKED}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &user_and_post.LinkIdentityResponse{Status: user_and_post.LinkIdentityResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) UnlinkIdentity(ctx context.Context, request *user_and_post.UnlinkIdentityRequest) (*user_and_post.UnlinkIdentityResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.UnlinkIdentityResponse{Status: user_and_post.UnlinkIdentityResponse_FORBIDDEN}, nil
+	}
+
+	// hard delete so the identity can be linked again later
+	result := uaps.DB.Unscoped().Where("id = ? AND user_id = ?", request.GetIdentityId(), request.GetUserId()).Delete(&model.IdentityLink{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &user_and_post.UnlinkIdentityResponse{Status: user_and_post.UnlinkIdentityResponse_NOT_FOUND}, nil
+	}
+	return &user_and_post.UnlinkIdentityResponse{Status: user_and_post.UnlinkIdentityResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) ListIdentities(ctx context.Context, request *user_and_post.ListIdentitiesRequest) (*user_and_post.ListIdentitiesResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.ListIdentitiesResponse{Status: user_and_post.ListIdentitiesResponse_FORBIDDEN}, nil
+	}
+
+	var links []model.IdentityLink
+	if err := uaps.DB.Where("user_id = ?", request.GetUserId()).Order("id").Find(&links).Error; err != nil {
+		return nil, err
+	}
+
+	identities := make([]*user_and_post.IdentityInfo, 0, len(links))
+	for _, link := range links {
+		identities = append(identities, &user_and_post.IdentityInfo{
+			IdentityId: int64(link.ID),
+			Issuer:     link.Issuer,
+			Subject:    link.Subject,
+			Email:      link.Email,
+			LinkedTime: timestamppb.New(link.CreatedAt),
+		})
+	}
+	return &user_and_post.ListIdentitiesResponse{
+		Status:     user_and_post.ListIdentitiesResponse_OK,
+		Identities: identities,
+	}, nil
+}
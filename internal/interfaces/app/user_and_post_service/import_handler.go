@@ -0,0 +1,285 @@
+package user_and_post_service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/khailequang334/social_network/internal/authz"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"github.com/khailequang334/social_network/internal/takeout"
+	"github.com/khailequang334/social_network/internal/validation"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// errDryRun rolls back the import transaction once the report is complete
+var errDryRun = errors.New("dry run")
+
+func (uaps *UserAndPostService) ImportAccount(ctx context.Context, request *user_and_post.ImportAccountRequest) (*user_and_post.ImportAccountResponse, error) {
+	allowed, err := uaps.callerHasPermission(ctx, authz.PermissionImportAccount)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return &user_and_post.ImportAccountResponse{Status: user_and_post.ImportAccountResponse_FORBIDDEN}, nil
+	}
+
+	archive, err := takeout.Read(bytes.NewReader(request.GetArchive()))
+	if err != nil {
+		return &user_and_post.ImportAccountResponse{
+			Status: user_and_post.ImportAccountResponse_INVALID_ARCHIVE,
+			Error:  err.Error(),
+		}, nil
+	}
+	return uaps.ImportArchive(archive, request.GetDryRun())
+}
+
+// ImportArchive recreates the user of a takeout archive with new ids, in one
+// transaction. Comments and likes are only kept on posts from the archive and
+// follow edges only to users that already exist by user name. The imported
+// user gets a random password and has to reset it
+func (uaps *UserAndPostService) ImportArchive(archive *takeout.Archive, dryRun bool) (*user_and_post.ImportAccountResponse, error) {
+	profile := archive.Profile
+	for _, fieldError := range []*validation.FieldError{validation.UserName(profile.UserName), validation.Email(profile.Email)} {
+		if fieldError != nil {
+			return &user_and_post.ImportAccountResponse{
+				Status: user_and_post.ImportAccountResponse_INVALID_ARCHIVE,
+				Error:  fmt.Sprintf("profile.%s %s", fieldError.Field, fieldError.Message),
+			}, nil
+		}
+	}
+
+	status, _, err := uaps.checkUserTaken(0, profile.UserName, profile.Email)
+	if err != nil {
+		return nil, err
+	}
+	if status == user_and_post.UserResult_USERNAME_TAKEN {
+		return &user_and_post.ImportAccountResponse{Status: user_and_post.ImportAccountResponse_USERNAME_TAKEN}, nil
+	} else if status == user_and_post.UserResult_EMAIL_TAKEN {
+		return &user_and_post.ImportAccountResponse{Status: user_and_post.ImportAccountResponse_EMAIL_TAKEN}, nil
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	hashedPassword, err := uaps.Passwords.Hash(base64.RawURLEncoding.EncodeToString(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &user_and_post.ImportAccountResponse{
+		Status: user_and_post.ImportAccountResponse_OK,
+		DryRun: dryRun,
+		Report: &user_and_post.ImportReport{},
+	}
+	report := response.Report
+	skip := func(format string, args ...interface{}) {
+		report.Skipped = append(report.Skipped, fmt.Sprintf(format, args...))
+	}
+
+	err = uaps.DB.Transaction(func(tx *gorm.DB) error {
+		user := model.User{
+			HashedPassword:     hashedPassword,
+			FirstName:          profile.FirstName,
+			LastName:           profile.LastName,
+			DateOfBirth:        profile.DateOfBirth,
+			Email:              profile.Email,
+			EmailVerifiedAt:    profile.EmailVerifiedAt,
+			UserName:           profile.UserName,
+			Bio:                profile.Bio,
+			AvatarPath:         profile.AvatarPath,
+			Location:           profile.Location,
+			Website:            profile.Website,
+			Pronouns:           profile.Pronouns,
+			BioVisibility:      importVisibility("bio", profile.Visibility.Bio, skip),
+			AvatarVisibility:   importVisibility("avatar", profile.Visibility.Avatar, skip),
+			LocationVisibility: importVisibility("location", profile.Visibility.Location, skip),
+			WebsiteVisibility:  importVisibility("website", profile.Visibility.Website, skip),
+			PronounsVisibility: importVisibility("pronouns", profile.Visibility.Pronouns, skip),
+			Private:            profile.Private,
+		}
+		if err := tx.Create(&user).Error; err != nil {
+			return err
+		}
+
+		// archive post id to the id of the recreated post
+		postIds := make(map[int64]uint, len(archive.Posts))
+		for _, archivedPost := range archive.Posts {
+			post := model.Post{
+				ContentText:      archivedPost.ContentText,
+				ContentImagePath: archivedPost.ContentImagePath,
+				UserID:           user.ID,
+				Audience:         importAudience(archivedPost, skip),
+				PinnedAt:         archivedPost.PinnedAt,
+				EditedAt:         archivedPost.EditedAt,
+			}
+			post.CreatedAt = archivedPost.CreatedAt
+			post.UpdatedAt = archivedPost.UpdatedAt
+			if err := tx.Create(&post).Error; err != nil {
+				return err
+			}
+			postIds[archivedPost.PostId] = post.ID
+			report.PostsImported++
+		}
+
+		for _, archivedComment := range archive.Comments {
+			postId, ok := postIds[archivedComment.PostId]
+			if !ok {
+				skip("comment %d: post %d is not part of the archive", archivedComment.CommentId, archivedComment.PostId)
+				continue
+			}
+			comment := model.Comment{Content: archivedComment.Content, UserID: user.ID, PostID: postId}
+			comment.CreatedAt = archivedComment.CreatedAt
+			if err := tx.Create(&comment).Error; err != nil {
+				return err
+			}
+			report.CommentsImported++
+		}
+
+		liked := make(map[uint]bool)
+		for _, archivedLike := range archive.Likes {
+			postId, ok := postIds[archivedLike.PostId]
+			if !ok {
+				skip("like: post %d is not part of the archive", archivedLike.PostId)
+				continue
+			}
+			if liked[postId] {
+				continue
+			}
+			liked[postId] = true
+			if err := tx.Table("like").Create(map[string]interface{}{"user_id": user.ID, "post_id": postId}).Error; err != nil {
+				return err
+			}
+			report.LikesImported++
+		}
+
+		followers, err := resolveFollows(tx, archive.Followers, "follower", skip)
+		if err != nil {
+			return err
+		}
+		for _, follower := range followers {
+			if err := createFollow(tx, follower.userId, user.ID, follower.followedAt); err != nil {
+				return err
+			}
+			report.FollowersImported++
+		}
+		following, err := resolveFollows(tx, archive.Following, "following", skip)
+		if err != nil {
+			return err
+		}
+		for _, followed := range following {
+			if err := createFollow(tx, user.ID, followed.userId, followed.followedAt); err != nil {
+				return err
+			}
+			report.FollowingImported++
+		}
+
+		if dryRun {
+			return errDryRun
+		}
+		response.UserId = int64(user.ID)
+		return nil
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		// a signup with the same user name or email won the race
+		return &user_and_post.ImportAccountResponse{Status: user_and_post.ImportAccountResponse_USERNAME_TAKEN}, nil
+	} else if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+
+	uaps.Logger.Info("account imported",
+		zap.String("userName", profile.UserName),
+		zap.Int64("userID", response.UserId),
+		zap.Bool("dryRun", dryRun),
+		zap.Int("skipped", len(report.Skipped)))
+	return response, nil
+}
+
+// resolvedFollow is an archived follow edge matched to an existing user
+type resolvedFollow struct {
+	userId     uint
+	followedAt time.Time
+}
+
+// resolveFollows maps the user names of archived follow edges to existing,
+// not purged users, each user is returned once
+func resolveFollows(tx *gorm.DB, follows []takeout.Follow, side string, skip func(format string, args ...interface{})) ([]resolvedFollow, error) {
+	if len(follows) == 0 {
+		return nil, nil
+	}
+	userNames := make([]string, 0, len(follows))
+	for _, follow := range follows {
+		userNames = append(userNames, follow.UserName)
+	}
+
+	var users []model.User
+	if err := tx.Where("user_name IN ? AND purged_at IS NULL", userNames).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	idsByName := make(map[string]uint, len(users))
+	for _, user := range users {
+		idsByName[user.UserName] = user.ID
+	}
+
+	seen := make(map[uint]bool)
+	resolved := make([]resolvedFollow, 0, len(users))
+	for _, follow := range follows {
+		userId, ok := idsByName[follow.UserName]
+		if !ok {
+			skip("%s %q: no such user", side, follow.UserName)
+			continue
+		}
+		if !seen[userId] {
+			seen[userId] = true
+			resolved = append(resolved, resolvedFollow{userId: userId, followedAt: follow.FollowedAt})
+		}
+	}
+	return resolved, nil
+}
+
+// createFollow keeps the archived follow time, archives without one get the
+// time of the import
+func createFollow(tx *gorm.DB, userId uint, followingUserId uint, followedAt time.Time) error {
+	values := map[string]interface{}{"user_id": userId, "friend_id": followingUserId}
+	if !followedAt.IsZero() {
+		values["created_at"] = followedAt
+	}
+	return tx.Table("following").Create(values).Error
+}
+
+// importVisibility falls back to private for values it does not know, hiding
+// a field by mistake is better than exposing it
+func importVisibility(field string, name string, skip func(format string, args ...interface{})) model.Visibility {
+	visibility, ok := user_and_post.Visibility_value[strings.ToUpper(name)]
+	if !ok {
+		skip("profile.visibility.%s: unknown value %q, set to private", field, name)
+		return model.VisibilityPrivate
+	}
+	return model.Visibility(visibility)
+}
+
+// importAudience maps posts of archives written before post audiences from
+// their visible flag and, like importVisibility, falls back to only me for
+// values it does not know
+func importAudience(post takeout.Post, skip func(format string, args ...interface{})) model.Audience {
+	if post.Audience == "" {
+		if post.Visible {
+			return model.AudiencePublic
+		}
+		return model.AudienceOnlyMe
+	}
+	audience, ok := user_and_post.Post_Audience_value[strings.ToUpper(post.Audience)]
+	if !ok {
+		skip("post %d: unknown audience %q, set to only_me", post.PostId, post.Audience)
+		return model.AudienceOnlyMe
+	}
+	return model.Audience(audience)
+}
@@ -0,0 +1,111 @@
+package user_and_post_service
+
+import (
+	"context"
+	"time"
+)
+
+// Failed logins are counted per username and per client ip inside a sliding
+// window. Past backoff_threshold every further failure doubles the wait before
+// the next attempt is accepted, past lockout_threshold the username is locked.
+
+func loginFailuresKey(kind string, value string) string {
+	return "login_failures:" + kind + ":" + value
+}
+
+func loginBackoffKey(kind string, value string) string {
+	return "login_backoff:" + kind + ":" + value
+}
+
+func loginLockKey(userName string) string {
+	return "login_lock:user:" + userName
+}
+
+// checkLoginAllowed reports whether the username is locked and how long the
+// client has to wait before the next attempt is accepted
+func (uaps *UserAndPostService) checkLoginAllowed(ctx context.Context, userName string, clientIp string) (locked bool, retryAfter time.Duration, err error) {
+	pipe := uaps.Redis.Pipeline()
+	lock := pipe.PTTL(ctx, loginLockKey(userName))
+	userBackoff := pipe.PTTL(ctx, loginBackoffKey("user", userName))
+	ipBackoff := pipe.PTTL(ctx, loginBackoffKey("ip", clientIp))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, 0, err
+	}
+
+	if lock.Val() > 0 {
+		return true, lock.Val(), nil
+	}
+	retryAfter = userBackoff.Val()
+	if clientIp != "" && ipBackoff.Val() > retryAfter {
+		retryAfter = ipBackoff.Val()
+	}
+	return false, retryAfter, nil
+}
+
+func (uaps *UserAndPostService) backoffFor(failures int64, threshold int64) time.Duration {
+	conf := uaps.LoginProtection
+	if threshold <= 0 || failures < threshold {
+		return 0
+	}
+	backoff := conf.BaseBackoff
+	for i := threshold; i < failures && backoff < conf.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if conf.MaxBackoff > 0 && backoff > conf.MaxBackoff {
+		backoff = conf.MaxBackoff
+	}
+	return backoff
+}
+
+func (uaps *UserAndPostService) countFailure(ctx context.Context, kind string, value string) (int64, error) {
+	pipe := uaps.Redis.TxPipeline()
+	failures := pipe.Incr(ctx, loginFailuresKey(kind, value))
+	pipe.Expire(ctx, loginFailuresKey(kind, value), uaps.LoginProtection.FailureWindow)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return failures.Val(), nil
+}
+
+// recordLoginFailure counts a failed attempt and reports whether it locked the account
+func (uaps *UserAndPostService) recordLoginFailure(ctx context.Context, userName string, clientIp string) (locked bool, err error) {
+	conf := uaps.LoginProtection
+
+	userFailures, err := uaps.countFailure(ctx, "user", userName)
+	if err != nil {
+		return false, err
+	}
+	if conf.LockoutThreshold > 0 && userFailures >= conf.LockoutThreshold {
+		pipe := uaps.Redis.TxPipeline()
+		pipe.Set(ctx, loginLockKey(userName), 1, conf.LockoutDuration)
+		pipe.Del(ctx, loginFailuresKey("user", userName), loginBackoffKey("user", userName))
+		_, err := pipe.Exec(ctx)
+		return err == nil, err
+	}
+	if backoff := uaps.backoffFor(userFailures, conf.BackoffThreshold); backoff > 0 {
+		if err := uaps.Redis.Set(ctx, loginBackoffKey("user", userName), 1, backoff).Err(); err != nil {
+			return false, err
+		}
+	}
+
+	if clientIp == "" {
+		return false, nil
+	}
+	ipFailures, err := uaps.countFailure(ctx, "ip", clientIp)
+	if err != nil {
+		return false, err
+	}
+	if backoff := uaps.backoffFor(ipFailures, conf.IPBackoffThreshold); backoff > 0 {
+		if err := uaps.Redis.Set(ctx, loginBackoffKey("ip", clientIp), 1, backoff).Err(); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// resetLoginFailures forgets failures and lockout of the username after the
+// owner proved who they are, the ip counter is kept so one valid account can
+// not unlock guessing others
+func (uaps *UserAndPostService) resetLoginFailures(ctx context.Context, userName string) error {
+	return uaps.Redis.Del(ctx, loginFailuresKey("user", userName), loginBackoffKey("user", userName), loginLockKey(userName)).Err()
+}
@@ -0,0 +1,184 @@
+package user_and_post_service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/khailequang334/social_network/internal/authz"
+	"github.com/khailequang334/social_network/internal/identity"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultReportPageSize = 50
+	maxReportPageSize     = 100
+)
+
+// CheckPermission lets the web server authorize routes, users may only ask about themselves
+func (uaps *UserAndPostService) CheckPermission(ctx context.Context, request *user_and_post.CheckPermissionRequest) (*user_and_post.CheckPermissionResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.CheckPermissionResponse{Allowed: false}, nil
+	}
+	allowed, err := uaps.Authz.HasPermission(ctx, request.GetUserId(), request.GetPermission())
+	if err != nil {
+		return nil, err
+	}
+	return &user_and_post.CheckPermissionResponse{Allowed: allowed}, nil
+}
+
+func (uaps *UserAndPostService) SuspendUser(ctx context.Context, request *user_and_post.SuspendUserRequest) (*user_and_post.SuspendUserResponse, error) {
+	allowed, err := uaps.callerHasPermission(ctx, authz.PermissionSuspendUser)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return &user_and_post.SuspendUserResponse{Status: user_and_post.SuspendUserResponse_FORBIDDEN}, nil
+	}
+
+	var user model.User
+	err = uaps.DB.First(&user, request.UserId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.SuspendUserResponse{Status: user_and_post.SuspendUserResponse_USER_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var suspendedAt *time.Time
+	if request.GetSuspended() {
+		now := time.Now()
+		suspendedAt = &now
+	}
+	if err := uaps.DB.Model(&user).Update("suspended_at", suspendedAt).Error; err != nil {
+		return nil, err
+	}
+
+	moderatorId, _ := identity.CallerIdFromContext(ctx)
+	uaps.Logger.Info("user suspension changed",
+		zap.Uint("userID", user.ID),
+		zap.Bool("suspended", request.GetSuspended()),
+		zap.String("reason", request.GetReason()),
+		zap.Int64("moderatorID", moderatorId))
+	return &user_and_post.SuspendUserResponse{Status: user_and_post.SuspendUserResponse_OK}, nil
+}
+
+// ForceDeletePost deletes any post and resolves the reports about it
+func (uaps *UserAndPostService) ForceDeletePost(ctx context.Context, request *user_and_post.ForceDeletePostRequest) (*user_and_post.ForceDeletePostResponse, error) {
+	allowed, err := uaps.callerHasPermission(ctx, authz.PermissionDeleteAnyPost)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return &user_and_post.ForceDeletePostResponse{Status: user_and_post.ForceDeletePostResponse_FORBIDDEN}, nil
+	}
+
+	var post model.Post
+	err = uaps.DB.First(&post, request.PostId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.ForceDeletePostResponse{Status: user_and_post.ForceDeletePostResponse_POST_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = uaps.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&post).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.Report{}).
+			Where("post_id = ? AND resolved_at IS NULL", post.ID).
+			Update("resolved_at", time.Now()).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := uaps.invalidatePostCache(ctx, int64(post.ID)); err != nil {
+		uaps.Logger.Error("failed to invalidate post cache", zap.Error(err), zap.Uint("postID", post.ID))
+	}
+
+	moderatorId, _ := identity.CallerIdFromContext(ctx)
+	uaps.Logger.Info("post force deleted",
+		zap.Uint("postID", post.ID),
+		zap.Uint("authorID", post.UserID),
+		zap.String("reason", request.GetReason()),
+		zap.Int64("moderatorID", moderatorId))
+	return &user_and_post.ForceDeletePostResponse{Status: user_and_post.ForceDeletePostResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) ReportPost(ctx context.Context, request *user_and_post.ReportPostRequest) (*user_and_post.ReportPostResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.ReportPostResponse{Status: user_and_post.ReportPostResponse_FORBIDDEN}, nil
+	}
+
+	var post model.Post
+	err := uaps.DB.First(&post, request.PostId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.ReportPostResponse{Status: user_and_post.ReportPostResponse_POST_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = uaps.DB.Create(&model.Report{
+		ReporterID: uint(request.GetUserId()),
+		PostID:     post.ID,
+		Reason:     request.GetReason(),
+	}).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &user_and_post.ReportPostResponse{Status: user_and_post.ReportPostResponse_ALREADY_REPORTED}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &user_and_post.ReportPostResponse{Status: user_and_post.ReportPostResponse_OK}, nil
+}
+
+// ListReports returns reports newest first
+func (uaps *UserAndPostService) ListReports(ctx context.Context, request *user_and_post.ListReportsRequest) (*user_and_post.ListReportsResponse, error) {
+	allowed, err := uaps.callerHasPermission(ctx, authz.PermissionListReports)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return &user_and_post.ListReportsResponse{Status: user_and_post.ListReportsResponse_FORBIDDEN}, nil
+	}
+
+	limit := int(request.GetLimit())
+	if limit <= 0 {
+		limit = defaultReportPageSize
+	} else if limit > maxReportPageSize {
+		limit = maxReportPageSize
+	}
+
+	query := uaps.DB.Order("id DESC").Limit(limit)
+	if !request.GetIncludeResolved() {
+		query = query.Where("resolved_at IS NULL")
+	}
+	if request.GetBeforeReportId() > 0 {
+		query = query.Where("id < ?", request.GetBeforeReportId())
+	}
+	var reports []model.Report
+	if err := query.Find(&reports).Error; err != nil {
+		return nil, err
+	}
+
+	infos := make([]*user_and_post.ReportInfo, 0, len(reports))
+	for _, report := range reports {
+		info := &user_and_post.ReportInfo{
+			ReportId:    int64(report.ID),
+			ReporterId:  int64(report.ReporterID),
+			PostId:      int64(report.PostID),
+			Reason:      report.Reason,
+			CreatedTime: timestamppb.New(report.CreatedAt),
+		}
+		if report.ResolvedAt != nil {
+			info.ResolvedTime = timestamppb.New(*report.ResolvedAt)
+		}
+		infos = append(infos, info)
+	}
+	return &user_and_post.ListReportsResponse{
+		Status:  user_and_post.ListReportsResponse_OK,
+		Reports: infos,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package user_and_post_service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/khailequang334/social_network/internal/authz"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func (uaps *UserAndPostService) ListPostRevisions(ctx context.Context, request *user_and_post.ListPostRevisionsRequest) (*user_and_post.ListPostRevisionsResponse, error) {
+	var post model.Post
+	err := uaps.DB.First(&post, request.GetPostId()).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.ListPostRevisionsResponse{Status: user_and_post.ListPostRevisionsResponse_POST_NOT_FOUND}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := uaps.canViewRevisions(ctx, &post)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return &user_and_post.ListPostRevisionsResponse{Status: user_and_post.ListPostRevisionsResponse_FORBIDDEN}, nil
+	}
+
+	var revisions []model.PostRevision
+	if err := uaps.DB.Where("post_id = ?", post.ID).Order("id DESC").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	response := &user_and_post.ListPostRevisionsResponse{Status: user_and_post.ListPostRevisionsResponse_OK}
+	for i := range revisions {
+		response.Revisions = append(response.Revisions, postRevisionMessage(&revisions[i]))
+	}
+	return response, nil
+}
+
+func (uaps *UserAndPostService) GetPostRevision(ctx context.Context, request *user_and_post.GetPostRevisionRequest) (*user_and_post.GetPostRevisionResponse, error) {
+	var post model.Post
+	err := uaps.DB.First(&post, request.GetPostId()).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.GetPostRevisionResponse{Status: user_and_post.GetPostRevisionResponse_POST_NOT_FOUND}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := uaps.canViewRevisions(ctx, &post)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return &user_and_post.GetPostRevisionResponse{Status: user_and_post.GetPostRevisionResponse_FORBIDDEN}, nil
+	}
+
+	var revision model.PostRevision
+	err = uaps.DB.Where("post_id = ?", post.ID).First(&revision, request.GetRevisionId()).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.GetPostRevisionResponse{Status: user_and_post.GetPostRevisionResponse_REVISION_NOT_FOUND}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user_and_post.GetPostRevisionResponse{
+		Status:   user_and_post.GetPostRevisionResponse_OK,
+		Revision: postRevisionMessage(&revision),
+	}, nil
+}
+
+// canViewRevisions lets the author and moderators see the edit history of a post
+func (uaps *UserAndPostService) canViewRevisions(ctx context.Context, post *model.Post) (bool, error) {
+	if isCaller(ctx, int64(post.UserID)) {
+		return true, nil
+	}
+	return uaps.callerHasPermission(ctx, authz.PermissionViewPostRevisions)
+}
+
+func postRevisionMessage(revision *model.PostRevision) *user_and_post.PostRevision {
+	return &user_and_post.PostRevision{
+		RevisionId:       int64(revision.ID),
+		PostId:           int64(revision.PostID),
+		EditorUserId:     int64(revision.EditorID),
+		ContentText:      revision.ContentText,
+		ContentImagePath: revision.ContentImagePath,
+		Audience:         user_and_post.Post_Audience(revision.Audience),
+		CreatedTime:      timestamppb.New(revision.CreatedAt),
+	}
+}
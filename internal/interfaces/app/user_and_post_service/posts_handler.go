@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/khailequang334/social_network/internal/identity"
 	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
 	"github.com/khailequang334/social_network/internal/model"
 	"go.uber.org/zap"
@@ -47,6 +48,10 @@ func (uaps *UserAndPostService) cachePost(ctx context.Context, postId int64, pos
 	return uaps.Redis.Set(ctx, cacheKey, data, cacheDuration).Err()
 }
 
+func (uaps *UserAndPostService) invalidatePostCache(ctx context.Context, postId int64) error {
+	return uaps.Redis.Del(ctx, "post:"+strconv.FormatInt(postId, 10)).Err()
+}
+
 func (uaps *UserAndPostService) GetPost(ctx context.Context, request *user_and_post.GetPostRequest) (*user_and_post.GetPostResponse, error) {
 	uaps.Logger.Debug("start get post")
 	defer uaps.Logger.Debug("end get post")
@@ -57,11 +62,27 @@ func (uaps *UserAndPostService) GetPost(ctx context.Context, request *user_and_p
 		uaps.Logger.Error("failed to get post from cache", zap.Error(err), zap.Int64("PostId", request.PostId))
 	}
 	if cachedPost != nil {
-		return cachedPost, nil
+		// the cached post outlives a deactivation whose invalidation failed,
+		// so the author is checked again
+		var activeAuthors int64
+		err := uaps.DB.Model(&model.User{}).Where("id = ? AND deactivated_at IS NULL", cachedPost.GetPost().GetUserId()).Count(&activeAuthors).Error
+		if err != nil {
+			return nil, err
+		}
+		if activeAuthors == 0 {
+			if err := uaps.invalidatePostCache(ctx, request.PostId); err != nil {
+				uaps.Logger.Error("failed to invalidate post cache", zap.Error(err), zap.Int64("PostId", request.PostId))
+			}
+			return &user_and_post.GetPostResponse{
+				Status: user_and_post.GetPostResponse_POST_NOT_FOUND,
+			}, nil
+		}
+		return uaps.hidePostFromCaller(ctx, cachedPost)
 	}
 
+	// posts of deleted accounts are hidden until the account is restored or purged
 	var post model.Post
-	err = uaps.DB.First(&post, request.PostId).Error
+	err = uaps.DB.Joins("JOIN user ON user.id = post.user_id AND user.deactivated_at IS NULL").First(&post, request.PostId).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return &user_and_post.GetPostResponse{
 			Status: user_and_post.GetPostResponse_POST_NOT_FOUND,
@@ -72,38 +93,85 @@ func (uaps *UserAndPostService) GetPost(ctx context.Context, request *user_and_p
 	}
 
 	// Cache the retrieved post
-	err = uaps.cachePost(ctx, request.PostId, &user_and_post.GetPostResponse{
+	response := &user_and_post.GetPostResponse{
 		Status: user_and_post.GetPostResponse_OK,
-		Post: &user_and_post.Post{
-			PostId:           int64(post.ID),
-			UserId:           int64(post.UserID),
-			ContentText:      post.ContentText,
-			ContentImagePath: post.ContentImagePath,
-			Visible:          post.Visible,
-			CreatedTime:      timestamppb.New(post.CreatedAt),
-		},
-	})
+		Post:   postMessage(&post),
+	}
+	err = uaps.cachePost(ctx, request.PostId, response)
 	if err != nil {
 		uaps.Logger.Error("failed to cache post", zap.Error(err), zap.Int64("PostId", request.PostId))
 	}
 
-	return &user_and_post.GetPostResponse{
-		Status: user_and_post.GetPostResponse_OK,
-		Post: &user_and_post.Post{
-			PostId:           int64(post.ID),
-			UserId:           int64(post.UserID),
-			ContentText:      post.ContentText,
-			ContentImagePath: post.ContentImagePath,
-			Visible:          post.Visible,
-			CreatedTime:      timestamppb.New(post.CreatedAt),
-		},
-	}, nil
+	return uaps.hidePostFromCaller(ctx, response)
+}
+
+func postMessage(post *model.Post) *user_and_post.Post {
+	message := &user_and_post.Post{
+		PostId:           int64(post.ID),
+		UserId:           int64(post.UserID),
+		ContentText:      post.ContentText,
+		ContentImagePath: post.ContentImagePath,
+		Audience:         user_and_post.Post_Audience(post.Audience),
+		CreatedTime:      timestamppb.New(post.CreatedAt),
+	}
+	if post.PinnedAt != nil {
+		message.PinnedTime = timestamppb.New(*post.PinnedAt)
+	}
+	if post.EditedAt != nil {
+		message.EditedTime = timestamppb.New(*post.EditedAt)
+	}
+	return message
+}
+
+// hidePostFromCaller reports the post as not found when the caller may not see it
+func (uaps *UserAndPostService) hidePostFromCaller(ctx context.Context, response *user_and_post.GetPostResponse) (*user_and_post.GetPostResponse, error) {
+	readable, err := uaps.postReadableByCaller(ctx, response.GetPost().GetUserId(), model.Audience(response.GetPost().GetAudience()))
+	if err != nil {
+		return nil, err
+	}
+	if !readable {
+		return &user_and_post.GetPostResponse{Status: user_and_post.GetPostResponse_POST_NOT_FOUND}, nil
+	}
+	return response, nil
+}
+
+// postReadableByCaller checks the audience of a post on top of the blocks and
+// the private flag of its author, it is the single post counterpart of
+// model.PostsReadableBy. Audiences it does not know are treated as only me
+func (uaps *UserAndPostService) postReadableByCaller(ctx context.Context, authorId int64, audience model.Audience) (bool, error) {
+	callerId, ok := identity.CallerIdFromContext(ctx)
+	if ok && callerId == authorId {
+		return true, nil
+	}
+	hidden, err := uaps.hiddenFromCaller(ctx, authorId)
+	if err != nil || hidden {
+		return false, err
+	}
+
+	switch {
+	case audience == model.AudiencePublic:
+		return true, nil
+	case !ok:
+		return false, nil
+	case audience == model.AudienceFollowers:
+		return uaps.isFollowing(callerId, authorId)
+	case audience == model.AudienceCloseFriends:
+		return uaps.isCloseFriend(authorId, callerId)
+	default:
+		return false, nil
+	}
 }
 
 func (uaps *UserAndPostService) CreatePost(ctx context.Context, request *user_and_post.CreatePostRequest) (*user_and_post.CreatePostResponse, error) {
 	uaps.Logger.Debug("start create post")
 	defer uaps.Logger.Debug("end create post")
 
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.CreatePostResponse{
+			Status: user_and_post.CreatePostResponse_FORBIDDEN,
+		}, nil
+	}
+
 	err := uaps.ensureUserExist(request.UserId)
 	if err != nil {
 		return &user_and_post.CreatePostResponse{
@@ -121,7 +189,7 @@ func (uaps *UserAndPostService) CreatePost(ctx context.Context, request *user_an
 		ContentText:      request.ContentText,
 		ContentImagePath: request.ContentImagePath,
 		UserID:           uint(request.UserId),
-		Visible:          request.Visible,
+		Audience:         model.Audience(request.GetAudience()),
 	}
 
 	err = uaps.DB.Model(&user).Association("Posts").Append(&post)
@@ -145,14 +213,24 @@ func (uaps *UserAndPostService) DeletePost(ctx context.Context, request *user_an
 	if err != nil {
 		return nil, err
 	}
+	if !isCaller(ctx, int64(post.UserID)) {
+		return &user_and_post.DeletePostResponse{
+			Status: user_and_post.DeletePostResponse_FORBIDDEN,
+		}, nil
+	}
+
 	err = uaps.DB.Delete(&post).Error
 	if err != nil {
 		return nil, err
 	}
+	if err := uaps.invalidatePostCache(ctx, int64(post.ID)); err != nil {
+		uaps.Logger.Error("failed to invalidate post cache", zap.Error(err), zap.Uint("postID", post.ID))
+	}
 	return &user_and_post.DeletePostResponse{Status: user_and_post.DeletePostResponse_OK}, nil
 }
 
-// EditPost edit post
+// EditPost edit post, the content it replaces is kept as a revision. Edits
+// that change nothing are not recorded
 func (uaps *UserAndPostService) EditPost(ctx context.Context, request *user_and_post.EditPostRequest) (*user_and_post.EditPostResponse, error) {
 	uaps.Logger.Debug("start edit post", zap.Any("request", request))
 	defer uaps.Logger.Debug("end edit post")
@@ -168,30 +246,62 @@ func (uaps *UserAndPostService) EditPost(ctx context.Context, request *user_and_
 		return nil, err
 	}
 	uaps.Logger.Debug("post", zap.Any("post", post))
+	if !isCaller(ctx, int64(post.UserID)) {
+		return &user_and_post.EditPostResponse{
+			Status: user_and_post.EditPostResponse_FORBIDDEN,
+		}, nil
+	}
 
+	editorId, _ := identity.CallerIdFromContext(ctx)
+	revision := model.PostRevision{
+		PostID:           post.ID,
+		EditorID:         uint(editorId),
+		ContentText:      post.ContentText,
+		ContentImagePath: post.ContentImagePath,
+		Audience:         post.Audience,
+	}
 	if request.ContentText != nil {
 		post.ContentText = request.GetContentText()
 	}
 	if request.ContentImagePath != nil {
 		post.ContentImagePath = request.GetContentImagePath()
 	}
-	if request.Visible != nil {
-		post.Visible = request.GetVisible()
+	if request.Audience != nil {
+		post.Audience = model.Audience(request.GetAudience())
+	}
+	if post.ContentText == revision.ContentText && post.ContentImagePath == revision.ContentImagePath && post.Audience == revision.Audience {
+		return &user_and_post.EditPostResponse{Status: user_and_post.EditPostResponse_OK}, nil
 	}
+	editedAt := time.Now()
+	post.EditedAt = &editedAt
 	uaps.Logger.Debug("updated post", zap.Any("post", post))
 
-	err = uaps.DB.Save(&post).Error
+	err = uaps.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&revision).Error; err != nil {
+			return err
+		}
+		return tx.Save(&post).Error
+	})
 	if err != nil {
 		return nil, err
 	}
+	if err := uaps.invalidatePostCache(ctx, int64(post.ID)); err != nil {
+		uaps.Logger.Error("failed to invalidate post cache", zap.Error(err), zap.Uint("postID", post.ID))
+	}
 	return &user_and_post.EditPostResponse{Status: user_and_post.EditPostResponse_OK}, nil
 }
 
-// CreatePostComment create post comment
-func (uaps *UserAndPostService) CreatePostComment(ctx context.Context, request *user_and_post.CommentPostRequest) (*user_and_post.CommentPostResponse, error) {
+// CommentPost create post comment
+func (uaps *UserAndPostService) CommentPost(ctx context.Context, request *user_and_post.CommentPostRequest) (*user_and_post.CommentPostResponse, error) {
 	uaps.Logger.Debug("start create post comment")
 	defer uaps.Logger.Debug("end create post comment")
 
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.CommentPostResponse{
+			Status: user_and_post.CommentPostResponse_FORBIDDEN,
+		}, nil
+	}
+
 	err := uaps.ensureUserExist(request.UserId)
 	if err != nil {
 		return &user_and_post.CommentPostResponse{
@@ -211,6 +321,25 @@ func (uaps *UserAndPostService) CreatePostComment(ctx context.Context, request *
 		return nil, err
 	}
 	uaps.Logger.Debug("post found", zap.Any("post", post))
+
+	blocked, err := uaps.isBlockedBetween(request.UserId, int64(post.UserID))
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return &user_and_post.CommentPostResponse{
+			Status: user_and_post.CommentPostResponse_BLOCKED,
+		}, nil
+	}
+	readable, err := uaps.postReadableByCaller(ctx, int64(post.UserID), post.Audience)
+	if err != nil {
+		return nil, err
+	}
+	if !readable {
+		return &user_and_post.CommentPostResponse{
+			Status: user_and_post.CommentPostResponse_POST_NOT_FOUND,
+		}, nil
+	}
 	uaps.DB.Preload("Comments").Find(&post, request.PostId)
 
 	comment := model.Comment{
@@ -231,9 +360,15 @@ func (uaps *UserAndPostService) LikePost(ctx context.Context, request *user_and_
 	uaps.Logger.Debug("start like post")
 	defer uaps.Logger.Debug("end like post")
 
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.LikePostResponse{
+			Status: user_and_post.LikePostResponse_FORBIDDEN,
+		}, nil
+	}
+
 	var err error
 	var user model.User
-	err = uaps.DB.First(&user, request.UserId).Error
+	err = uaps.DB.Where("deactivated_at IS NULL AND purged_at IS NULL").First(&user, request.UserId).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return &user_and_post.LikePostResponse{
 			Status: user_and_post.LikePostResponse_USER_NOT_FOUND,
@@ -251,6 +386,25 @@ func (uaps *UserAndPostService) LikePost(ctx context.Context, request *user_and_
 		return nil, err
 	}
 
+	blocked, err := uaps.isBlockedBetween(request.UserId, int64(post.UserID))
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return &user_and_post.LikePostResponse{
+			Status: user_and_post.LikePostResponse_BLOCKED,
+		}, nil
+	}
+	readable, err := uaps.postReadableByCaller(ctx, int64(post.UserID), post.Audience)
+	if err != nil {
+		return nil, err
+	}
+	if !readable {
+		return &user_and_post.LikePostResponse{
+			Status: user_and_post.LikePostResponse_POST_NOT_FOUND,
+		}, nil
+	}
+
 	err = uaps.DB.Preload("LikedUsers").Find(&post, request.PostId).Error
 	if err != nil {
 		return nil, err
@@ -0,0 +1,212 @@
+package user_and_post_service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/khailequang334/social_network/internal/identity"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"gorm.io/gorm"
+)
+
+// maxProfilesPerRequest bounds GetUsers, a rendered feed page needs far fewer authors
+const maxProfilesPerRequest = 100
+
+func (uaps *UserAndPostService) GetUser(ctx context.Context, request *user_and_post.GetUserRequest) (*user_and_post.GetUserResponse, error) {
+	return uaps.getUserProfile(ctx, uaps.DB.Where("id = ?", request.GetUserId()))
+}
+
+// GetUserByUserName falls back to the rename history, an old name resolves to
+// its user until it is released
+func (uaps *UserAndPostService) GetUserByUserName(ctx context.Context, request *user_and_post.GetUserByUserNameRequest) (*user_and_post.GetUserResponse, error) {
+	response, err := uaps.getUserProfile(ctx, uaps.DB.Where("user_name = ?", request.GetUserName()))
+	if err != nil || response.GetStatus() != user_and_post.GetUserResponse_USER_NOT_FOUND {
+		return response, err
+	}
+
+	var held model.UserNameHistory
+	err = uaps.DB.Where("user_name = ? AND released_at > ?", request.GetUserName(), time.Now()).Order("created_at DESC").First(&held).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return response, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	response, err = uaps.getUserProfile(ctx, uaps.DB.Where("id = ?", held.UserID))
+	if err != nil {
+		return nil, err
+	}
+	if response.GetStatus() == user_and_post.GetUserResponse_OK {
+		response.RedirectedFrom = request.GetUserName()
+	}
+	return response, nil
+}
+
+// GetUsers hydrates many profiles in one round trip, missing users are skipped rather than failing the batch
+func (uaps *UserAndPostService) GetUsers(ctx context.Context, request *user_and_post.GetUsersRequest) (*user_and_post.GetUsersResponse, error) {
+	if len(request.GetUserIds()) > maxProfilesPerRequest {
+		return &user_and_post.GetUsersResponse{Status: user_and_post.GetUsersResponse_TOO_MANY_USERS}, nil
+	}
+	if len(request.GetUserIds()) == 0 {
+		return &user_and_post.GetUsersResponse{Status: user_and_post.GetUsersResponse_OK}, nil
+	}
+
+	var users []model.User
+	if err := uaps.DB.Scopes(visibleUsers).Where("id IN ?", request.GetUserIds()).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	profiles, err := uaps.buildProfiles(ctx, users)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[int64]*user_and_post.UserProfile, len(profiles))
+	for _, profile := range profiles {
+		byId[profile.UserId] = profile
+	}
+	ordered := make([]*user_and_post.UserProfile, 0, len(profiles))
+	for _, userId := range request.GetUserIds() {
+		if profile, ok := byId[userId]; ok {
+			ordered = append(ordered, profile)
+			// a repeated id is only returned once
+			delete(byId, userId)
+		}
+	}
+	return &user_and_post.GetUsersResponse{Status: user_and_post.GetUsersResponse_OK, Profiles: ordered}, nil
+}
+
+// visibleUsers leaves out suspended and deleted users, their profiles disappear with them
+func visibleUsers(db *gorm.DB) *gorm.DB {
+	return db.Where("suspended_at IS NULL AND deactivated_at IS NULL")
+}
+
+func (uaps *UserAndPostService) getUserProfile(ctx context.Context, query *gorm.DB) (*user_and_post.GetUserResponse, error) {
+	var user model.User
+	err := query.Scopes(visibleUsers).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.GetUserResponse{Status: user_and_post.GetUserResponse_USER_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	profiles, err := uaps.buildProfiles(ctx, []model.User{user})
+	if err != nil {
+		return nil, err
+	}
+	return &user_and_post.GetUserResponse{Status: user_and_post.GetUserResponse_OK, Profile: profiles[0]}, nil
+}
+
+// buildProfiles loads the counters of all users with one grouped query each and
+// applies the field visibility of every user for the caller
+func (uaps *UserAndPostService) buildProfiles(ctx context.Context, users []model.User) ([]*user_and_post.UserProfile, error) {
+	userIds := make([]int64, 0, len(users))
+	for _, user := range users {
+		userIds = append(userIds, int64(user.ID))
+	}
+
+	followerCounts, err := countByUser(uaps.DB.Table("following"), "friend_id", userIds)
+	if err != nil {
+		return nil, err
+	}
+	followingCounts, err := countByUser(uaps.DB.Table("following"), "user_id", userIds)
+	if err != nil {
+		return nil, err
+	}
+	// post counts only include the posts the caller may read
+	callerId, hasCaller := identity.CallerIdFromContext(ctx)
+	postCounts, err := countByUser(uaps.DB.Model(&model.Post{}).Scopes(model.PostsReadableBy(callerId)), "user_id", userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	followedByCaller := make(map[int64]bool)
+	if hasCaller {
+		var followedIds []int64
+		err := uaps.DB.Table("following").Where("user_id = ? AND friend_id IN ?", callerId, userIds).Pluck("friend_id", &followedIds).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, followedId := range followedIds {
+			followedByCaller[followedId] = true
+		}
+	}
+
+	profiles := make([]*user_and_post.UserProfile, 0, len(users))
+	for _, user := range users {
+		userId := int64(user.ID)
+		isOwner := hasCaller && callerId == userId
+		// canSee hides a field the caller is not allowed to see by returning it empty
+		canSee := func(visibility model.Visibility) bool {
+			return isOwner || visibility == model.VisibilityPublic ||
+				(visibility == model.VisibilityFollowers && followedByCaller[userId])
+		}
+
+		profile := &user_and_post.UserProfile{
+			UserId:           userId,
+			UserName:         user.UserName,
+			FirstName:        user.FirstName,
+			LastName:         user.LastName,
+			DisplayName:      displayName(&user),
+			FollowerCount:    followerCounts[userId],
+			FollowingCount:   followingCounts[userId],
+			PostCount:        postCounts[userId],
+			FollowedByCaller: followedByCaller[userId],
+			Private:          user.Private,
+		}
+		if canSee(user.BioVisibility) {
+			profile.Bio = user.Bio
+		}
+		if canSee(user.AvatarVisibility) {
+			profile.AvatarPath = user.AvatarPath
+		}
+		if canSee(user.LocationVisibility) {
+			profile.Location = user.Location
+		}
+		if canSee(user.WebsiteVisibility) {
+			profile.Website = user.Website
+		}
+		if canSee(user.PronounsVisibility) {
+			profile.Pronouns = user.Pronouns
+		}
+		if isOwner {
+			profile.Visibility = &user_and_post.ProfileVisibility{
+				Bio:      user_and_post.Visibility(user.BioVisibility),
+				Avatar:   user_and_post.Visibility(user.AvatarVisibility),
+				Location: user_and_post.Visibility(user.LocationVisibility),
+				Website:  user_and_post.Visibility(user.WebsiteVisibility),
+				Pronouns: user_and_post.Visibility(user.PronounsVisibility),
+			}
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// countByUser counts the rows of query per value of column, users without rows are absent from the map
+func countByUser(query *gorm.DB, column string, userIds []int64) (map[int64]int64, error) {
+	var rows []struct {
+		UserId int64
+		Count  int64
+	}
+	err := query.Select(column+" AS user_id, COUNT(*) AS count").Where(column+" IN ?", userIds).Group(column).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.UserId] = row.Count
+	}
+	return counts, nil
+}
+
+// displayName falls back to the user name for accounts without a first name
+func displayName(user *model.User) string {
+	name := strings.TrimSpace(user.FirstName + " " + user.LastName)
+	if name == "" {
+		return user.UserName
+	}
+	return name
+}
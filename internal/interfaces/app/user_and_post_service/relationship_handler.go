@@ -0,0 +1,102 @@
+package user_and_post_service
+
+import (
+	"context"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"gorm.io/gorm"
+)
+
+// maxRelationshipsPerRequest bounds GetRelationships, like GetUsers it serves one rendered page
+const maxRelationshipsPerRequest = 100
+
+func (uaps *UserAndPostService) GetRelationship(ctx context.Context, request *user_and_post.GetRelationshipRequest) (*user_and_post.GetRelationshipResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.GetRelationshipResponse{Status: user_and_post.GetRelationshipResponse_FORBIDDEN}, nil
+	}
+	if err := uaps.ensureUserExist(request.GetTargetUserId()); err != nil {
+		return &user_and_post.GetRelationshipResponse{Status: user_and_post.GetRelationshipResponse_USER_NOT_FOUND}, nil
+	}
+
+	relationships, err := uaps.relationships(request.GetUserId(), []int64{request.GetTargetUserId()})
+	if err != nil {
+		return nil, err
+	}
+	return &user_and_post.GetRelationshipResponse{
+		Status:       user_and_post.GetRelationshipResponse_OK,
+		Relationship: relationships[0],
+	}, nil
+}
+
+func (uaps *UserAndPostService) GetRelationships(ctx context.Context, request *user_and_post.GetRelationshipsRequest) (*user_and_post.GetRelationshipsResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.GetRelationshipsResponse{Status: user_and_post.GetRelationshipsResponse_FORBIDDEN}, nil
+	}
+	if len(request.GetTargetUserIds()) > maxRelationshipsPerRequest {
+		return &user_and_post.GetRelationshipsResponse{Status: user_and_post.GetRelationshipsResponse_TOO_MANY_USERS}, nil
+	}
+
+	seen := make(map[int64]bool)
+	targetIds := make([]int64, 0, len(request.GetTargetUserIds()))
+	for _, targetId := range request.GetTargetUserIds() {
+		if !seen[targetId] {
+			seen[targetId] = true
+			targetIds = append(targetIds, targetId)
+		}
+	}
+
+	relationships, err := uaps.relationships(request.GetUserId(), targetIds)
+	if err != nil {
+		return nil, err
+	}
+	return &user_and_post.GetRelationshipsResponse{
+		Status:        user_and_post.GetRelationshipsResponse_OK,
+		Relationships: relationships,
+	}, nil
+}
+
+// relationships answers each flag with one query on the primary key or the
+// reverse index of its table, whatever the number of targets
+func (uaps *UserAndPostService) relationships(userId int64, targetIds []int64) ([]*user_and_post.Relationship, error) {
+	if len(targetIds) == 0 {
+		return []*user_and_post.Relationship{}, nil
+	}
+
+	lookups := []struct {
+		query        *gorm.DB
+		targetColumn string
+		result       map[int64]bool
+	}{
+		{uaps.DB.Table("following").Where("user_id = ? AND friend_id IN ?", userId, targetIds), "friend_id", nil},
+		{uaps.DB.Table("following").Where("friend_id = ? AND user_id IN ?", userId, targetIds), "user_id", nil},
+		{uaps.DB.Model(&model.Block{}).Where("blocker_id = ? AND blocked_id IN ?", userId, targetIds), "blocked_id", nil},
+		{uaps.DB.Model(&model.Mute{}).Where("muter_id = ? AND muted_id IN ?", userId, targetIds), "muted_id", nil},
+		{uaps.DB.Model(&model.FollowRequest{}).Where("requester_id = ? AND target_id IN ?", userId, targetIds), "target_id", nil},
+	}
+	for i := range lookups {
+		var ids []int64
+		if err := lookups[i].query.Pluck(lookups[i].targetColumn, &ids).Error; err != nil {
+			return nil, err
+		}
+		lookups[i].result = make(map[int64]bool, len(ids))
+		for _, id := range ids {
+			lookups[i].result[id] = true
+		}
+	}
+	following, followedBy, blocking, muting, requested := lookups[0].result, lookups[1].result, lookups[2].result, lookups[3].result, lookups[4].result
+
+	relationships := make([]*user_and_post.Relationship, 0, len(targetIds))
+	for _, targetId := range targetIds {
+		relationships = append(relationships, &user_and_post.Relationship{
+			TargetUserId:    targetId,
+			Following:       following[targetId],
+			FollowedBy:      followedBy[targetId],
+			Mutual:          following[targetId] && followedBy[targetId],
+			Blocking:        blocking[targetId],
+			Muting:          muting[targetId],
+			FollowRequested: requested[targetId],
+		})
+	}
+	return relationships, nil
+}
@@ -0,0 +1,325 @@
+package user_and_post_service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"github.com/khailequang334/social_network/internal/totp"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const recoveryCodeCount = 10
+
+func secondFactorChallengeKey(challengeId string) string {
+	return "second_factor_challenge:" + challengeId
+}
+
+func secondFactorAttemptsKey(challengeId string) string {
+	return "second_factor_attempts:" + challengeId
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.ReplaceAll(code, "-", ""))))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateRecoveryCode returns a code formatted as xxxxx-xxxxx
+func generateRecoveryCode() (string, error) {
+	raw := make([]byte, 10)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
+
+// getConfirmedSecondFactor returns nil when the user has not enabled 2FA
+func (uaps *UserAndPostService) getConfirmedSecondFactor(userId uint) (*model.TwoFactor, error) {
+	var twoFactor model.TwoFactor
+	err := uaps.DB.Where("user_id = ? AND confirmed_at IS NOT NULL", userId).First(&twoFactor).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &twoFactor, nil
+}
+
+// replaceRecoveryCodes drops the old recovery codes of the user and returns a fresh set
+func (uaps *UserAndPostService) replaceRecoveryCodes(tx *gorm.DB, userId uint) ([]string, error) {
+	err := tx.Unscoped().Where("user_id = ?", userId).Delete(&model.RecoveryCode{}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, recoveryCodeCount)
+	rows := make([]model.RecoveryCode, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		rows = append(rows, model.RecoveryCode{UserID: userId, CodeHash: hashRecoveryCode(code)})
+	}
+	if err := tx.Create(&rows).Error; err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// createSecondFactorChallenge is called by AuthenticateUser once the password is verified
+func (uaps *UserAndPostService) createSecondFactorChallenge(ctx context.Context, userId uint) (string, error) {
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	challengeId := hex.EncodeToString(raw)
+	err := uaps.Redis.Set(ctx, secondFactorChallengeKey(challengeId), userId, uaps.TwoFactor.ChallengeTTL).Err()
+	if err != nil {
+		return "", err
+	}
+	return challengeId, nil
+}
+
+// EnrollSecondFactor generates a new TOTP secret, it is only active after ConfirmSecondFactor
+func (uaps *UserAndPostService) EnrollSecondFactor(ctx context.Context, request *user_and_post.EnrollSecondFactorRequest) (*user_and_post.EnrollSecondFactorResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.EnrollSecondFactorResponse{Status: user_and_post.EnrollSecondFactorResponse_FORBIDDEN}, nil
+	}
+
+	var user model.User
+	err := uaps.DB.First(&user, request.UserId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.EnrollSecondFactorResponse{Status: user_and_post.EnrollSecondFactorResponse_USER_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	confirmed, err := uaps.getConfirmedSecondFactor(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if confirmed != nil {
+		return &user_and_post.EnrollSecondFactorResponse{Status: user_and_post.EnrollSecondFactorResponse_ALREADY_ENABLED}, nil
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	// a pending enrollment is replaced, only the latest secret can be confirmed
+	err = uaps.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("user_id = ?", user.ID).Delete(&model.TwoFactor{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.TwoFactor{UserID: user.ID, Secret: secret}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_and_post.EnrollSecondFactorResponse{
+		Status:     user_and_post.EnrollSecondFactorResponse_OK,
+		Secret:     secret,
+		OtpauthUri: totp.URI(uaps.TwoFactor.Issuer, user.UserName, secret),
+	}, nil
+}
+
+// ConfirmSecondFactor enables 2FA once the user proves the authenticator works
+func (uaps *UserAndPostService) ConfirmSecondFactor(ctx context.Context, request *user_and_post.ConfirmSecondFactorRequest) (*user_and_post.ConfirmSecondFactorResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.ConfirmSecondFactorResponse{Status: user_and_post.ConfirmSecondFactorResponse_FORBIDDEN}, nil
+	}
+
+	var twoFactor model.TwoFactor
+	err := uaps.DB.Where("user_id = ?", request.UserId).First(&twoFactor).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.ConfirmSecondFactorResponse{Status: user_and_post.ConfirmSecondFactorResponse_NOT_ENROLLED}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if twoFactor.ConfirmedAt != nil {
+		return &user_and_post.ConfirmSecondFactorResponse{Status: user_and_post.ConfirmSecondFactorResponse_ALREADY_ENABLED}, nil
+	}
+
+	step, ok := totp.Validate(twoFactor.Secret, request.GetCode(), time.Now(), 1)
+	if !ok {
+		return &user_and_post.ConfirmSecondFactorResponse{Status: user_and_post.ConfirmSecondFactorResponse_INVALID_CODE}, nil
+	}
+
+	var codes []string
+	err = uaps.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		twoFactor.ConfirmedAt = &now
+		twoFactor.LastUsedStep = step
+		if err := tx.Save(&twoFactor).Error; err != nil {
+			return err
+		}
+		codes, err = uaps.replaceRecoveryCodes(tx, twoFactor.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_and_post.ConfirmSecondFactorResponse{
+		Status:        user_and_post.ConfirmSecondFactorResponse_OK,
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// GenerateRecoveryCodes invalidates all previous recovery codes of the user
+func (uaps *UserAndPostService) GenerateRecoveryCodes(ctx context.Context, request *user_and_post.GenerateRecoveryCodesRequest) (*user_and_post.GenerateRecoveryCodesResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.GenerateRecoveryCodesResponse{Status: user_and_post.GenerateRecoveryCodesResponse_FORBIDDEN}, nil
+	}
+
+	twoFactor, err := uaps.getConfirmedSecondFactor(uint(request.UserId))
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor == nil {
+		return &user_and_post.GenerateRecoveryCodesResponse{Status: user_and_post.GenerateRecoveryCodesResponse_NOT_ENABLED}, nil
+	}
+
+	var codes []string
+	err = uaps.DB.Transaction(func(tx *gorm.DB) error {
+		codes, err = uaps.replaceRecoveryCodes(tx, twoFactor.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_and_post.GenerateRecoveryCodesResponse{
+		Status:        user_and_post.GenerateRecoveryCodesResponse_OK,
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// verifyCode accepts a TOTP code not used before or an unused recovery code
+func (uaps *UserAndPostService) verifyCode(twoFactor *model.TwoFactor, code string) (bool, error) {
+	step, ok := totp.Validate(twoFactor.Secret, code, time.Now(), 1)
+	if ok {
+		if step <= twoFactor.LastUsedStep {
+			return false, nil
+		}
+		// conditional update so two concurrent logins can not use the same code
+		result := uaps.DB.Model(&model.TwoFactor{}).
+			Where("id = ? AND last_used_step < ?", twoFactor.ID, step).
+			Update("last_used_step", step)
+		return result.RowsAffected == 1, result.Error
+	}
+
+	result := uaps.DB.Model(&model.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", twoFactor.UserID, hashRecoveryCode(code)).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+// VerifySecondFactor completes a login started by AuthenticateUser
+func (uaps *UserAndPostService) VerifySecondFactor(ctx context.Context, request *user_and_post.VerifySecondFactorRequest) (*user_and_post.VerifySecondFactorResponse, error) {
+	challengeKey := secondFactorChallengeKey(request.GetChallengeId())
+	userId, err := uaps.Redis.Get(ctx, challengeKey).Int64()
+	if err == redis.Nil {
+		return &user_and_post.VerifySecondFactorResponse{Status: user_and_post.VerifySecondFactorResponse_CHALLENGE_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var user model.User
+	err = uaps.DB.Select("id", "user_name").First(&user, userId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		uaps.Redis.Del(ctx, challengeKey)
+		return &user_and_post.VerifySecondFactorResponse{Status: user_and_post.VerifySecondFactorResponse_CHALLENGE_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// the lockout of AuthenticateUser also covers the second step, a locked
+	// username can not finish a login it started before
+	attemptsKey := secondFactorAttemptsKey(request.GetChallengeId())
+	locked, retryAfter, err := uaps.checkLoginAllowed(ctx, user.UserName, request.GetClientIp())
+	if err != nil {
+		return nil, err
+	}
+	if locked {
+		uaps.Redis.Del(ctx, challengeKey, attemptsKey)
+		return &user_and_post.VerifySecondFactorResponse{
+			Status:            user_and_post.VerifySecondFactorResponse_ACCOUNT_LOCKED,
+			RetryAfterSeconds: retryAfterSeconds(retryAfter),
+		}, nil
+	}
+
+	// a challenge only survives a few wrong codes
+	pipe := uaps.Redis.TxPipeline()
+	attempts := pipe.Incr(ctx, attemptsKey)
+	pipe.Expire(ctx, attemptsKey, uaps.TwoFactor.ChallengeTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	if attempts.Val() > uaps.TwoFactor.MaxAttempts {
+		uaps.Redis.Del(ctx, challengeKey, attemptsKey)
+		return uaps.secondFactorFailure(ctx, user.UserName, request.GetClientIp(), user_and_post.VerifySecondFactorResponse_CHALLENGE_NOT_FOUND)
+	}
+
+	twoFactor, err := uaps.getConfirmedSecondFactor(uint(userId))
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor == nil {
+		uaps.Logger.Error("second factor challenge for user without second factor", zap.Int64("userID", userId))
+		return &user_and_post.VerifySecondFactorResponse{Status: user_and_post.VerifySecondFactorResponse_CHALLENGE_NOT_FOUND}, nil
+	}
+
+	ok, err := uaps.verifyCode(twoFactor, request.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return uaps.secondFactorFailure(ctx, user.UserName, request.GetClientIp(), user_and_post.VerifySecondFactorResponse_INVALID_CODE)
+	}
+
+	if err := uaps.Redis.Del(ctx, challengeKey, attemptsKey).Err(); err != nil {
+		return nil, err
+	}
+	if err := uaps.resetLoginFailures(ctx, user.UserName); err != nil {
+		return nil, err
+	}
+	uaps.Logger.Info("second factor verified", zap.Int64("userID", userId))
+	if err := uaps.restoreAccount(ctx, uint(userId)); err != nil {
+		return nil, err
+	}
+	return &user_and_post.VerifySecondFactorResponse{
+		Status: user_and_post.VerifySecondFactorResponse_OK,
+		UserId: userId,
+	}, nil
+}
+
+// secondFactorFailure counts a wrong code like a wrong password, so the
+// username locks after the same number of failures whichever step they hit
+func (uaps *UserAndPostService) secondFactorFailure(ctx context.Context, userName string, clientIp string, status user_and_post.VerifySecondFactorResponse_VerifySecondFactorStatus) (*user_and_post.VerifySecondFactorResponse, error) {
+	locked, err := uaps.recordLoginFailure(ctx, userName, clientIp)
+	if err != nil {
+		return nil, err
+	}
+	if locked {
+		return &user_and_post.VerifySecondFactorResponse{
+			Status:            user_and_post.VerifySecondFactorResponse_ACCOUNT_LOCKED,
+			RetryAfterSeconds: retryAfterSeconds(uaps.LoginProtection.LockoutDuration),
+		}, nil
+	}
+	return &user_and_post.VerifySecondFactorResponse{Status: status}, nil
+}
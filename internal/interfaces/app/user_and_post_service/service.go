@@ -1,12 +1,18 @@
 package user_and_post_service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/khailequang334/social_network/configs"
+	"github.com/khailequang334/social_network/internal/authz"
+	"github.com/khailequang334/social_network/internal/identity"
 	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
 	"github.com/khailequang334/social_network/internal/logger"
+	"github.com/khailequang334/social_network/internal/mailer"
+	"github.com/khailequang334/social_network/internal/password"
+	"github.com/khailequang334/social_network/internal/validation"
 	"go.uber.org/zap"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -14,13 +20,26 @@ import (
 
 type UserAndPostService struct {
 	user_and_post.UnimplementedUserAndPostServer
-	DB     *gorm.DB
-	Redis  *redis.Client
-	Logger *zap.Logger
+	DB                *gorm.DB
+	Redis             *redis.Client
+	Logger            *zap.Logger
+	LoginProtection   configs.LoginProtectionConfig
+	TwoFactor         configs.TwoFactorConfig
+	AccountTokens     configs.AccountTokensConfig
+	Mailer            mailer.Mailer
+	Passwords         password.Hasher
+	PasswordPolicy    *validation.PasswordPolicy
+	Authz             authz.Checker
+	AccountDeletion   configs.AccountDeletionConfig
+	DataExport        configs.DataExportConfig
+	UserNameChange    configs.UserNameChangeConfig
+	FollowSuggestions configs.FollowSuggestionsConfig
+
+	dataExportQueued chan struct{}
 }
 
 func NewUserAndPostService(conf *configs.UserAndPostConfig) (*UserAndPostService, error) {
-	db, err := gorm.Open(mysql.New(conf.MySQL), &gorm.Config{})
+	db, err := gorm.Open(mysql.New(conf.MySQL), &gorm.Config{TranslateError: true})
 	if err != nil {
 		fmt.Println("can not connect to db ", err)
 		return nil, err
@@ -35,9 +54,52 @@ func NewUserAndPostService(conf *configs.UserAndPostConfig) (*UserAndPostService
 	if err != nil {
 		return nil, err
 	}
+
+	mail, err := mailer.NewMailer(conf.Mailer)
+	if err != nil {
+		return nil, err
+	}
+
+	passwords, err := password.NewHasher(conf.PasswordHashing)
+	if err != nil {
+		return nil, err
+	}
+
+	passwordPolicy, err := validation.NewPasswordPolicy(conf.PasswordPolicy, passwords.MaxPasswordBytes())
+	if err != nil {
+		return nil, err
+	}
 	return &UserAndPostService{
-		DB:     db,
-		Redis:  rd,
-		Logger: zapLogger,
+		DB:                db,
+		Redis:             rd,
+		Logger:            zapLogger,
+		LoginProtection:   conf.LoginProtection,
+		TwoFactor:         conf.TwoFactor,
+		AccountTokens:     conf.AccountTokens,
+		Mailer:            mail,
+		Passwords:         passwords,
+		PasswordPolicy:    passwordPolicy,
+		Authz:             authz.NewDBChecker(db),
+		AccountDeletion:   conf.AccountDeletion,
+		DataExport:        conf.DataExport,
+		UserNameChange:    conf.UserNameChange,
+		FollowSuggestions: conf.FollowSuggestions,
+
+		dataExportQueued: make(chan struct{}, 1),
 	}, nil
 }
+
+// isCaller reports whether the authenticated caller of the rpc is the given user
+func isCaller(ctx context.Context, userId int64) bool {
+	callerId, ok := identity.CallerIdFromContext(ctx)
+	return ok && callerId == userId
+}
+
+// callerHasPermission reports whether the authenticated caller of the rpc holds the permission
+func (uaps *UserAndPostService) callerHasPermission(ctx context.Context, permission string) (bool, error) {
+	callerId, ok := identity.CallerIdFromContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	return uaps.Authz.HasPermission(ctx, callerId, permission)
+}
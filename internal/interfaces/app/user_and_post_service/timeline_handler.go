@@ -0,0 +1,149 @@
+package user_and_post_service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/khailequang334/social_network/internal/identity"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultPostPageSize = 20
+	maxPostPageSize     = 100
+	// maxPinnedPosts bounds how many posts a user can pin to their profile
+	maxPinnedPosts = 3
+)
+
+// ListUserPosts pages through the profile timeline of a user. Posts are left
+// out when the caller is not part of their audience, a private account the
+// caller does not follow or a block hides the whole timeline
+func (uaps *UserAndPostService) ListUserPosts(ctx context.Context, request *user_and_post.ListUserPostsRequest) (*user_and_post.ListUserPostsResponse, error) {
+	var user model.User
+	err := uaps.DB.Scopes(visibleUsers).Select("id").First(&user, request.GetUserId()).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.ListUserPostsResponse{Status: user_and_post.ListUserPostsResponse_USER_NOT_FOUND}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	hidden, err := uaps.hiddenFromCaller(ctx, request.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if hidden {
+		return &user_and_post.ListUserPostsResponse{Status: user_and_post.ListUserPostsResponse_HIDDEN}, nil
+	}
+
+	after, err := decodePageCursor(request.GetCursor())
+	if err != nil {
+		return &user_and_post.ListUserPostsResponse{Status: user_and_post.ListUserPostsResponse_INVALID_CURSOR}, nil
+	}
+	pageSize := pageLimit(request.GetLimit(), defaultPostPageSize, maxPostPageSize)
+	// anonymous callers only read public posts
+	callerId, _ := identity.CallerIdFromContext(ctx)
+
+	response := &user_and_post.ListUserPostsResponse{Status: user_and_post.ListUserPostsResponse_OK}
+	if after == nil {
+		var pinned []model.Post
+		err := uaps.DB.Scopes(model.PostsReadableBy(callerId)).
+			Where("user_id = ? AND pinned_at IS NOT NULL", request.GetUserId()).
+			Order("pinned_at DESC").
+			Find(&pinned).Error
+		if err != nil {
+			return nil, err
+		}
+		for i := range pinned {
+			response.PinnedPosts = append(response.PinnedPosts, postMessage(&pinned[i]))
+		}
+	}
+
+	query := uaps.DB.Scopes(model.PostsReadableBy(callerId)).
+		Where("user_id = ? AND pinned_at IS NULL", request.GetUserId()).
+		Order("created_at DESC, id DESC").
+		// one more row than asked tells whether there is a next page
+		Limit(pageSize + 1)
+	if after != nil {
+		query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", after.Time, after.Time, after.Id)
+	}
+	var posts []model.Post
+	if err := query.Find(&posts).Error; err != nil {
+		return nil, err
+	}
+
+	if len(posts) > pageSize {
+		posts = posts[:pageSize]
+		last := posts[len(posts)-1]
+		response.NextCursor = pageCursor{Time: last.CreatedAt, Id: int64(last.ID)}.encode()
+	}
+	for i := range posts {
+		response.Posts = append(response.Posts, postMessage(&posts[i]))
+	}
+	return response, nil
+}
+
+// PinPost pins a post to the top of its author's timeline, pinning a pinned
+// post again keeps its place
+func (uaps *UserAndPostService) PinPost(ctx context.Context, request *user_and_post.PinPostRequest) (*user_and_post.PinPostResponse, error) {
+	var post model.Post
+	err := uaps.DB.First(&post, request.GetPostId()).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.PinPostResponse{Status: user_and_post.PinPostResponse_POST_NOT_FOUND}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !isCaller(ctx, int64(post.UserID)) {
+		return &user_and_post.PinPostResponse{Status: user_and_post.PinPostResponse_FORBIDDEN}, nil
+	}
+	if post.PinnedAt != nil {
+		return &user_and_post.PinPostResponse{Status: user_and_post.PinPostResponse_OK}, nil
+	}
+
+	var pinnedCount int64
+	err = uaps.DB.Model(&model.Post{}).Where("user_id = ? AND pinned_at IS NOT NULL", post.UserID).Count(&pinnedCount).Error
+	if err != nil {
+		return nil, err
+	}
+	if pinnedCount >= maxPinnedPosts {
+		return &user_and_post.PinPostResponse{Status: user_and_post.PinPostResponse_TOO_MANY_PINNED}, nil
+	}
+
+	// pinning is not an edit, updated_at is left alone
+	if err := uaps.DB.Model(&post).UpdateColumn("pinned_at", time.Now()).Error; err != nil {
+		return nil, err
+	}
+	if err := uaps.invalidatePostCache(ctx, int64(post.ID)); err != nil {
+		uaps.Logger.Error("failed to invalidate post cache", zap.Error(err), zap.Uint("postID", post.ID))
+	}
+	return &user_and_post.PinPostResponse{Status: user_and_post.PinPostResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) UnpinPost(ctx context.Context, request *user_and_post.UnpinPostRequest) (*user_and_post.UnpinPostResponse, error) {
+	var post model.Post
+	err := uaps.DB.First(&post, request.GetPostId()).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.UnpinPostResponse{Status: user_and_post.UnpinPostResponse_POST_NOT_FOUND}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !isCaller(ctx, int64(post.UserID)) {
+		return &user_and_post.UnpinPostResponse{Status: user_and_post.UnpinPostResponse_FORBIDDEN}, nil
+	}
+	if post.PinnedAt == nil {
+		return &user_and_post.UnpinPostResponse{Status: user_and_post.UnpinPostResponse_NOT_PINNED}, nil
+	}
+
+	if err := uaps.DB.Model(&post).UpdateColumn("pinned_at", nil).Error; err != nil {
+		return nil, err
+	}
+	if err := uaps.invalidatePostCache(ctx, int64(post.ID)); err != nil {
+		uaps.Logger.Error("failed to invalidate post cache", zap.Error(err), zap.Uint("postID", post.ID))
+	}
+	return &user_and_post.UnpinPostResponse{Status: user_and_post.UnpinPostResponse_OK}, nil
+}
@@ -0,0 +1,211 @@
+package user_and_post_service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/mailer"
+	"github.com/khailequang334/social_network/internal/model"
+	"github.com/khailequang334/social_network/internal/validation"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// errRejectedPassword rolls back ResetPassword, the token stays usable for another try
+var errRejectedPassword = errors.New("rejected password")
+
+func hashUserToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueUserToken stores a new single-use token and returns its plain value,
+// older unused tokens of the same purpose are invalidated
+func (uaps *UserAndPostService) issueUserToken(userId uint, purpose string, ttl time.Duration) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	err := uaps.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.UserToken{}).
+			Where("user_id = ? AND purpose = ? AND used_at IS NULL", userId, purpose).
+			Update("used_at", time.Now()).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&model.UserToken{
+			UserID:    userId,
+			Purpose:   purpose,
+			TokenHash: hashUserToken(token),
+			ExpiresAt: time.Now().Add(ttl),
+		}).Error
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// consumeUserToken marks the token used and returns its owner, it returns
+// gorm.ErrRecordNotFound for unknown, expired or already used tokens
+func (uaps *UserAndPostService) consumeUserToken(tx *gorm.DB, token string, purpose string) (uint, error) {
+	var userToken model.UserToken
+	err := tx.Where("token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", hashUserToken(token), purpose, time.Now()).
+		First(&userToken).Error
+	if err != nil {
+		return 0, err
+	}
+
+	// conditional update so the same token can not be redeemed twice concurrently
+	result := tx.Model(&model.UserToken{}).
+		Where("id = ? AND used_at IS NULL", userToken.ID).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if result.RowsAffected != 1 {
+		return 0, gorm.ErrRecordNotFound
+	}
+	return userToken.UserID, nil
+}
+
+func (uaps *UserAndPostService) publicLink(path string, token string) string {
+	return uaps.AccountTokens.PublicURL + path + "?" + url.Values{"token": {token}}.Encode()
+}
+
+func (uaps *UserAndPostService) sendEmailVerification(ctx context.Context, user *model.User) error {
+	token, err := uaps.issueUserToken(user.ID, model.UserTokenPurposeVerifyEmail, uaps.AccountTokens.VerifyEmailTTL)
+	if err != nil {
+		return err
+	}
+	return uaps.Mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease confirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s.\n",
+			user.FirstName, uaps.publicLink("/verify-email", token), uaps.AccountTokens.VerifyEmailTTL),
+	})
+}
+
+func (uaps *UserAndPostService) RequestEmailVerification(ctx context.Context, request *user_and_post.RequestEmailVerificationRequest) (*user_and_post.RequestEmailVerificationResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.RequestEmailVerificationResponse{Status: user_and_post.RequestEmailVerificationResponse_FORBIDDEN}, nil
+	}
+
+	var user model.User
+	err := uaps.DB.First(&user, request.UserId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.RequestEmailVerificationResponse{Status: user_and_post.RequestEmailVerificationResponse_USER_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if user.EmailVerifiedAt != nil {
+		return &user_and_post.RequestEmailVerificationResponse{Status: user_and_post.RequestEmailVerificationResponse_ALREADY_VERIFIED}, nil
+	}
+
+	if err := uaps.sendEmailVerification(ctx, &user); err != nil {
+		return nil, err
+	}
+	return &user_and_post.RequestEmailVerificationResponse{Status: user_and_post.RequestEmailVerificationResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) VerifyEmail(ctx context.Context, request *user_and_post.VerifyEmailRequest) (*user_and_post.VerifyEmailResponse, error) {
+	var userId uint
+	err := uaps.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		userId, err = uaps.consumeUserToken(tx, request.GetToken(), model.UserTokenPurposeVerifyEmail)
+		if err != nil {
+			return err
+		}
+		return tx.Model(&model.User{ID: userId}).Update("email_verified_at", time.Now()).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.VerifyEmailResponse{Status: user_and_post.VerifyEmailResponse_INVALID_TOKEN}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &user_and_post.VerifyEmailResponse{
+		Status: user_and_post.VerifyEmailResponse_OK,
+		UserId: int64(userId),
+	}, nil
+}
+
+func (uaps *UserAndPostService) RequestPasswordReset(ctx context.Context, request *user_and_post.RequestPasswordResetRequest) (*user_and_post.RequestPasswordResetResponse, error) {
+	var user model.User
+	err := uaps.DB.Where(&model.User{Email: request.GetEmail()}).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		uaps.Logger.Debug("password reset requested for unknown email")
+		return &user_and_post.RequestPasswordResetResponse{Status: user_and_post.RequestPasswordResetResponse_OK}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	token, err := uaps.issueUserToken(user.ID, model.UserTokenPurposeResetPassword, uaps.AccountTokens.ResetPasswordTTL)
+	if err != nil {
+		return nil, err
+	}
+	err = uaps.Mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nSomeone asked to reset the password of your account %s. If it was you, open the link below:\n\n%s\n\nThe link expires in %s. If you did not ask for it, you can ignore this email.\n",
+			user.FirstName, user.UserName, uaps.publicLink("/reset-password", token), uaps.AccountTokens.ResetPasswordTTL),
+	})
+	if err != nil {
+		// answer like for an unknown email, an error here would tell the email is registered
+		uaps.Logger.Error("failed to send password reset mail", zap.Error(err), zap.Uint("userID", user.ID))
+	}
+	return &user_and_post.RequestPasswordResetResponse{Status: user_and_post.RequestPasswordResetResponse_OK}, nil
+}
+
+func (uaps *UserAndPostService) ResetPassword(ctx context.Context, request *user_and_post.ResetPasswordRequest) (*user_and_post.ResetPasswordResponse, error) {
+	var user model.User
+	var fieldError *validation.FieldError
+	err := uaps.DB.Transaction(func(tx *gorm.DB) error {
+		userId, err := uaps.consumeUserToken(tx, request.GetToken(), model.UserTokenPurposeResetPassword)
+		if err != nil {
+			return err
+		}
+		if err := tx.First(&user, userId).Error; err != nil {
+			return err
+		}
+		if fieldError = uaps.PasswordPolicy.Check(request.GetNewPassword(), passwordUserInputs(&user)...); fieldError != nil {
+			return errRejectedPassword
+		}
+		hashedPassword, err := uaps.Passwords.Hash(request.GetNewPassword())
+		if err != nil {
+			return err
+		}
+		user.HashedPassword = hashedPassword
+		user.Salt = ""
+		return tx.Save(&user).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.ResetPasswordResponse{Status: user_and_post.ResetPasswordResponse_INVALID_TOKEN}, nil
+	} else if errors.Is(err, errRejectedPassword) {
+		return &user_and_post.ResetPasswordResponse{
+			Status:      user_and_post.ResetPasswordResponse_INVALID_FIELD,
+			FieldErrors: toProtoFieldErrors([]*validation.FieldError{fieldError}),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// someone guessing the password is no reason to keep the owner locked out
+	if err := uaps.resetLoginFailures(ctx, user.UserName); err != nil {
+		uaps.Logger.Error("failed to reset login failures", zap.Error(err), zap.Uint("userID", user.ID))
+	}
+	return &user_and_post.ResetPasswordResponse{
+		Status: user_and_post.ResetPasswordResponse_OK,
+		UserId: int64(user.ID),
+	}, nil
+}
@@ -0,0 +1,96 @@
+package user_and_post_service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"github.com/khailequang334/social_network/internal/validation"
+	"gorm.io/gorm"
+)
+
+func toProtoFieldErrors(fieldErrors []*validation.FieldError) []*user_and_post.FieldError {
+	result := make([]*user_and_post.FieldError, 0, len(fieldErrors))
+	for _, fieldError := range fieldErrors {
+		result = append(result, &user_and_post.FieldError{
+			Field:   fieldError.Field,
+			Message: fieldError.Message,
+		})
+	}
+	return result
+}
+
+// appendFieldError skips nil so validators can be chained without checks
+func appendFieldError(fieldErrors []*validation.FieldError, fieldError *validation.FieldError) []*validation.FieldError {
+	if fieldError == nil {
+		return fieldErrors
+	}
+	return append(fieldErrors, fieldError)
+}
+
+// passwordUserInputs are the values a password must not be built from
+func passwordUserInputs(user *model.User) []string {
+	inputs := []string{user.UserName, user.FirstName, user.LastName}
+	if at := strings.Index(user.Email, "@"); at > 0 {
+		inputs = append(inputs, user.Email[:at])
+	}
+	return inputs
+}
+
+// setVisibility copies a requested visibility into target, nil leaves target unchanged
+func setVisibility(field string, requested *user_and_post.Visibility, target *model.Visibility) *validation.FieldError {
+	if requested == nil {
+		return nil
+	}
+	if _, ok := user_and_post.Visibility_name[int32(*requested)]; !ok {
+		return &validation.FieldError{Field: field, Message: "must be one of public, followers or private"}
+	}
+	*target = model.Visibility(*requested)
+	return nil
+}
+
+func (uaps *UserAndPostService) validateNewUser(request *user_and_post.UserDetailInfo) []*validation.FieldError {
+	var fieldErrors []*validation.FieldError
+	fieldErrors = appendFieldError(fieldErrors, validation.UserName(request.GetUserName()))
+	fieldErrors = appendFieldError(fieldErrors, validation.Email(request.GetEmail()))
+	fieldErrors = appendFieldError(fieldErrors, validation.Name("first_name", request.GetFirstName(), true))
+	fieldErrors = appendFieldError(fieldErrors, validation.Name("last_name", request.GetLastName(), false))
+	fieldErrors = appendFieldError(fieldErrors, uaps.PasswordPolicy.Check(request.GetUserPassword(), passwordUserInputs(&model.User{
+		UserName:  request.GetUserName(),
+		FirstName: request.GetFirstName(),
+		LastName:  request.GetLastName(),
+		Email:     request.GetEmail(),
+	})...))
+	return fieldErrors
+}
+
+// checkUserTaken returns the status and field error for an already used
+// username or email, excluding the user with id userId
+func (uaps *UserAndPostService) checkUserTaken(userId uint, userName string, email string) (user_and_post.UserResult_UserStatus, *validation.FieldError, error) {
+	var existing model.User
+	err := uaps.DB.Where("id <> ? AND user_name = ?", userId, userName).First(&existing).Error
+	if err == nil {
+		return user_and_post.UserResult_USERNAME_TAKEN, &validation.FieldError{Field: "user_name", Message: "is already taken"}, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return user_and_post.UserResult_OK, nil, err
+	}
+
+	// a name given up by someone else still redirects to them until it is released
+	var held model.UserNameHistory
+	err = uaps.DB.Where("user_id <> ? AND user_name = ? AND released_at > ?", userId, userName, time.Now()).First(&held).Error
+	if err == nil {
+		return user_and_post.UserResult_USERNAME_TAKEN, &validation.FieldError{Field: "user_name", Message: "is already taken"}, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return user_and_post.UserResult_OK, nil, err
+	}
+
+	err = uaps.DB.Where("id <> ? AND email = ?", userId, email).First(&existing).Error
+	if err == nil {
+		return user_and_post.UserResult_EMAIL_TAKEN, &validation.FieldError{Field: "email", Message: "is already registered"}, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return user_and_post.UserResult_OK, nil, err
+	}
+	return user_and_post.UserResult_OK, nil, nil
+}
@@ -3,34 +3,85 @@ package user_and_post_service
 import (
 	"context"
 	"errors"
-	"fmt"
-	"math/rand"
 	"time"
 
 	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
 	"github.com/khailequang334/social_network/internal/model"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/khailequang334/social_network/internal/password"
+	"github.com/khailequang334/social_network/internal/validation"
+	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
 func (uaps *UserAndPostService) CreateUser(ctx context.Context, request *user_and_post.UserDetailInfo) (*user_and_post.UserResult, error) {
-	salt := GenerateSalt(16)
-	hashedPassword, err := HashPassword(request.GetUserPassword(), salt)
+	return uaps.createUser(ctx, request, false)
+}
+
+// createUser skips the verification mail when the email was already verified,
+// e.g. by the external identity provider the account is provisioned from
+func (uaps *UserAndPostService) createUser(ctx context.Context, request *user_and_post.UserDetailInfo, emailVerified bool) (*user_and_post.UserResult, error) {
+	if fieldErrors := uaps.validateNewUser(request); len(fieldErrors) > 0 {
+		return &user_and_post.UserResult{
+			Status:      user_and_post.UserResult_INVALID_FIELD,
+			FieldErrors: toProtoFieldErrors(fieldErrors),
+		}, nil
+	}
+
+	status, fieldError, err := uaps.checkUserTaken(0, request.GetUserName(), request.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+	if fieldError != nil {
+		return &user_and_post.UserResult{
+			Status:      status,
+			FieldErrors: toProtoFieldErrors([]*validation.FieldError{fieldError}),
+		}, nil
+	}
+
+	hashedPassword, err := uaps.Passwords.Hash(request.GetUserPassword())
 	if err != nil {
 		return nil, err
 	}
 
 	newUser := model.User{
 		HashedPassword: hashedPassword,
-		Salt:           string(salt),
 		FirstName:      request.GetFirstName(),
 		LastName:       request.GetLastName(),
-		DateOfBirth:    request.Dob.AsTime(),
 		Email:          request.GetEmail(),
 		UserName:       request.GetUserName(),
 	}
-	// add new user in DB
-	uaps.DB.Create(&newUser)
+	if request.Dob != nil {
+		dob := request.Dob.AsTime()
+		newUser.DateOfBirth = &dob
+	}
+	if emailVerified {
+		now := time.Now()
+		newUser.EmailVerifiedAt = &now
+	}
+	// add new user in DB, the unique indexes catch a concurrent signup with the same username or email
+	err = uaps.DB.Create(&newUser).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		status, fieldError, err := uaps.checkUserTaken(0, newUser.UserName, newUser.Email)
+		if err != nil {
+			return nil, err
+		}
+		if fieldError == nil {
+			status = user_and_post.UserResult_USERNAME_TAKEN
+			fieldError = &validation.FieldError{Field: "user_name", Message: "is already taken"}
+		}
+		return &user_and_post.UserResult{
+			Status:      status,
+			FieldErrors: toProtoFieldErrors([]*validation.FieldError{fieldError}),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !emailVerified {
+		if err := uaps.sendEmailVerification(ctx, &newUser); err != nil {
+			uaps.Logger.Error("failed to send email verification", zap.Error(err), zap.Uint("userID", newUser.ID))
+		}
+	}
 
 	return &user_and_post.UserResult{
 		Status: user_and_post.UserResult_OK,
@@ -41,76 +92,214 @@ func (uaps *UserAndPostService) CreateUser(ctx context.Context, request *user_an
 	}, nil
 }
 
-func GenerateSalt(length int) string {
-	random := rand.New(rand.NewSource(time.Now().UnixNano()))
-
-	const alphanum = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
-	salt := make([]byte, length)
-
-	// generate salt randomly
-	for i := 0; i < length; i++ {
-		salt[i] = alphanum[random.Intn(len(alphanum))]
-	}
-
-	return string(salt)
-}
-
-func HashPassword(password string, salt string) (string, error) {
-	// Add salt
-	passwordWithSalt := []byte(password + salt)
-
-	// Generate the bcrypt hash
-	hashedPassword, err := bcrypt.GenerateFromPassword(passwordWithSalt, bcrypt.DefaultCost)
-	if err != nil {
-		return "", err
-	}
-
-	return string(hashedPassword), nil
-}
-
 // EditUser edit user request by looking up user id in mysql database and update it
 func (uaps *UserAndPostService) EditUser(ctx context.Context, request *user_and_post.EditUserRequest) (*user_and_post.EditUserResponse, error) {
-	var user model.User
-	uaps.DB.Where(&model.User{ID: uint(request.UserId)}).First(&user)
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.EditUserResponse{Status: user_and_post.EditUserResponse_FORBIDDEN}, nil
+	}
 
-	if user.ID == 0 {
-		return nil, fmt.Errorf("user not found")
+	var user model.User
+	err := uaps.DB.Where(&model.User{ID: uint(request.UserId)}).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.EditUserResponse{Status: user_and_post.EditUserResponse_USER_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
 	}
+
+	var fieldErrors []*validation.FieldError
 	// update FirstName
 	if request.FirstName != nil {
+		fieldErrors = appendFieldError(fieldErrors, validation.Name("first_name", request.GetFirstName(), true))
 		user.FirstName = request.GetFirstName()
 	}
 	// update LastName
 	if request.LastName != nil {
+		fieldErrors = appendFieldError(fieldErrors, validation.Name("last_name", request.GetLastName(), false))
 		user.LastName = request.GetLastName()
 	}
-	// update Password
+	// update optional profile fields, an empty string clears the field
+	if request.Bio != nil {
+		fieldErrors = appendFieldError(fieldErrors, validation.Bio(request.GetBio()))
+		user.Bio = request.GetBio()
+	}
+	if request.AvatarPath != nil {
+		fieldErrors = appendFieldError(fieldErrors, validation.AvatarPath(request.GetAvatarPath()))
+		user.AvatarPath = request.GetAvatarPath()
+	}
+	if request.Location != nil {
+		fieldErrors = appendFieldError(fieldErrors, validation.Location(request.GetLocation()))
+		user.Location = request.GetLocation()
+	}
+	if request.Website != nil {
+		fieldErrors = appendFieldError(fieldErrors, validation.Website(request.GetWebsite()))
+		user.Website = request.GetWebsite()
+	}
+	if request.Pronouns != nil {
+		fieldErrors = appendFieldError(fieldErrors, validation.Pronouns(request.GetPronouns()))
+		user.Pronouns = request.GetPronouns()
+	}
+	// update profile field visibility
+	fieldErrors = appendFieldError(fieldErrors, setVisibility("bio_visibility", request.BioVisibility, &user.BioVisibility))
+	fieldErrors = appendFieldError(fieldErrors, setVisibility("avatar_visibility", request.AvatarVisibility, &user.AvatarVisibility))
+	fieldErrors = appendFieldError(fieldErrors, setVisibility("location_visibility", request.LocationVisibility, &user.LocationVisibility))
+	fieldErrors = appendFieldError(fieldErrors, setVisibility("website_visibility", request.WebsiteVisibility, &user.WebsiteVisibility))
+	fieldErrors = appendFieldError(fieldErrors, setVisibility("pronouns_visibility", request.PronounsVisibility, &user.PronounsVisibility))
+	// update Password, checked against the names as they are after this edit
+	if request.UserPassword != nil {
+		fieldErrors = appendFieldError(fieldErrors, uaps.PasswordPolicy.Check(request.GetUserPassword(), passwordUserInputs(&user)...))
+	}
+	if len(fieldErrors) > 0 {
+		return &user_and_post.EditUserResponse{
+			UserId:      int64(user.ID),
+			Status:      user_and_post.EditUserResponse_INVALID_FIELD,
+			FieldErrors: toProtoFieldErrors(fieldErrors),
+		}, nil
+	}
 	if request.UserPassword != nil {
-		salt := GenerateSalt(16)
-		hashedPassword, err := HashPassword(request.GetUserPassword(), salt)
+		hashedPassword, err := uaps.Passwords.Hash(request.GetUserPassword())
 		if err != nil {
 			return nil, err
 		}
 		user.HashedPassword = hashedPassword
-		user.Salt = string(salt)
+		user.Salt = ""
 	}
 	// update DOB
 	if request.Dob != nil {
-		user.DateOfBirth = request.Dob.AsTime()
+		dob := request.Dob.AsTime()
+		user.DateOfBirth = &dob
+	}
+	// update Private, an account going public approves its pending follow requests
+	wasPrivate := user.Private
+	if request.Private != nil {
+		user.Private = request.GetPrivate()
 	}
 
-	uaps.DB.Save(&user)
+	var newFollowerIds []int64
+	err = uaps.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&user).Error; err != nil {
+			return err
+		}
+		if wasPrivate && !user.Private {
+			var err error
+			newFollowerIds, err = approveAllFollowRequests(tx, user.ID)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uaps.invalidateNewsfeeds(ctx, newFollowerIds...)
 
 	return &user_and_post.EditUserResponse{
 		UserId: int64(user.ID),
+		Status: user_and_post.EditUserResponse_OK,
 	}, nil
 }
 
+// ChangeUserName renames the user, the old name keeps resolving to the user
+// for the hold period and renames are rate limited by a cooldown
+func (uaps *UserAndPostService) ChangeUserName(ctx context.Context, request *user_and_post.ChangeUserNameRequest) (*user_and_post.ChangeUserNameResponse, error) {
+	if !isCaller(ctx, request.UserId) {
+		return &user_and_post.ChangeUserNameResponse{Status: user_and_post.ChangeUserNameResponse_FORBIDDEN}, nil
+	}
+
+	var user model.User
+	err := uaps.DB.First(&user, request.UserId).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &user_and_post.ChangeUserNameResponse{Status: user_and_post.ChangeUserNameResponse_USER_NOT_FOUND}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if user.UserName == request.GetUserName() {
+		return &user_and_post.ChangeUserNameResponse{Status: user_and_post.ChangeUserNameResponse_OK}, nil
+	}
+
+	if fieldError := validation.UserName(request.GetUserName()); fieldError != nil {
+		return &user_and_post.ChangeUserNameResponse{
+			Status:      user_and_post.ChangeUserNameResponse_INVALID_FIELD,
+			FieldErrors: toProtoFieldErrors([]*validation.FieldError{fieldError}),
+		}, nil
+	}
+
+	var lastChange model.UserNameHistory
+	err = uaps.DB.Where("user_id = ?", user.ID).Order("created_at DESC").First(&lastChange).Error
+	if err == nil {
+		if wait := time.Until(lastChange.CreatedAt.Add(uaps.UserNameChange.Cooldown)); wait > 0 {
+			return &user_and_post.ChangeUserNameResponse{
+				Status:            user_and_post.ChangeUserNameResponse_TOO_SOON,
+				RetryAfterSeconds: retryAfterSeconds(wait),
+			}, nil
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	status, fieldError, err := uaps.checkUserTaken(user.ID, request.GetUserName(), user.Email)
+	if err != nil {
+		return nil, err
+	}
+	if status == user_and_post.UserResult_USERNAME_TAKEN {
+		return &user_and_post.ChangeUserNameResponse{
+			Status:      user_and_post.ChangeUserNameResponse_USERNAME_TAKEN,
+			FieldErrors: toProtoFieldErrors([]*validation.FieldError{fieldError}),
+		}, nil
+	}
+
+	oldUserName := user.UserName
+	err = uaps.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&user).Update("user_name", request.GetUserName()).Error; err != nil {
+			return err
+		}
+		// taking back one of the user's own old names ends its redirect
+		if err := tx.Where("user_id = ? AND user_name = ?", user.ID, request.GetUserName()).Delete(&model.UserNameHistory{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.UserNameHistory{
+			UserID:     user.ID,
+			UserName:   oldUserName,
+			ReleasedAt: time.Now().Add(uaps.UserNameChange.HoldPeriod),
+		}).Error
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &user_and_post.ChangeUserNameResponse{
+			Status:      user_and_post.ChangeUserNameResponse_USERNAME_TAKEN,
+			FieldErrors: []*user_and_post.FieldError{{Field: "user_name", Message: "is already taken"}},
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	uaps.Logger.Info("user name changed", zap.Uint("userID", user.ID), zap.String("from", oldUserName), zap.String("to", request.GetUserName()))
+	return &user_and_post.ChangeUserNameResponse{Status: user_and_post.ChangeUserNameResponse_OK}, nil
+}
+
 func (uaps *UserAndPostService) AuthenticateUser(ctx context.Context, request *user_and_post.AuthenticateUserRequest) (*user_and_post.AuthenticateUserResponse, error) {
-	// get user detail infor from DB
+	// reject early while the username is locked or the client has to back off
+	locked, retryAfter, err := uaps.checkLoginAllowed(ctx, request.GetUserName(), request.GetClientIp())
+	if err != nil {
+		return nil, err
+	}
+	if locked {
+		return &user_and_post.AuthenticateUserResponse{
+			Status:            user_and_post.AuthenticateUserResponse_ACCOUNT_LOCKED,
+			RetryAfterSeconds: retryAfterSeconds(retryAfter),
+		}, nil
+	} else if retryAfter > 0 {
+		return &user_and_post.AuthenticateUserResponse{
+			Status:            user_and_post.AuthenticateUserResponse_RETRY_LATER,
+			RetryAfterSeconds: retryAfterSeconds(retryAfter),
+		}, nil
+	}
+
+	// get user detail infor from DB, purged accounts keep their row but can not log in
 	var user model.User
-	result := uaps.DB.Where(&model.User{UserName: request.GetUserName()}).First(&user)
+	result := uaps.DB.Where(&model.User{UserName: request.GetUserName()}).Where("purged_at IS NULL").First(&user)
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		// unknown usernames count as failures too, guessing them is not free
+		if _, err := uaps.recordLoginFailure(ctx, request.GetUserName(), request.GetClientIp()); err != nil {
+			return nil, err
+		}
 		return &user_and_post.AuthenticateUserResponse{
 			Status: user_and_post.AuthenticateUserResponse_USER_NOT_FOUND,
 		}, nil
@@ -118,16 +307,90 @@ func (uaps *UserAndPostService) AuthenticateUser(ctx context.Context, request *u
 		return nil, result.Error
 	}
 
-	// add salt to password to compare
-	passwordWithSalt := []byte(request.UserPassword + user.Salt)
-	err := bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), passwordWithSalt)
+	matched, err := uaps.verifyPassword(&user, request.GetUserPassword())
 	if err != nil {
+		return nil, err
+	}
+	if !matched {
+		locked, err := uaps.recordLoginFailure(ctx, request.GetUserName(), request.GetClientIp())
+		if err != nil {
+			return nil, err
+		}
+		if locked {
+			return &user_and_post.AuthenticateUserResponse{
+				Status:            user_and_post.AuthenticateUserResponse_ACCOUNT_LOCKED,
+				RetryAfterSeconds: retryAfterSeconds(uaps.LoginProtection.LockoutDuration),
+			}, nil
+		}
 		return &user_and_post.AuthenticateUserResponse{
 			Status: user_and_post.AuthenticateUserResponse_WRONG_PASSWORD,
 		}, nil
 	}
 
+	// only told once the password is right, so it does not leak to guessers
+	if user.SuspendedAt != nil {
+		return &user_and_post.AuthenticateUserResponse{
+			Status: user_and_post.AuthenticateUserResponse_ACCOUNT_SUSPENDED,
+		}, nil
+	}
+
+	// the plain password is only known now, move old hashes to the current policy
+	if user.Salt != "" || uaps.Passwords.NeedsRehash(user.HashedPassword) {
+		if err := uaps.rehashPassword(&user, request.GetUserPassword()); err != nil {
+			uaps.Logger.Error("failed to rehash password", zap.Error(err), zap.Uint("userID", user.ID))
+		}
+	}
+
+	// the password is only the first step when 2FA is enabled
+	twoFactor, err := uaps.getConfirmedSecondFactor(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor != nil {
+		// failures are only forgotten once VerifySecondFactor accepts the code,
+		// otherwise every right password would buy a fresh round of code guesses
+		challengeId, err := uaps.createSecondFactorChallenge(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &user_and_post.AuthenticateUserResponse{
+			Status:      user_and_post.AuthenticateUserResponse_SECOND_FACTOR_REQUIRED,
+			ChallengeId: challengeId,
+		}, nil
+	}
+
+	if err := uaps.resetLoginFailures(ctx, request.GetUserName()); err != nil {
+		return nil, err
+	}
+	if err := uaps.restoreAccount(ctx, user.ID); err != nil {
+		return nil, err
+	}
 	return &user_and_post.AuthenticateUserResponse{
 		Status: user_and_post.AuthenticateUserResponse_OK,
+		UserId: int64(user.ID),
 	}, nil
 }
+
+// verifyPassword also accepts legacy hashes, recognized by their separate salt
+func (uaps *UserAndPostService) verifyPassword(user *model.User, plain string) (bool, error) {
+	if user.Salt != "" {
+		return password.VerifyLegacy(plain, user.Salt, user.HashedPassword)
+	}
+	return password.Verify(plain, user.HashedPassword)
+}
+
+func (uaps *UserAndPostService) rehashPassword(user *model.User, plain string) error {
+	hashedPassword, err := uaps.Passwords.Hash(plain)
+	if err != nil {
+		return err
+	}
+	return uaps.DB.Model(user).Updates(map[string]interface{}{
+		"hashed_password": hashedPassword,
+		"salt":            "",
+	}).Error
+}
+
+// retryAfterSeconds rounds up so clients never retry a moment too early
+func retryAfterSeconds(d time.Duration) int64 {
+	return int64((d + time.Second - 1) / time.Second)
+}
@@ -5,6 +5,7 @@ import (
 	"net/http/pprof"
 
 	"github.com/gin-gonic/gin"
+	"github.com/khailequang334/social_network/internal/authz"
 	"github.com/khailequang334/social_network/internal/interfaces/app/web_server/web_service"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
@@ -12,10 +13,16 @@ import (
 type WebServer struct {
 	Service *web_service.WebService
 	Port    int
+	// TrustedProxies may set the client ip through X-Forwarded-For, with none
+	// a client could pick any ip and dodge the per ip login backoff
+	TrustedProxies []string
 }
 
-func (s *WebServer) Run() {
+func (s *WebServer) Run() error {
 	r := gin.Default()
+	if err := r.SetTrustedProxies(s.TrustedProxies); err != nil {
+		return fmt.Errorf("invalid trusted proxies: %w", err)
+	}
 
 	v1 := r.Group("/api/v1")
 	setupRoutes(v1, s.Service)
@@ -23,30 +30,98 @@ func (s *WebServer) Run() {
 	setupPrometheus(r)
 	setupPprof(r)
 
-	r.Run(fmt.Sprintf(":%d", s.Port))
+	return r.Run(fmt.Sprintf(":%d", s.Port))
 }
 
 func setupRoutes(r *gin.RouterGroup, svc *web_service.WebService) {
+	authRequired := svc.AuthRequired()
+	authOptional := svc.AuthOptional()
+
 	userRouter := r.Group("users")
 	userRouter.POST("", svc.CreateUser)
 	userRouter.POST("login", svc.AuthentcateUser)
-	userRouter.PUT("", svc.EditUser)
+	userRouter.POST("token/refresh", svc.RefreshToken)
+	userRouter.POST("logout", svc.Logout)
+	userRouter.POST("logout/all", authRequired, svc.LogoutAll)
+	userRouter.PUT("", authRequired, svc.EditUser)
+	userRouter.PUT("user_name", authRequired, svc.ChangeUserName)
+	userRouter.DELETE("", authRequired, svc.DeleteAccount)
+	userRouter.POST("email/verification", authRequired, svc.RequestEmailVerification)
+	userRouter.POST("email/verify", svc.VerifyEmail)
+	userRouter.POST("password/forgot", svc.ForgotPassword)
+	userRouter.POST("password/reset", svc.ResetPassword)
+	userRouter.GET("identities", authRequired, svc.ListIdentities)
+	userRouter.DELETE("identities/:identity_id", authRequired, svc.UnlinkIdentity)
+	userRouter.POST("exports", authRequired, svc.RequestDataExport)
+	userRouter.GET("exports/:export_id", authRequired, svc.GetDataExport)
+	userRouter.GET("exports/:export_id/download", authRequired, svc.DownloadDataExport)
+	userRouter.GET("", authOptional, svc.GetUsers)
+	userRouter.GET(":user_id", authOptional, svc.GetUser)
+	userRouter.GET(":user_id/relationship", authRequired, svc.GetRelationship)
+	userRouter.GET(":user_id/posts", authOptional, svc.ListUserPosts)
+	userRouter.GET("relationships", authRequired, svc.GetRelationships)
+	userRouter.GET("by_name/:user_name", authOptional, svc.GetUserByUserName)
+
+	secondFactorRouter := userRouter.Group("2fa")
+	secondFactorRouter.POST("enroll", authRequired, svc.EnrollSecondFactor)
+	secondFactorRouter.POST("confirm", authRequired, svc.ConfirmSecondFactor)
+	secondFactorRouter.POST("recovery_codes", authRequired, svc.GenerateRecoveryCodes)
+	secondFactorRouter.POST("verify", svc.VerifySecondFactor)
+
+	oidcRouter := r.Group("oidc")
+	oidcRouter.GET(":provider/login", svc.OIDCLogin)
+	oidcRouter.GET(":provider/link", authRequired, svc.OIDCLink)
+	oidcRouter.GET(":provider/callback", svc.OIDCCallback)
 
 	friendRouter := r.Group("friends")
+	friendRouter.GET("suggestions", authRequired, svc.SuggestFollows)
 	friendRouter.GET(":user_id", svc.GetFollowList)
-	friendRouter.POST(":user_id", svc.FollowUser)
-	friendRouter.DELETE(":user_id", svc.UnfollowUser)
+	friendRouter.GET(":user_id/following", svc.GetFollowingList)
+	friendRouter.POST(":user_id", authRequired, svc.FollowUser)
+	friendRouter.DELETE(":user_id", authRequired, svc.UnfollowUser)
+	friendRouter.DELETE(":user_id/request", authRequired, svc.CancelFollowRequest)
+
+	followRequestRouter := r.Group("follow_requests")
+	followRequestRouter.GET("", authRequired, svc.ListFollowRequests)
+	followRequestRouter.POST(":user_id/approve", authRequired, svc.ApproveFollowRequest)
+	followRequestRouter.POST(":user_id/reject", authRequired, svc.RejectFollowRequest)
+
+	blockRouter := r.Group("blocks")
+	blockRouter.GET("", authRequired, svc.ListBlockedUsers)
+	blockRouter.POST(":user_id", authRequired, svc.BlockUser)
+	blockRouter.DELETE(":user_id", authRequired, svc.UnblockUser)
+
+	muteRouter := r.Group("mutes")
+	muteRouter.GET("", authRequired, svc.ListMutedUsers)
+	muteRouter.POST(":user_id", authRequired, svc.MuteUser)
+	muteRouter.DELETE(":user_id", authRequired, svc.UnmuteUser)
+
+	closeFriendRouter := r.Group("close_friends")
+	closeFriendRouter.GET("", authRequired, svc.ListCloseFriends)
+	closeFriendRouter.POST(":user_id", authRequired, svc.AddCloseFriend)
+	closeFriendRouter.DELETE(":user_id", authRequired, svc.RemoveCloseFriend)
 
 	postRouter := r.Group("posts")
-	postRouter.POST("", svc.CreatePost)
-	postRouter.GET(":post_id", svc.GetPost)
-	postRouter.PUT(":post_id", svc.EditPost)
-	postRouter.DELETE(":post_id", svc.DeletePost)
-	postRouter.POST(":post_id/likes", svc.LikePost)
-	postRouter.POST(":post_id/comments", svc.CreatePostComment)
+	postRouter.POST("", authRequired, svc.CreatePost)
+	postRouter.GET(":post_id", authOptional, svc.GetPost)
+	postRouter.PUT(":post_id", authRequired, svc.EditPost)
+	postRouter.DELETE(":post_id", authRequired, svc.DeletePost)
+	postRouter.POST(":post_id/pin", authRequired, svc.PinPost)
+	postRouter.DELETE(":post_id/pin", authRequired, svc.UnpinPost)
+	postRouter.GET(":post_id/revisions", authRequired, svc.ListPostRevisions)
+	postRouter.GET(":post_id/revisions/:revision_id", authRequired, svc.GetPostRevision)
+	postRouter.POST(":post_id/likes", authRequired, svc.LikePost)
+	postRouter.POST(":post_id/comments", authRequired, svc.CreatePostComment)
+	postRouter.POST(":post_id/reports", authRequired, svc.ReportPost)
 
 	newsfeedRouter := r.Group("newsfeeds")
-	newsfeedRouter.GET("", svc.GetNewsfeed)
+	newsfeedRouter.GET("", authRequired, svc.GetNewsfeed)
+
+	adminRouter := r.Group("admin", authRequired)
+	adminRouter.POST("users/:user_id/suspension", svc.RequirePermission(authz.PermissionSuspendUser), svc.SuspendUser)
+	adminRouter.DELETE("users/:user_id/suspension", svc.RequirePermission(authz.PermissionSuspendUser), svc.UnsuspendUser)
+	adminRouter.DELETE("posts/:post_id", svc.RequirePermission(authz.PermissionDeleteAnyPost), svc.ForceDeletePost)
+	adminRouter.GET("reports", svc.RequirePermission(authz.PermissionListReports), svc.ListReports)
 }
 
 func setupPrometheus(r *gin.Engine) {
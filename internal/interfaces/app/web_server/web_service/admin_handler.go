@@ -0,0 +1,140 @@
+package web_service
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+)
+
+func (svc *WebService) SuspendUser(ctx *gin.Context) {
+	svc.setSuspension(ctx, true)
+}
+
+func (svc *WebService) UnsuspendUser(ctx *gin.Context) {
+	svc.setSuspension(ctx, false)
+}
+
+func (svc *WebService) setSuspension(ctx *gin.Context, suspended bool) {
+	userId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: fmt.Sprintf("invalid user id: %s", ctx.Param("user_id"))})
+		return
+	}
+	// the body is optional, it only carries the reason
+	var request model.ModerationRequest
+	_ = ctx.ShouldBindJSON(&request)
+
+	response, err := svc.UserAndPostClient.SuspendUser(ctx, &user_and_post.SuspendUserRequest{
+		UserId:    userId,
+		Suspended: suspended,
+		Reason:    request.Reason,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.SuspendUserResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "user not found"})
+		return
+	} else if response.Status == user_and_post.SuspendUserResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+
+	if !suspended {
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "user suspension lifted"})
+		return
+	}
+	// a suspended user is logged out everywhere
+	if err := svc.Sessions.DeleteAll(ctx, userId); err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if svc.Tokens != nil {
+		if err := svc.Tokens.RevokeAll(ctx, userId); err != nil {
+			ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+			return
+		}
+	}
+	ctx.JSON(http.StatusOK, model.MessageResponse{Message: "user suspended"})
+}
+
+func (svc *WebService) ForceDeletePost(ctx *gin.Context) {
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: fmt.Sprintf("invalid post id: %s", ctx.Param("post_id"))})
+		return
+	}
+	var request model.ModerationRequest
+	_ = ctx.ShouldBindJSON(&request)
+
+	response, err := svc.UserAndPostClient.ForceDeletePost(ctx, &user_and_post.ForceDeletePostRequest{
+		PostId: postId,
+		Reason: request.Reason,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.ForceDeletePostResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "post not found"})
+		return
+	} else if response.Status == user_and_post.ForceDeletePostResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+	ctx.JSON(http.StatusOK, model.MessageResponse{Message: "post deleted"})
+}
+
+func (svc *WebService) ListReports(ctx *gin.Context) {
+	request := &user_and_post.ListReportsRequest{
+		IncludeResolved: ctx.Query("include_resolved") == "true",
+	}
+	if before := ctx.Query("before"); before != "" {
+		beforeId, err := strconv.ParseInt(before, 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: fmt.Sprintf("invalid before: %s", before)})
+			return
+		}
+		request.BeforeReportId = beforeId
+	}
+	if limit := ctx.Query("limit"); limit != "" {
+		parsedLimit, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: fmt.Sprintf("invalid limit: %s", limit)})
+			return
+		}
+		request.Limit = int32(parsedLimit)
+	}
+
+	response, err := svc.UserAndPostClient.ListReports(ctx, request)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.ListReportsResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+
+	reports := make([]model.ReportResponse, 0, len(response.GetReports()))
+	for _, info := range response.GetReports() {
+		report := model.ReportResponse{
+			ReportId:    info.GetReportId(),
+			ReporterId:  info.GetReporterId(),
+			PostId:      info.GetPostId(),
+			Reason:      info.GetReason(),
+			CreatedTime: info.GetCreatedTime().AsTime(),
+		}
+		if info.ResolvedTime != nil {
+			resolvedTime := info.GetResolvedTime().AsTime()
+			report.ResolvedTime = &resolvedTime
+		}
+		reports = append(reports, report)
+	}
+	ctx.JSON(http.StatusOK, model.ListReportsResponse{Reports: reports})
+}
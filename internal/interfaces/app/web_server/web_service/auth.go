@@ -0,0 +1,219 @@
+package web_service
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/khailequang334/social_network/internal/identity"
+	"github.com/khailequang334/social_network/internal/model"
+	"github.com/khailequang334/social_network/internal/session"
+	"github.com/khailequang334/social_network/internal/token"
+	"go.uber.org/zap"
+)
+
+const (
+	sessionCookieName = "session_id"
+	currentUserIdKey  = identity.ContextKey
+	bearerPrefix      = "Bearer "
+)
+
+func bearerToken(ctx *gin.Context) (string, bool) {
+	header := ctx.GetHeader("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(header, bearerPrefix)), true
+}
+
+var (
+	errUnauthenticated   = errors.New("unauthenticated")
+	errTokenAuthDisabled = errors.New("token authentication is disabled")
+)
+
+// authenticate resolves either a "Bearer" access token or the session cookie
+// to a user id, it returns errUnauthenticated when the request carries no
+// valid credentials
+func (svc *WebService) authenticate(ctx *gin.Context) (int64, error) {
+	if accessToken, ok := bearerToken(ctx); ok {
+		if svc.Tokens == nil {
+			return 0, errTokenAuthDisabled
+		}
+		claims, err := svc.Tokens.Verify(ctx, accessToken)
+		if errors.Is(err, token.ErrInvalidToken) || errors.Is(err, token.ErrRevokedToken) {
+			return 0, errUnauthenticated
+		} else if err != nil {
+			return 0, fmt.Errorf("verify access token: %w", err)
+		}
+		return claims.UserId, nil
+	}
+
+	sessionToken, err := ctx.Cookie(sessionCookieName)
+	if errors.Is(err, http.ErrNoCookie) {
+		return 0, errUnauthenticated
+	} else if err != nil {
+		return 0, err
+	}
+
+	userId, err := svc.Sessions.Get(ctx, sessionToken)
+	if errors.Is(err, session.ErrInvalidSession) {
+		svc.clearSessionCookie(ctx)
+		return 0, errUnauthenticated
+	} else if err != nil {
+		return 0, fmt.Errorf("load session: %w", err)
+	}
+
+	// sliding renewal, the cookie lives as long as the session does
+	svc.setSessionCookie(ctx, sessionToken)
+	return userId, nil
+}
+
+// AuthRequired stores the authenticated user id in the request context,
+// handlers read it back with getCurrentUserId
+func (svc *WebService) AuthRequired() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		userId, err := svc.authenticate(ctx)
+		if errors.Is(err, errTokenAuthDisabled) {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, model.MessageResponse{Message: err.Error()})
+			return
+		} else if errors.Is(err, errUnauthenticated) {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, model.MessageResponse{Message: "unauthorized"})
+			return
+		} else if err != nil {
+			svc.Logger.Error("failed to authenticate request", zap.Error(err))
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
+			return
+		}
+
+		ctx.Set(currentUserIdKey, userId)
+		ctx.Next()
+	}
+}
+
+// AuthOptional is AuthRequired for public routes that show more to logged in
+// users, anonymous requests pass through without a current user
+func (svc *WebService) AuthOptional() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		userId, err := svc.authenticate(ctx)
+		if err == nil {
+			ctx.Set(currentUserIdKey, userId)
+		} else if !errors.Is(err, errUnauthenticated) && !errors.Is(err, errTokenAuthDisabled) {
+			svc.Logger.Error("failed to authenticate request", zap.Error(err))
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// RequirePermission must be chained after AuthRequired
+func (svc *WebService) RequirePermission(permission string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		allowed, err := svc.Permissions.HasPermission(ctx, getCurrentUserId(ctx), permission)
+		if err != nil {
+			svc.Logger.Error("failed to check permission", zap.Error(err), zap.String("permission", permission))
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
+			return
+		}
+		if !allowed {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// getCurrentUserId must only be called from handlers behind AuthRequired
+func getCurrentUserId(ctx *gin.Context) int64 {
+	return ctx.GetInt64(currentUserIdKey)
+}
+
+func (svc *WebService) setSessionCookie(ctx *gin.Context, sessionToken string) {
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(sessionCookieName, sessionToken, int(svc.Sessions.TTL().Seconds()), "/", "", svc.SessionConfig.Secure, true)
+}
+
+func (svc *WebService) clearSessionCookie(ctx *gin.Context) {
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(sessionCookieName, "", -1, "/", "", svc.SessionConfig.Secure, true)
+}
+
+func tokenResponse(pair *token.Pair) *model.TokenResponse {
+	return &model.TokenResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		TokenType:    strings.TrimSpace(bearerPrefix),
+		ExpiresIn:    int64(pair.ExpiresIn.Seconds()),
+	}
+}
+
+func (svc *WebService) RefreshToken(ctx *gin.Context) {
+	if svc.Tokens == nil {
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "token authentication is disabled"})
+		return
+	}
+
+	var request model.RefreshTokenRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	pair, err := svc.Tokens.Refresh(ctx, request.RefreshToken)
+	if errors.Is(err, token.ErrInvalidToken) {
+		ctx.JSON(http.StatusUnauthorized, model.MessageResponse{Message: "invalid refresh token"})
+		return
+	} else if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, tokenResponse(pair))
+}
+
+func (svc *WebService) Logout(ctx *gin.Context) {
+	if accessToken, ok := bearerToken(ctx); ok && svc.Tokens != nil {
+		// the body is optional, it only carries the refresh token to drop
+		var request model.LogoutRequest
+		_ = ctx.ShouldBindJSON(&request)
+
+		claims, err := svc.Tokens.Verify(ctx, accessToken)
+		if err == nil {
+			err = svc.Tokens.Revoke(ctx, claims)
+		}
+		if err == nil && request.RefreshToken != "" {
+			err = svc.Tokens.RevokeRefreshToken(ctx, request.RefreshToken)
+		}
+		if err != nil && !errors.Is(err, token.ErrInvalidToken) && !errors.Is(err, token.ErrRevokedToken) {
+			ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "logout successfully"})
+		return
+	}
+
+	sessionToken, _ := ctx.Cookie(sessionCookieName)
+	if err := svc.Sessions.Delete(ctx, sessionToken); err != nil && !errors.Is(err, session.ErrInvalidSession) {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	svc.clearSessionCookie(ctx)
+	ctx.JSON(http.StatusOK, model.MessageResponse{Message: "logout successfully"})
+}
+
+func (svc *WebService) LogoutAll(ctx *gin.Context) {
+	userId := getCurrentUserId(ctx)
+	if err := svc.Sessions.DeleteAll(ctx, userId); err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if svc.Tokens != nil {
+		if err := svc.Tokens.RevokeAll(ctx, userId); err != nil {
+			ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+			return
+		}
+	}
+	svc.clearSessionCookie(ctx)
+	ctx.JSON(http.StatusOK, model.MessageResponse{Message: "logout from all devices successfully"})
+}
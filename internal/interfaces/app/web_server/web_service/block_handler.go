@@ -0,0 +1,161 @@
+package web_service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (svc *WebService) BlockUser(ctx *gin.Context) {
+	targetUserId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.BlockUser(ctx, &user_and_post.BlockUserRequest{
+		UserId:       getCurrentUserId(ctx),
+		TargetUserId: targetUserId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.BlockUserResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.BlockUserResponse_USER_NOT_FOUND:
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "user not found"})
+	case user_and_post.BlockUserResponse_INVALID_TARGET:
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "can not block yourself"})
+	case user_and_post.BlockUserResponse_ALREADY_BLOCKED:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "user already blocked"})
+	default:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "block user successfully"})
+	}
+}
+
+func (svc *WebService) UnblockUser(ctx *gin.Context) {
+	targetUserId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.UnblockUser(ctx, &user_and_post.UnblockUserRequest{
+		UserId:       getCurrentUserId(ctx),
+		TargetUserId: targetUserId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.UnblockUserResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.UnblockUserResponse_NOT_BLOCKED:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "user not blocked"})
+	default:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "unblock user successfully"})
+	}
+}
+
+func (svc *WebService) ListBlockedUsers(ctx *gin.Context) {
+	response, err := svc.UserAndPostClient.ListBlockedUsers(ctx, &user_and_post.ListBlockedUsersRequest{
+		UserId: getCurrentUserId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.ListBlockedUsersResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+	ctx.JSON(http.StatusOK, model.ListRelatedUsersResponse{Users: toRelatedUserResponses(response.GetUsers())})
+}
+
+func (svc *WebService) MuteUser(ctx *gin.Context) {
+	targetUserId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.MuteUser(ctx, &user_and_post.MuteUserRequest{
+		UserId:       getCurrentUserId(ctx),
+		TargetUserId: targetUserId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.MuteUserResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.MuteUserResponse_USER_NOT_FOUND:
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "user not found"})
+	case user_and_post.MuteUserResponse_INVALID_TARGET:
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "can not mute yourself"})
+	case user_and_post.MuteUserResponse_ALREADY_MUTED:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "user already muted"})
+	default:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "mute user successfully"})
+	}
+}
+
+func (svc *WebService) UnmuteUser(ctx *gin.Context) {
+	targetUserId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.UnmuteUser(ctx, &user_and_post.UnmuteUserRequest{
+		UserId:       getCurrentUserId(ctx),
+		TargetUserId: targetUserId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.UnmuteUserResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.UnmuteUserResponse_NOT_MUTED:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "user not muted"})
+	default:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "unmute user successfully"})
+	}
+}
+
+func (svc *WebService) ListMutedUsers(ctx *gin.Context) {
+	response, err := svc.UserAndPostClient.ListMutedUsers(ctx, &user_and_post.ListMutedUsersRequest{
+		UserId: getCurrentUserId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.ListMutedUsersResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+	ctx.JSON(http.StatusOK, model.ListRelatedUsersResponse{Users: toRelatedUserResponses(response.GetUsers())})
+}
+
+func toRelatedUserResponses(users []*user_and_post.RelatedUser) []model.RelatedUserResponse {
+	responses := make([]model.RelatedUserResponse, 0, len(users))
+	for _, user := range users {
+		responses = append(responses, model.RelatedUserResponse{
+			UserId:    user.GetUserId(),
+			UserName:  user.GetUserName(),
+			SinceTime: user.GetSinceTime().AsTime(),
+		})
+	}
+	return responses
+}
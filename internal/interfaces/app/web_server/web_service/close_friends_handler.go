@@ -0,0 +1,82 @@
+package web_service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (svc *WebService) AddCloseFriend(ctx *gin.Context) {
+	targetUserId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.AddCloseFriend(ctx, &user_and_post.AddCloseFriendRequest{
+		UserId:       getCurrentUserId(ctx),
+		TargetUserId: targetUserId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.AddCloseFriendResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.AddCloseFriendResponse_USER_NOT_FOUND:
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "user not found"})
+	case user_and_post.AddCloseFriendResponse_INVALID_TARGET:
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "can not add yourself to close friends"})
+	case user_and_post.AddCloseFriendResponse_BLOCKED:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "blocked"})
+	case user_and_post.AddCloseFriendResponse_ALREADY_CLOSE_FRIEND:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "user already a close friend"})
+	default:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "add close friend successfully"})
+	}
+}
+
+func (svc *WebService) RemoveCloseFriend(ctx *gin.Context) {
+	targetUserId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.RemoveCloseFriend(ctx, &user_and_post.RemoveCloseFriendRequest{
+		UserId:       getCurrentUserId(ctx),
+		TargetUserId: targetUserId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.RemoveCloseFriendResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.RemoveCloseFriendResponse_NOT_CLOSE_FRIEND:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "user not a close friend"})
+	default:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "remove close friend successfully"})
+	}
+}
+
+func (svc *WebService) ListCloseFriends(ctx *gin.Context) {
+	response, err := svc.UserAndPostClient.ListCloseFriends(ctx, &user_and_post.ListCloseFriendsRequest{
+		UserId: getCurrentUserId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.ListCloseFriendsResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+	ctx.JSON(http.StatusOK, model.ListRelatedUsersResponse{Users: toRelatedUserResponses(response.GetUsers())})
+}
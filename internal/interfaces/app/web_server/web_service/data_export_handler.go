@@ -0,0 +1,130 @@
+package web_service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+func (svc *WebService) RequestDataExport(ctx *gin.Context) {
+	currentUserId := getCurrentUserId(ctx)
+
+	response, err := svc.UserAndPostClient.RequestDataExport(ctx, &user_and_post.RequestDataExportRequest{UserId: currentUserId})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, model.RequestDataExportResponse{
+		Message:  "export requested, poll its status until it is ready",
+		ExportId: response.GetExportId(),
+	})
+}
+
+func (svc *WebService) GetDataExport(ctx *gin.Context) {
+	exportId, ok := svc.exportIdParam(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := svc.UserAndPostClient.GetDataExport(ctx, &user_and_post.GetDataExportRequest{
+		UserId:   getCurrentUserId(ctx),
+		ExportId: exportId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.GetStatus() == user_and_post.GetDataExportResponse_EXPORT_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "export not found"})
+		return
+	}
+
+	export := response.GetExport()
+	result := model.DataExportResponse{
+		ExportId:    export.GetExportId(),
+		State:       strings.ToLower(export.GetState().String()),
+		CreatedTime: export.GetCreatedTime().AsTime(),
+		SizeBytes:   export.GetSizeBytes(),
+	}
+	if export.CompletedTime != nil {
+		completedTime := export.GetCompletedTime().AsTime()
+		result.CompletedTime = &completedTime
+	}
+	if export.ExpiresTime != nil {
+		expiresTime := export.GetExpiresTime().AsTime()
+		result.ExpiresTime = &expiresTime
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// DownloadDataExport relays the archive chunks as they arrive, the archive is never held in memory
+func (svc *WebService) DownloadDataExport(ctx *gin.Context) {
+	exportId, ok := svc.exportIdParam(ctx)
+	if !ok {
+		return
+	}
+
+	stream, err := svc.UserAndPostClient.DownloadDataExport(ctx, &user_and_post.DownloadDataExportRequest{
+		UserId:   getCurrentUserId(ctx),
+		ExportId: exportId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch first.GetStatus() {
+	case user_and_post.DownloadDataExportResponse_EXPORT_NOT_FOUND:
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "export not found"})
+		return
+	case user_and_post.DownloadDataExportResponse_NOT_READY:
+		ctx.JSON(http.StatusConflict, model.MessageResponse{Message: "export is not ready"})
+		return
+	case user_and_post.DownloadDataExportResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+
+	ctx.Header("Content-Type", "application/json")
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="export-%d-%s.json"`, exportId, time.Now().Format("2006-01-02")))
+	ctx.Status(http.StatusOK)
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		} else if err != nil {
+			// the status line is already sent, all that is left is to cut the body short
+			svc.Logger.Error("failed to stream data export", zap.Error(err), zap.Int64("export_id", exportId))
+			ctx.Abort()
+			return
+		}
+		if _, err := ctx.Writer.Write(response.GetChunk()); err != nil {
+			return
+		}
+	}
+}
+
+func (svc *WebService) exportIdParam(ctx *gin.Context) (int64, bool) {
+	exportId, err := strconv.ParseInt(ctx.Param("export_id"), 10, 64)
+	if err != nil {
+		svc.Logger.Error("invalid export id", zap.String("export_id", ctx.Param("export_id")))
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid export id"})
+		return 0, false
+	}
+	return exportId, true
+}
@@ -0,0 +1,101 @@
+package web_service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (svc *WebService) ListFollowRequests(ctx *gin.Context) {
+	response, err := svc.UserAndPostClient.ListFollowRequests(ctx, &user_and_post.ListFollowRequestsRequest{
+		UserId: getCurrentUserId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.ListFollowRequestsResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+	ctx.JSON(http.StatusOK, model.ListRelatedUsersResponse{Users: toRelatedUserResponses(response.GetRequesters())})
+}
+
+func (svc *WebService) ApproveFollowRequest(ctx *gin.Context) {
+	requesterUserId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.ApproveFollowRequest(ctx, &user_and_post.ApproveFollowRequestRequest{
+		UserId:          getCurrentUserId(ctx),
+		RequesterUserId: requesterUserId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.ApproveFollowRequestResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.ApproveFollowRequestResponse_REQUEST_NOT_FOUND:
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "follow request not found"})
+	default:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "follow request approved"})
+	}
+}
+
+func (svc *WebService) RejectFollowRequest(ctx *gin.Context) {
+	requesterUserId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.RejectFollowRequest(ctx, &user_and_post.RejectFollowRequestRequest{
+		UserId:          getCurrentUserId(ctx),
+		RequesterUserId: requesterUserId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.RejectFollowRequestResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.RejectFollowRequestResponse_REQUEST_NOT_FOUND:
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "follow request not found"})
+	default:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "follow request rejected"})
+	}
+}
+
+func (svc *WebService) CancelFollowRequest(ctx *gin.Context) {
+	targetUserId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.CancelFollowRequest(ctx, &user_and_post.CancelFollowRequestRequest{
+		UserId:       getCurrentUserId(ctx),
+		TargetUserId: targetUserId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.CancelFollowRequestResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.CancelFollowRequestResponse_REQUEST_NOT_FOUND:
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "follow request not found"})
+	default:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "follow request cancelled"})
+	}
+}
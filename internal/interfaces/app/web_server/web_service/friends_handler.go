@@ -1,7 +1,7 @@
 package web_service
 
 import (
-	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
@@ -20,22 +20,7 @@ func (svc *WebService) FollowUser(ctx *gin.Context) {
 		return
 	}
 
-	// get current user from session id
-	sessionId, err := ctx.Cookie("session_id")
-	if errors.Is(err, http.ErrNoCookie) {
-		svc.Logger.Error("unauthorized")
-		ctx.JSON(http.StatusUnauthorized, model.MessageResponse{Message: "unauthorized"})
-		return
-	} else if err != nil {
-		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
-		return
-	}
-
-	currentUserId, err := strconv.ParseInt(sessionId, 10, 64)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
-		return
-	}
+	currentUserId := getCurrentUserId(ctx)
 
 	response, err := svc.UserAndPostClient.FollowUser(ctx, &user_and_post.FollowUserRequest{
 		UserId:          currentUserId,
@@ -45,12 +30,24 @@ func (svc *WebService) FollowUser(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
 		return
 	}
-	if response.Status == user_and_post.FollowUserResponse_USER_NOT_FOUND {
+	if response.Status == user_and_post.FollowUserResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	} else if response.Status == user_and_post.FollowUserResponse_USER_NOT_FOUND {
 		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "user not found"})
 		return
+	} else if response.Status == user_and_post.FollowUserResponse_CANNOT_FOLLOW_SELF {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "cannot follow yourself"})
+		return
 	} else if response.Status == user_and_post.FollowUserResponse_ALREADY_FOLLOWED {
 		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "user already followed"})
 		return
+	} else if response.Status == user_and_post.FollowUserResponse_BLOCKED {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "blocked"})
+		return
+	} else if response.Status == user_and_post.FollowUserResponse_REQUESTED {
+		ctx.JSON(http.StatusAccepted, model.MessageResponse{Message: "follow request sent"})
+		return
 	}
 
 	ctx.JSON(http.StatusOK, model.MessageResponse{Message: "follow user successfully"})
@@ -64,22 +61,7 @@ func (svc *WebService) UnfollowUser(ctx *gin.Context) {
 		return
 	}
 
-	// get current user from session id
-	sessionId, err := ctx.Cookie("session_id")
-	if errors.Is(err, http.ErrNoCookie) {
-		svc.Logger.Error("unauthorized")
-		ctx.JSON(http.StatusUnauthorized, model.MessageResponse{Message: "unauthorized"})
-		return
-	} else if err != nil {
-		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
-		return
-	}
-
-	currentUserId, err := strconv.ParseInt(sessionId, 10, 64)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
-		return
-	}
+	currentUserId := getCurrentUserId(ctx)
 
 	response, err := svc.UserAndPostClient.UnfollowUser(ctx, &user_and_post.UnfollowUserRequest{
 		UserId:          currentUserId,
@@ -89,7 +71,10 @@ func (svc *WebService) UnfollowUser(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
 		return
 	}
-	if response.Status == user_and_post.UnfollowUserResponse_USER_NOT_FOUND {
+	if response.Status == user_and_post.UnfollowUserResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	} else if response.Status == user_and_post.UnfollowUserResponse_USER_NOT_FOUND {
 		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "user not found"})
 		return
 	} else if response.Status == user_and_post.UnfollowUserResponse_NOT_FOLLOWED {
@@ -107,8 +92,17 @@ func (svc *WebService) GetFollowList(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
 		return
 	}
+	limit, ok := parseLimit(ctx)
+	if !ok {
+		return
+	}
 
-	response, err := svc.UserAndPostClient.GetFollowerList(ctx, &user_and_post.GetFollowerListRequest{UserId: userId})
+	response, err := svc.UserAndPostClient.GetFollowerList(ctx, &user_and_post.GetFollowerListRequest{
+		UserId:         userId,
+		Limit:          limit,
+		Cursor:         ctx.Query("cursor"),
+		UserNamePrefix: ctx.Query("prefix"),
+	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
 		return
@@ -116,7 +110,106 @@ func (svc *WebService) GetFollowList(ctx *gin.Context) {
 	if response.Status == user_and_post.GetFollowerListResponse_USER_NOT_FOUND {
 		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "user not found"})
 		return
+	} else if response.Status == user_and_post.GetFollowerListResponse_INVALID_CURSOR {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid cursor"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.ListFollowsResponse{
+		Users:      toFollowResponses(response.GetFollowers()),
+		NextCursor: response.GetNextCursor(),
+	})
+}
+
+func (svc *WebService) GetFollowingList(ctx *gin.Context) {
+	userId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+	limit, ok := parseLimit(ctx)
+	if !ok {
+		return
 	}
 
-	ctx.JSON(http.StatusOK, response.GetFollowers())
+	response, err := svc.UserAndPostClient.GetFollowingList(ctx, &user_and_post.GetFollowingListRequest{
+		UserId:         userId,
+		Limit:          limit,
+		Cursor:         ctx.Query("cursor"),
+		UserNamePrefix: ctx.Query("prefix"),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.GetFollowingListResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "user not found"})
+		return
+	} else if response.Status == user_and_post.GetFollowingListResponse_INVALID_CURSOR {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid cursor"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.ListFollowsResponse{
+		Users:      toFollowResponses(response.GetFollowing()),
+		NextCursor: response.GetNextCursor(),
+	})
+}
+
+func (svc *WebService) SuggestFollows(ctx *gin.Context) {
+	limit, ok := parseLimit(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := svc.UserAndPostClient.SuggestFollows(ctx, &user_and_post.SuggestFollowsRequest{
+		UserId: getCurrentUserId(ctx),
+		Limit:  limit,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.SuggestFollowsResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+
+	suggestions := make([]model.FollowSuggestionResponse, 0, len(response.GetSuggestions()))
+	for _, suggestion := range response.GetSuggestions() {
+		suggestions = append(suggestions, model.FollowSuggestionResponse{
+			UserId:           suggestion.GetUserId(),
+			UserName:         suggestion.GetUserName(),
+			FriendsOfFriends: suggestion.GetFriendsOfFriends(),
+			MutualFollowers:  suggestion.GetMutualFollowers(),
+		})
+	}
+	ctx.JSON(http.StatusOK, model.ListFollowSuggestionsResponse{Suggestions: suggestions})
+}
+
+// parseLimit reads the optional limit query parameter, it renders the error
+// response itself when the value is not a number
+func parseLimit(ctx *gin.Context) (int32, bool) {
+	limit := ctx.Query("limit")
+	if limit == "" {
+		return 0, true
+	}
+	parsedLimit, err := strconv.ParseInt(limit, 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: fmt.Sprintf("invalid limit: %s", limit)})
+		return 0, false
+	}
+	return int32(parsedLimit), true
+}
+
+func toFollowResponses(follows []*user_and_post.FollowInfo) []model.FollowResponse {
+	responses := make([]model.FollowResponse, 0, len(follows))
+	for _, follow := range follows {
+		responses = append(responses, model.FollowResponse{
+			UserId:       follow.GetUserId(),
+			UserName:     follow.GetUserName(),
+			FollowedTime: follow.GetFollowedTime().AsTime(),
+		})
+	}
+	return responses
 }
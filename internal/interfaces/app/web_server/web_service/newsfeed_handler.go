@@ -2,21 +2,14 @@ package web_service
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/newsfeed"
 	"github.com/khailequang334/social_network/internal/model"
-	"go.uber.org/zap"
 )
 
 func (svc *WebService) GetNewsfeed(ctx *gin.Context) {
-	userId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
-	if err != nil {
-		svc.Logger.Error("invalid user id", zap.String("user_id", ctx.Param("user_id")))
-		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
-		return
-	}
+	userId := getCurrentUserId(ctx)
 
 	response, err := svc.NewsfeedClient.GenerateNewsfeed(ctx, &newsfeed.GenerateNewsfeedRequest{
 		UserId: userId,
@@ -0,0 +1,190 @@
+package web_service
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/khailequang334/social_network/internal/identity"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+	"github.com/khailequang334/social_network/internal/sso"
+	"go.uber.org/zap"
+)
+
+// oidcStateCookieName binds the state to the browser that started the flow,
+// so a callback url can not be replayed in a victim's browser
+const oidcStateCookieName = "oidc_state"
+
+func (svc *WebService) OIDCLogin(ctx *gin.Context) {
+	svc.beginOIDC(ctx, 0)
+}
+
+// OIDCLink starts the same flow but links the identity to the current user on callback
+func (svc *WebService) OIDCLink(ctx *gin.Context) {
+	svc.beginOIDC(ctx, getCurrentUserId(ctx))
+}
+
+func (svc *WebService) beginOIDC(ctx *gin.Context, linkUserId int64) {
+	issueTokens := ctx.Query("issue_tokens") == "true"
+	authURL, state, err := svc.SSO.Begin(ctx, ctx.Param("provider"), linkUserId, issueTokens)
+	if errors.Is(err, sso.ErrUnknownProvider) {
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "unknown provider"})
+		return
+	} else if err != nil {
+		svc.Logger.Error("failed to start oidc login", zap.Error(err))
+		ctx.JSON(http.StatusBadGateway, model.MessageResponse{Message: "identity provider unavailable"})
+		return
+	}
+
+	ctx.SetSameSite(http.SameSiteLaxMode)
+	ctx.SetCookie(oidcStateCookieName, state, int(svc.SSO.StateTTL().Seconds()), "/api/v1/oidc", "", svc.SessionConfig.Secure, true)
+	ctx.Redirect(http.StatusFound, authURL)
+}
+
+func (svc *WebService) OIDCCallback(ctx *gin.Context) {
+	countExporter.WithLabelValues("oidc_login", "total").Inc()
+
+	if providerError := ctx.Query("error"); providerError != "" {
+		countExporter.WithLabelValues("oidc_login", "provider_error").Inc()
+		ctx.JSON(http.StatusUnauthorized, model.MessageResponse{Message: "login rejected by provider: " + providerError})
+		return
+	}
+
+	state := ctx.Query("state")
+	cookieState, _ := ctx.Cookie(oidcStateCookieName)
+	ctx.SetCookie(oidcStateCookieName, "", -1, "/api/v1/oidc", "", svc.SessionConfig.Secure, true)
+	if state == "" || cookieState != state {
+		countExporter.WithLabelValues("oidc_login", "invalid_state").Inc()
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid state"})
+		return
+	}
+
+	loginState, claims, err := svc.SSO.Complete(ctx, ctx.Param("provider"), state, ctx.Query("code"))
+	if errors.Is(err, sso.ErrInvalidState) || errors.Is(err, sso.ErrUnknownProvider) {
+		countExporter.WithLabelValues("oidc_login", "invalid_state").Inc()
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid or expired state, start the login again"})
+		return
+	} else if err != nil {
+		countExporter.WithLabelValues("oidc_login", "exchange_failed").Inc()
+		svc.Logger.Error("failed to complete oidc login", zap.Error(err))
+		ctx.JSON(http.StatusUnauthorized, model.MessageResponse{Message: "login with provider failed"})
+		return
+	}
+
+	if loginState.LinkUserId != 0 {
+		svc.linkIdentity(ctx, loginState.LinkUserId, claims)
+		return
+	}
+
+	response, err := svc.UserAndPostClient.LoginWithIdentity(ctx, &user_and_post.LoginWithIdentityRequest{
+		Issuer:            claims.Issuer,
+		Subject:           claims.Subject,
+		Email:             claims.Email,
+		EmailVerified:     claims.EmailVerified,
+		PreferredUserName: claims.PreferredUsername,
+		FirstName:         claims.GivenName,
+		LastName:          claims.FamilyName,
+	})
+	if err != nil {
+		countExporter.WithLabelValues("oidc_login", "call_api_failed").Inc()
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.GetStatus() {
+	case user_and_post.LoginWithIdentityResponse_EMAIL_TAKEN:
+		countExporter.WithLabelValues("oidc_login", "email_taken").Inc()
+		ctx.JSON(http.StatusConflict, model.MessageResponse{Message: "an account with this email exists, log in and link the identity from the account"})
+	case user_and_post.LoginWithIdentityResponse_INVALID_EMAIL:
+		countExporter.WithLabelValues("oidc_login", "invalid_email").Inc()
+		ctx.JSON(http.StatusUnprocessableEntity, model.MessageResponse{Message: "the provider did not share a valid email address"})
+	case user_and_post.LoginWithIdentityResponse_ACCOUNT_SUSPENDED:
+		countExporter.WithLabelValues("oidc_login", "account_suspended").Inc()
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "account suspended"})
+	case user_and_post.LoginWithIdentityResponse_SECOND_FACTOR_REQUIRED:
+		countExporter.WithLabelValues("oidc_login", "second_factor_required").Inc()
+		ctx.JSON(http.StatusOK, &model.SecondFactorChallengeResponse{
+			Message:     "second factor required",
+			ChallengeId: response.GetChallengeId(),
+		})
+	default:
+		svc.startLogin(ctx, "oidc_login", response.GetUserId(), loginState.IssueTokens)
+	}
+}
+
+func (svc *WebService) linkIdentity(ctx *gin.Context, userId int64, claims *sso.Claims) {
+	// the callback is not behind AuthRequired, the user was authenticated when the flow started
+	response, err := svc.UserAndPostClient.LinkIdentity(identity.WithCallerId(ctx, userId), &user_and_post.LinkIdentityRequest{
+		UserId:  userId,
+		Issuer:  claims.Issuer,
+		Subject: claims.Subject,
+		Email:   claims.Email,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.LinkIdentityResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "user not found"})
+		return
+	} else if response.Status == user_and_post.LinkIdentityResponse_ALREADY_LINKED {
+		ctx.JSON(http.StatusConflict, model.MessageResponse{Message: "identity already linked to another account"})
+		return
+	} else if response.Status == user_and_post.LinkIdentityResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+	ctx.JSON(http.StatusOK, model.MessageResponse{Message: "identity linked successfully"})
+}
+
+func (svc *WebService) ListIdentities(ctx *gin.Context) {
+	response, err := svc.UserAndPostClient.ListIdentities(ctx, &user_and_post.ListIdentitiesRequest{
+		UserId: getCurrentUserId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.ListIdentitiesResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+
+	identities := make([]model.IdentityResponse, 0, len(response.GetIdentities()))
+	for _, info := range response.GetIdentities() {
+		identities = append(identities, model.IdentityResponse{
+			IdentityId: info.GetIdentityId(),
+			Issuer:     info.GetIssuer(),
+			Subject:    info.GetSubject(),
+			Email:      info.GetEmail(),
+			LinkedTime: info.GetLinkedTime().AsTime(),
+		})
+	}
+	ctx.JSON(http.StatusOK, model.ListIdentitiesResponse{Identities: identities})
+}
+
+func (svc *WebService) UnlinkIdentity(ctx *gin.Context) {
+	identityId, err := strconv.ParseInt(ctx.Param("identity_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid identity id"})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.UnlinkIdentity(ctx, &user_and_post.UnlinkIdentityRequest{
+		UserId:     getCurrentUserId(ctx),
+		IdentityId: identityId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.UnlinkIdentityResponse_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "identity not found"})
+		return
+	} else if response.Status == user_and_post.UnlinkIdentityResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+	ctx.JSON(http.StatusOK, model.MessageResponse{Message: "identity unlinked successfully"})
+}
@@ -1,10 +1,10 @@
 package web_service
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
@@ -17,13 +17,22 @@ func (svc *WebService) CreatePost(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: err.Error()})
 		return
 	}
+	audience, fieldErrors := parseAudience(request.Audience, nil)
+	if len(fieldErrors) > 0 {
+		renderFieldErrors(ctx, fieldErrors)
+		return
+	}
 
-	response, err := svc.UserAndPostClient.CreatePost(ctx, &user_and_post.CreatePostRequest{
-		UserId:           request.UserId,
+	createPostRequest := &user_and_post.CreatePostRequest{
+		UserId:           getCurrentUserId(ctx),
 		ContentText:      request.ContentText,
 		ContentImagePath: request.ContentImagePath,
-		Visible:          request.Visible,
-	})
+	}
+	if audience != nil {
+		createPostRequest.Audience = *audience
+	}
+
+	response, err := svc.UserAndPostClient.CreatePost(ctx, createPostRequest)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
 		return
@@ -31,6 +40,9 @@ func (svc *WebService) CreatePost(ctx *gin.Context) {
 	if response.Status == user_and_post.CreatePostResponse_USER_NOT_FOUND {
 		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "user not found"})
 		return
+	} else if response.Status == user_and_post.CreatePostResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
 	}
 
 	ctx.JSON(http.StatusOK, model.MessageResponse{Message: fmt.Sprintf("create post successfully with id: %d", response.PostId)})
@@ -55,14 +67,195 @@ func (svc *WebService) GetPost(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, model.PostDetailResponse{
-		PostID:           response.Post.PostId,
-		UserID:           response.Post.UserId,
-		ContentText:      response.Post.ContentText,
-		ContentImagePath: response.Post.ContentImagePath,
-		Visible:          response.Post.Visible,
-		CreatedTime:      response.Post.CreatedTime.AsTime(),
+	ctx.JSON(http.StatusOK, toPostResponse(response.GetPost()))
+}
+
+func (svc *WebService) ListUserPosts(ctx *gin.Context) {
+	userId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+	limit, ok := parseLimit(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := svc.UserAndPostClient.ListUserPosts(ctx, &user_and_post.ListUserPostsRequest{
+		UserId: userId,
+		Limit:  limit,
+		Cursor: ctx.Query("cursor"),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.ListUserPostsResponse_USER_NOT_FOUND:
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "user not found"})
+	case user_and_post.ListUserPostsResponse_INVALID_CURSOR:
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid cursor"})
+	case user_and_post.ListUserPostsResponse_HIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "posts of this user are hidden"})
+	default:
+		ctx.JSON(http.StatusOK, model.ListPostsResponse{
+			PinnedPosts: toPostResponses(response.GetPinnedPosts()),
+			Posts:       toPostResponses(response.GetPosts()),
+			NextCursor:  response.GetNextCursor(),
+		})
+	}
+}
+
+func (svc *WebService) PinPost(ctx *gin.Context) {
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: fmt.Sprintf("invalid post id: %s", ctx.Param("post_id"))})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.PinPost(ctx, &user_and_post.PinPostRequest{
+		PostId: postId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.PinPostResponse_POST_NOT_FOUND:
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "post not found"})
+	case user_and_post.PinPostResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.PinPostResponse_TOO_MANY_PINNED:
+		ctx.JSON(http.StatusConflict, model.MessageResponse{Message: "too many pinned posts, unpin one first"})
+	default:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: fmt.Sprintf("pin post successfully with id: %d", postId)})
+	}
+}
+
+func (svc *WebService) UnpinPost(ctx *gin.Context) {
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: fmt.Sprintf("invalid post id: %s", ctx.Param("post_id"))})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.UnpinPost(ctx, &user_and_post.UnpinPostRequest{
+		PostId: postId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.UnpinPostResponse_POST_NOT_FOUND:
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "post not found"})
+	case user_and_post.UnpinPostResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.UnpinPostResponse_NOT_PINNED:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: "post not pinned"})
+	default:
+		ctx.JSON(http.StatusOK, model.MessageResponse{Message: fmt.Sprintf("unpin post successfully with id: %d", postId)})
+	}
+}
+
+func (svc *WebService) ListPostRevisions(ctx *gin.Context) {
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: fmt.Sprintf("invalid post id: %s", ctx.Param("post_id"))})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.ListPostRevisions(ctx, &user_and_post.ListPostRevisionsRequest{
+		PostId: postId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.ListPostRevisionsResponse_POST_NOT_FOUND:
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "post not found"})
+	case user_and_post.ListPostRevisionsResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	default:
+		revisions := make([]model.PostRevisionResponse, 0, len(response.GetRevisions()))
+		for _, revision := range response.GetRevisions() {
+			revisions = append(revisions, toPostRevisionResponse(revision))
+		}
+		ctx.JSON(http.StatusOK, model.ListPostRevisionsResponse{Revisions: revisions})
+	}
+}
+
+func (svc *WebService) GetPostRevision(ctx *gin.Context) {
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: fmt.Sprintf("invalid post id: %s", ctx.Param("post_id"))})
+		return
+	}
+	revisionId, err := strconv.ParseInt(ctx.Param("revision_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: fmt.Sprintf("invalid revision id: %s", ctx.Param("revision_id"))})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.GetPostRevision(ctx, &user_and_post.GetPostRevisionRequest{
+		PostId:     postId,
+		RevisionId: revisionId,
 	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.GetPostRevisionResponse_POST_NOT_FOUND:
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "post not found"})
+	case user_and_post.GetPostRevisionResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.GetPostRevisionResponse_REVISION_NOT_FOUND:
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "revision not found"})
+	default:
+		ctx.JSON(http.StatusOK, toPostRevisionResponse(response.GetRevision()))
+	}
+}
+
+func toPostRevisionResponse(revision *user_and_post.PostRevision) model.PostRevisionResponse {
+	return model.PostRevisionResponse{
+		RevisionID:       revision.GetRevisionId(),
+		PostID:           revision.GetPostId(),
+		EditorID:         revision.GetEditorUserId(),
+		ContentText:      revision.GetContentText(),
+		ContentImagePath: revision.GetContentImagePath(),
+		Audience:         formatAudience(revision.GetAudience()),
+		CreatedTime:      revision.GetCreatedTime().AsTime(),
+	}
+}
+
+func toPostResponse(post *user_and_post.Post) model.PostDetailResponse {
+	response := model.PostDetailResponse{
+		PostID:           post.GetPostId(),
+		UserID:           post.GetUserId(),
+		ContentText:      post.GetContentText(),
+		ContentImagePath: post.GetContentImagePath(),
+		Audience:         formatAudience(post.GetAudience()),
+		CreatedTime:      post.GetCreatedTime().AsTime(),
+	}
+	if post.GetPinnedTime() != nil {
+		pinnedTime := post.GetPinnedTime().AsTime()
+		response.PinnedTime = &pinnedTime
+	}
+	if post.GetEditedTime() != nil {
+		editedTime := post.GetEditedTime().AsTime()
+		response.EditedTime = &editedTime
+	}
+	return response
+}
+
+func toPostResponses(posts []*user_and_post.Post) []model.PostDetailResponse {
+	responses := make([]model.PostDetailResponse, 0, len(posts))
+	for _, post := range posts {
+		responses = append(responses, toPostResponse(post))
+	}
+	return responses
 }
 
 func (svc *WebService) DeletePost(ctx *gin.Context) {
@@ -82,6 +275,9 @@ func (svc *WebService) DeletePost(ctx *gin.Context) {
 	if response.Status == user_and_post.DeletePostResponse_POST_NOT_FOUND {
 		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "post not found"})
 		return
+	} else if response.Status == user_and_post.DeletePostResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
 	}
 
 	ctx.JSON(http.StatusOK, model.MessageResponse{Message: fmt.Sprintf("delete post successfully with id: %d", postId)})
@@ -108,8 +304,11 @@ func (svc *WebService) EditPost(ctx *gin.Context) {
 	if request.ContentImagePath != nil {
 		editPostRequest.ContentImagePath = request.ContentImagePath
 	}
-	if request.Visible != nil {
-		editPostRequest.Visible = request.Visible
+	var fieldErrors []*user_and_post.FieldError
+	editPostRequest.Audience, fieldErrors = parseAudience(request.Audience, fieldErrors)
+	if len(fieldErrors) > 0 {
+		renderFieldErrors(ctx, fieldErrors)
+		return
 	}
 
 	response, err := svc.UserAndPostClient.EditPost(ctx, editPostRequest)
@@ -120,6 +319,9 @@ func (svc *WebService) EditPost(ctx *gin.Context) {
 	if response.Status == user_and_post.EditPostResponse_POST_NOT_FOUND {
 		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "post not found"})
 		return
+	} else if response.Status == user_and_post.EditPostResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
 	}
 
 	ctx.JSON(http.StatusOK, model.MessageResponse{Message: fmt.Sprintf("edit post successfully with id: %d", postId)})
@@ -132,22 +334,7 @@ func (svc *WebService) LikePost(ctx *gin.Context) {
 		return
 	}
 
-	// get current user from session id
-	sessionId, err := ctx.Cookie("session_id")
-	if errors.Is(err, http.ErrNoCookie) {
-		svc.Logger.Error("unauthorized")
-		ctx.JSON(http.StatusUnauthorized, model.MessageResponse{Message: "unauthorized"})
-		return
-	} else if err != nil {
-		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
-		return
-	}
-
-	currentUserId, err := strconv.ParseInt(sessionId, 10, 64)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
-		return
-	}
+	currentUserId := getCurrentUserId(ctx)
 
 	response, err := svc.UserAndPostClient.LikePost(ctx, &user_and_post.LikePostRequest{
 		PostId: postId,
@@ -163,6 +350,12 @@ func (svc *WebService) LikePost(ctx *gin.Context) {
 	} else if response.Status == user_and_post.LikePostResponse_USER_NOT_FOUND {
 		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "user not found"})
 		return
+	} else if response.Status == user_and_post.LikePostResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	} else if response.Status == user_and_post.LikePostResponse_BLOCKED {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "blocked"})
+		return
 	}
 
 	ctx.JSON(http.StatusOK, model.MessageResponse{Message: fmt.Sprintf("like post successfully with id: %d", postId)})
@@ -181,22 +374,7 @@ func (svc *WebService) CreatePostComment(ctx *gin.Context) {
 		return
 	}
 
-	// get current user from session id
-	sessionId, err := ctx.Cookie("session_id")
-	if errors.Is(err, http.ErrNoCookie) {
-		svc.Logger.Error("unauthorized")
-		ctx.JSON(http.StatusUnauthorized, model.MessageResponse{Message: "unauthorized"})
-		return
-	} else if err != nil {
-		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
-		return
-	}
-
-	currentUserId, err := strconv.ParseInt(sessionId, 10, 64)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
-		return
-	}
+	currentUserId := getCurrentUserId(ctx)
 
 	resp, err := svc.UserAndPostClient.CommentPost(ctx, &user_and_post.CommentPostRequest{
 		PostId:      postId,
@@ -214,7 +392,67 @@ func (svc *WebService) CreatePostComment(ctx *gin.Context) {
 	} else if resp.Status == user_and_post.CommentPostResponse_USER_NOT_FOUND {
 		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "user not found"})
 		return
+	} else if resp.Status == user_and_post.CommentPostResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	} else if resp.Status == user_and_post.CommentPostResponse_BLOCKED {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "blocked"})
+		return
 	}
 
 	ctx.JSON(http.StatusOK, model.MessageResponse{Message: fmt.Sprintf("create post comment successfully with id: %d", resp.CommentId)})
 }
+
+func (svc *WebService) ReportPost(ctx *gin.Context) {
+	postId, err := strconv.ParseInt(ctx.Param("post_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: fmt.Sprintf("invalid post id: %s", ctx.Param("post_id"))})
+		return
+	}
+
+	var request model.ReportPostRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if request.Reason == "" || len(request.Reason) > 255 {
+		renderFieldErrors(ctx, []*user_and_post.FieldError{{Field: "reason", Message: "must be between 1 and 255 characters"}})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.ReportPost(ctx, &user_and_post.ReportPostRequest{
+		UserId: getCurrentUserId(ctx),
+		PostId: postId,
+		Reason: request.Reason,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.ReportPostResponse_POST_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "post not found"})
+		return
+	} else if response.Status == user_and_post.ReportPostResponse_ALREADY_REPORTED {
+		ctx.JSON(http.StatusConflict, model.MessageResponse{Message: "post already reported"})
+		return
+	} else if response.Status == user_and_post.ReportPostResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+	ctx.JSON(http.StatusOK, model.MessageResponse{Message: "post reported"})
+}
+
+func parseAudience(value *string, fieldErrors []*user_and_post.FieldError) (*user_and_post.Post_Audience, []*user_and_post.FieldError) {
+	if value == nil {
+		return nil, fieldErrors
+	}
+	audience, ok := user_and_post.Post_Audience_value[strings.ToUpper(*value)]
+	if !ok {
+		return nil, append(fieldErrors, &user_and_post.FieldError{Field: "audience", Message: "must be one of public, followers, close_friends or only_me"})
+	}
+	return user_and_post.Post_Audience(audience).Enum(), fieldErrors
+}
+
+func formatAudience(audience user_and_post.Post_Audience) string {
+	return strings.ToLower(audience.String())
+}
@@ -0,0 +1,136 @@
+package web_service
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+func (svc *WebService) GetUser(ctx *gin.Context) {
+	userId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		svc.Logger.Error("invalid user id", zap.String("user_id", ctx.Param("user_id")))
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.GetUser(ctx, &user_and_post.GetUserRequest{UserId: userId})
+	svc.renderProfile(ctx, response, err)
+}
+
+func (svc *WebService) GetUserByUserName(ctx *gin.Context) {
+	response, err := svc.UserAndPostClient.GetUserByUserName(ctx, &user_and_post.GetUserByUserNameRequest{
+		UserName: ctx.Param("user_name"),
+	})
+	svc.renderProfile(ctx, response, err)
+}
+
+// GetUsers takes a comma separated "ids" query parameter, e.g. /users?ids=1,2,3
+func (svc *WebService) GetUsers(ctx *gin.Context) {
+	userIds, ok := parseUserIds(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := svc.UserAndPostClient.GetUsers(ctx, &user_and_post.GetUsersRequest{UserIds: userIds})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.GetUsersResponse_TOO_MANY_USERS {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "too many user ids"})
+		return
+	}
+
+	users := make([]model.UserProfileResponse, 0, len(response.GetProfiles()))
+	for _, profile := range response.GetProfiles() {
+		users = append(users, toUserProfileResponse(profile))
+	}
+	ctx.JSON(http.StatusOK, model.ListUserProfilesResponse{Users: users})
+}
+
+func (svc *WebService) renderProfile(ctx *gin.Context, response *user_and_post.GetUserResponse, err error) {
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.GetUserResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "user not found"})
+		return
+	}
+
+	profile := toUserProfileResponse(response.GetProfile())
+	profile.RedirectedFrom = response.GetRedirectedFrom()
+	ctx.JSON(http.StatusOK, profile)
+}
+
+// parseUserIds reads the comma separated "ids" query parameter, it renders
+// the error response itself when an id is not a number
+func parseUserIds(ctx *gin.Context) ([]int64, bool) {
+	var userIds []int64
+	for _, rawId := range strings.Split(ctx.Query("ids"), ",") {
+		if rawId == "" {
+			continue
+		}
+		userId, err := strconv.ParseInt(rawId, 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+			return nil, false
+		}
+		userIds = append(userIds, userId)
+	}
+	return userIds, true
+}
+
+func toUserProfileResponse(profile *user_and_post.UserProfile) model.UserProfileResponse {
+	response := model.UserProfileResponse{
+		UserId:           profile.GetUserId(),
+		UserName:         profile.GetUserName(),
+		FirstName:        profile.GetFirstName(),
+		LastName:         profile.GetLastName(),
+		DisplayName:      profile.GetDisplayName(),
+		Bio:              profile.GetBio(),
+		AvatarPath:       profile.GetAvatarPath(),
+		Location:         profile.GetLocation(),
+		Website:          profile.GetWebsite(),
+		Pronouns:         profile.GetPronouns(),
+		FollowerCount:    profile.GetFollowerCount(),
+		FollowingCount:   profile.GetFollowingCount(),
+		PostCount:        profile.GetPostCount(),
+		FollowedByCaller: profile.GetFollowedByCaller(),
+		Private:          profile.GetPrivate(),
+	}
+	if visibility := profile.GetVisibility(); visibility != nil {
+		response.Visibility = &model.ProfileVisibilityResponse{
+			Bio:      formatVisibility(visibility.GetBio()),
+			Avatar:   formatVisibility(visibility.GetAvatar()),
+			Location: formatVisibility(visibility.GetLocation()),
+			Website:  formatVisibility(visibility.GetWebsite()),
+			Pronouns: formatVisibility(visibility.GetPronouns()),
+		}
+	}
+	return response
+}
+
+// parseVisibility maps "public", "followers" or "private" to the proto enum,
+// a nil value stays nil so the setting is left unchanged
+func parseVisibility(field string, value *string, fieldErrors []*user_and_post.FieldError) (*user_and_post.Visibility, []*user_and_post.FieldError) {
+	if value == nil {
+		return nil, fieldErrors
+	}
+	visibility, ok := user_and_post.Visibility_value[strings.ToUpper(*value)]
+	if !ok {
+		return nil, append(fieldErrors, &user_and_post.FieldError{Field: field, Message: "must be one of public, followers or private"})
+	}
+	return user_and_post.Visibility(visibility).Enum(), fieldErrors
+}
+
+func formatVisibility(visibility user_and_post.Visibility) string {
+	return strings.ToLower(visibility.String())
+}
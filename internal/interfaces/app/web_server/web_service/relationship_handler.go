@@ -0,0 +1,79 @@
+package web_service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (svc *WebService) GetRelationship(ctx *gin.Context) {
+	targetUserId, err := strconv.ParseInt(ctx.Param("user_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid user id"})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.GetRelationship(ctx, &user_and_post.GetRelationshipRequest{
+		UserId:       getCurrentUserId(ctx),
+		TargetUserId: targetUserId,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.GetRelationshipResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+	case user_and_post.GetRelationshipResponse_USER_NOT_FOUND:
+		ctx.JSON(http.StatusNotFound, model.MessageResponse{Message: "user not found"})
+	default:
+		ctx.JSON(http.StatusOK, toRelationshipResponse(response.GetRelationship()))
+	}
+}
+
+// GetRelationships takes a comma separated "ids" query parameter, e.g. /users/relationships?ids=1,2,3
+func (svc *WebService) GetRelationships(ctx *gin.Context) {
+	targetUserIds, ok := parseUserIds(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := svc.UserAndPostClient.GetRelationships(ctx, &user_and_post.GetRelationshipsRequest{
+		UserId:        getCurrentUserId(ctx),
+		TargetUserIds: targetUserIds,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.Status {
+	case user_and_post.GetRelationshipsResponse_FORBIDDEN:
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	case user_and_post.GetRelationshipsResponse_TOO_MANY_USERS:
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "too many user ids"})
+		return
+	}
+
+	relationships := make([]model.RelationshipResponse, 0, len(response.GetRelationships()))
+	for _, relationship := range response.GetRelationships() {
+		relationships = append(relationships, toRelationshipResponse(relationship))
+	}
+	ctx.JSON(http.StatusOK, model.ListRelationshipsResponse{Relationships: relationships})
+}
+
+func toRelationshipResponse(relationship *user_and_post.Relationship) model.RelationshipResponse {
+	return model.RelationshipResponse{
+		UserId:          relationship.GetTargetUserId(),
+		Following:       relationship.GetFollowing(),
+		FollowedBy:      relationship.GetFollowedBy(),
+		Mutual:          relationship.GetMutual(),
+		Blocking:        relationship.GetBlocking(),
+		Muting:          relationship.GetMuting(),
+		FollowRequested: relationship.GetFollowRequested(),
+	}
+}
@@ -0,0 +1,124 @@
+package web_service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+)
+
+func (svc *WebService) EnrollSecondFactor(ctx *gin.Context) {
+	response, err := svc.UserAndPostClient.EnrollSecondFactor(ctx, &user_and_post.EnrollSecondFactorRequest{
+		UserId: getCurrentUserId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.EnrollSecondFactorResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "user not found"})
+		return
+	} else if response.Status == user_and_post.EnrollSecondFactorResponse_ALREADY_ENABLED {
+		ctx.JSON(http.StatusConflict, model.MessageResponse{Message: "two-factor authentication already enabled"})
+		return
+	} else if response.Status == user_and_post.EnrollSecondFactorResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.EnrollSecondFactorResponse{
+		Secret:     response.GetSecret(),
+		OtpauthUri: response.GetOtpauthUri(),
+	})
+}
+
+func (svc *WebService) ConfirmSecondFactor(ctx *gin.Context) {
+	var request model.ConfirmSecondFactorRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.ConfirmSecondFactor(ctx, &user_and_post.ConfirmSecondFactorRequest{
+		UserId: getCurrentUserId(ctx),
+		Code:   request.Code,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.ConfirmSecondFactorResponse_NOT_ENROLLED {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "two-factor authentication not enrolled"})
+		return
+	} else if response.Status == user_and_post.ConfirmSecondFactorResponse_ALREADY_ENABLED {
+		ctx.JSON(http.StatusConflict, model.MessageResponse{Message: "two-factor authentication already enabled"})
+		return
+	} else if response.Status == user_and_post.ConfirmSecondFactorResponse_INVALID_CODE {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid code"})
+		return
+	} else if response.Status == user_and_post.ConfirmSecondFactorResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.RecoveryCodesResponse{RecoveryCodes: response.GetRecoveryCodes()})
+}
+
+func (svc *WebService) GenerateRecoveryCodes(ctx *gin.Context) {
+	response, err := svc.UserAndPostClient.GenerateRecoveryCodes(ctx, &user_and_post.GenerateRecoveryCodesRequest{
+		UserId: getCurrentUserId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.GenerateRecoveryCodesResponse_NOT_ENABLED {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "two-factor authentication not enabled"})
+		return
+	} else if response.Status == user_and_post.GenerateRecoveryCodesResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.RecoveryCodesResponse{RecoveryCodes: response.GetRecoveryCodes()})
+}
+
+func (svc *WebService) VerifySecondFactor(ctx *gin.Context) {
+	countExporter.WithLabelValues("verify_second_factor", "total").Inc()
+
+	var request model.VerifySecondFactorRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		countExporter.WithLabelValues("verify_second_factor", "bad_request").Inc()
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.VerifySecondFactor(ctx, &user_and_post.VerifySecondFactorRequest{
+		ChallengeId: request.ChallengeId,
+		Code:        request.Code,
+		ClientIp:    ctx.ClientIP(),
+	})
+	if err != nil {
+		countExporter.WithLabelValues("verify_second_factor", "call_api_failed").Inc()
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.VerifySecondFactorResponse_CHALLENGE_NOT_FOUND {
+		countExporter.WithLabelValues("verify_second_factor", "challenge_not_found").Inc()
+		ctx.JSON(http.StatusUnauthorized, model.MessageResponse{Message: "challenge expired, login again"})
+		return
+	} else if response.Status == user_and_post.VerifySecondFactorResponse_INVALID_CODE {
+		countExporter.WithLabelValues("verify_second_factor", "invalid_code").Inc()
+		ctx.JSON(http.StatusUnauthorized, model.MessageResponse{Message: "invalid code"})
+		return
+	} else if response.Status == user_and_post.VerifySecondFactorResponse_ACCOUNT_LOCKED {
+		countExporter.WithLabelValues("verify_second_factor", "account_locked").Inc()
+		ctx.Header("Retry-After", strconv.FormatInt(response.GetRetryAfterSeconds(), 10))
+		ctx.JSON(http.StatusTooManyRequests, model.MessageResponse{Message: "account locked"})
+		return
+	}
+
+	svc.startLogin(ctx, "verify_second_factor", response.GetUserId(), request.IssueTokens)
+}
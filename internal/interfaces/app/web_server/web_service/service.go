@@ -1,32 +1,64 @@
 package web_service
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
 	"github.com/khailequang334/social_network/configs"
+	"github.com/khailequang334/social_network/internal/authz"
 	"github.com/khailequang334/social_network/internal/clients/newsfeed_client"
 	"github.com/khailequang334/social_network/internal/clients/user_and_post_client"
+	"github.com/khailequang334/social_network/internal/identity"
 	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/newsfeed"
 	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
 	"github.com/khailequang334/social_network/internal/logger"
+	"github.com/khailequang334/social_network/internal/session"
+	"github.com/khailequang334/social_network/internal/sso"
+	"github.com/khailequang334/social_network/internal/token"
 	"go.uber.org/zap"
 )
 
 type WebService struct {
 	UserAndPostClient user_and_post.UserAndPostClient
 	NewsfeedClient    newsfeed.NewsfeedClient
+	Sessions          *session.Store
+	Tokens            *token.Manager // nil when jwt mode is disabled
+	SSO               *sso.Registry
+	Permissions       authz.Checker
+	SessionConfig     configs.SessionConfig
 	Logger            *zap.Logger
 }
 
 func NewWebService(conf *configs.WebConfig) (*WebService, error) {
-	userAndPostClnt, err := user_and_post_client.NewClient(conf.UserAndPost.Hosts)
+	userAndPostClnt, err := user_and_post_client.NewClient(conf.UserAndPost.Hosts, conf.ServiceSecret)
 	if err != nil {
 		return nil, err
 	}
 
-	newsfeedClnt, err := newsfeed_client.NewClient(conf.Newsfeed.Hosts)
+	newsfeedClnt, err := newsfeed_client.NewClient(conf.Newsfeed.Hosts, conf.ServiceSecret)
 	if err != nil {
 		return nil, err
 	}
 
+	rd := redis.NewClient(&conf.Redis)
+	if rd == nil {
+		return nil, fmt.Errorf("can not init redis client")
+	}
+
+	sessions, err := session.NewStore(rd, conf.Session.Secret, conf.Session.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens *token.Manager
+	if conf.JWT.Enabled {
+		tokens, err = token.NewManager(rd, conf.JWT)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	zapLogger, err := logger.NewLogger(nil)
 	if err != nil {
 		return nil, err
@@ -35,6 +67,25 @@ func NewWebService(conf *configs.WebConfig) (*WebService, error) {
 	return &WebService{
 		UserAndPostClient: userAndPostClnt,
 		NewsfeedClient:    newsfeedClnt,
+		Sessions:          sessions,
+		Tokens:            tokens,
+		SSO:               sso.NewRegistry(rd, conf.OIDC),
+		Permissions:       newPermissionChecker(userAndPostClnt),
+		SessionConfig:     conf.Session,
 		Logger:            zapLogger,
 	}, nil
 }
+
+// newPermissionChecker asks the user_and_post service, which owns the role tables
+func newPermissionChecker(client user_and_post.UserAndPostClient) authz.Checker {
+	return authz.CheckerFunc(func(ctx context.Context, userId int64, permission string) (bool, error) {
+		response, err := client.CheckPermission(identity.WithCallerId(ctx, userId), &user_and_post.CheckPermissionRequest{
+			UserId:     userId,
+			Permission: permission,
+		})
+		if err != nil {
+			return false, err
+		}
+		return response.GetAllowed(), nil
+	})
+}
@@ -0,0 +1,111 @@
+package web_service
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/khailequang334/social_network/internal/interfaces/proto/protobuf/user_and_post"
+	"github.com/khailequang334/social_network/internal/model"
+)
+
+func (svc *WebService) RequestEmailVerification(ctx *gin.Context) {
+	response, err := svc.UserAndPostClient.RequestEmailVerification(ctx, &user_and_post.RequestEmailVerificationRequest{
+		UserId: getCurrentUserId(ctx),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.RequestEmailVerificationResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "user not found"})
+		return
+	} else if response.Status == user_and_post.RequestEmailVerificationResponse_ALREADY_VERIFIED {
+		ctx.JSON(http.StatusConflict, model.MessageResponse{Message: "email already verified"})
+		return
+	} else if response.Status == user_and_post.RequestEmailVerificationResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, model.MessageResponse{Message: "forbidden"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.MessageResponse{Message: "verification email sent"})
+}
+
+func (svc *WebService) VerifyEmail(ctx *gin.Context) {
+	var request model.VerifyEmailRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.VerifyEmail(ctx, &user_and_post.VerifyEmailRequest{
+		Token: request.Token,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.VerifyEmailResponse_INVALID_TOKEN {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid or expired token"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, model.MessageResponse{Message: "email verified successfully"})
+}
+
+func (svc *WebService) ForgotPassword(ctx *gin.Context) {
+	var request model.ForgotPasswordRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	_, err := svc.UserAndPostClient.RequestPasswordReset(ctx, &user_and_post.RequestPasswordResetRequest{
+		Email: request.Email,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// same answer whether the email exists or not
+	ctx.JSON(http.StatusOK, model.MessageResponse{Message: "if the email is registered, a reset link has been sent"})
+}
+
+func (svc *WebService) ResetPassword(ctx *gin.Context) {
+	var request model.ResetPasswordRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.ResetPassword(ctx, &user_and_post.ResetPasswordRequest{
+		Token:       request.Token,
+		NewPassword: request.Password,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.Status == user_and_post.ResetPasswordResponse_INVALID_TOKEN {
+		ctx.JSON(http.StatusBadRequest, model.MessageResponse{Message: "invalid or expired token"})
+		return
+	} else if response.Status == user_and_post.ResetPasswordResponse_INVALID_FIELD {
+		renderFieldErrors(ctx, response.GetFieldErrors())
+		return
+	}
+
+	// whoever knew the old password must not stay logged in
+	userId := response.GetUserId()
+	if err := svc.Sessions.DeleteAll(ctx, userId); err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if svc.Tokens != nil {
+		if err := svc.Tokens.RevokeAll(ctx, userId); err != nil {
+			ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, model.MessageResponse{Message: "password reset successfully"})
+}
@@ -1,7 +1,6 @@
 package web_service
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -23,7 +22,7 @@ func (svc *WebService) CreateUser(ctx *gin.Context) {
 
 	dob, err := time.Parse("2006-01-02", request.Dob)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, &model.MessageResponse{Message: err.Error()})
+		renderFieldErrors(ctx, []*user_and_post.FieldError{{Field: "dob", Message: "must be a date formatted as YYYY-MM-DD"}})
 		return
 	}
 
@@ -39,26 +38,27 @@ func (svc *WebService) CreateUser(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, &model.MessageResponse{Message: err.Error()})
 		return
 	}
+	if response.GetStatus() != user_and_post.UserResult_OK {
+		renderFieldErrors(ctx, response.GetFieldErrors())
+		return
+	}
 	ctx.JSON(http.StatusOK, &model.MessageResponse{Message: fmt.Sprintf("Successfully created user with id: %d", response.Info.UserId)})
 }
 
-func (svc *WebService) EditUser(ctx *gin.Context) {
-	// get current user from session id
-	sessionId, err := ctx.Cookie("session_id")
-	if errors.Is(err, http.ErrNoCookie) {
-		svc.Logger.Error("unauthorized")
-		ctx.JSON(http.StatusUnauthorized, model.MessageResponse{Message: "unauthorized"})
-		return
-	} else if err != nil {
-		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
-		return
+// renderFieldErrors answers 422 with one message per rejected field
+func renderFieldErrors(ctx *gin.Context, fieldErrors []*user_and_post.FieldError) {
+	errs := make(map[string]string, len(fieldErrors))
+	for _, fieldError := range fieldErrors {
+		errs[fieldError.GetField()] = fieldError.GetMessage()
 	}
+	ctx.JSON(http.StatusUnprocessableEntity, &model.ValidationErrorResponse{
+		Message: "validation failed",
+		Errors:  errs,
+	})
+}
 
-	currentUserId, err := strconv.ParseInt(sessionId, 10, 64)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: "unexpected error"})
-		return
-	}
+func (svc *WebService) EditUser(ctx *gin.Context) {
+	currentUserId := getCurrentUserId(ctx)
 
 	var request model.EditUserRequest
 	if err := ctx.ShouldBindJSON(&request); err != nil {
@@ -78,7 +78,7 @@ func (svc *WebService) EditUser(ctx *gin.Context) {
 	if request.Dob != nil {
 		parsedDob, err := time.Parse("2006-01-02", *request.Dob)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, &model.MessageResponse{Message: err.Error()})
+			renderFieldErrors(ctx, []*user_and_post.FieldError{{Field: "dob", Message: "must be a date formatted as YYYY-MM-DD"}})
 			return
 		}
 		editUserRequest.Dob = timestamppb.New(parsedDob)
@@ -86,6 +86,23 @@ func (svc *WebService) EditUser(ctx *gin.Context) {
 	if request.Password != nil {
 		editUserRequest.UserPassword = request.Password
 	}
+	editUserRequest.Bio = request.Bio
+	editUserRequest.AvatarPath = request.AvatarPath
+	editUserRequest.Location = request.Location
+	editUserRequest.Website = request.Website
+	editUserRequest.Pronouns = request.Pronouns
+	editUserRequest.Private = request.Private
+
+	var fieldErrors []*user_and_post.FieldError
+	editUserRequest.BioVisibility, fieldErrors = parseVisibility("bio_visibility", request.BioVisibility, fieldErrors)
+	editUserRequest.AvatarVisibility, fieldErrors = parseVisibility("avatar_visibility", request.AvatarVisibility, fieldErrors)
+	editUserRequest.LocationVisibility, fieldErrors = parseVisibility("location_visibility", request.LocationVisibility, fieldErrors)
+	editUserRequest.WebsiteVisibility, fieldErrors = parseVisibility("website_visibility", request.WebsiteVisibility, fieldErrors)
+	editUserRequest.PronounsVisibility, fieldErrors = parseVisibility("pronouns_visibility", request.PronounsVisibility, fieldErrors)
+	if len(fieldErrors) > 0 {
+		renderFieldErrors(ctx, fieldErrors)
+		return
+	}
 
 	// request to user_and_post svc
 	response, err := svc.UserAndPostClient.EditUser(ctx, editUserRequest)
@@ -93,10 +110,84 @@ func (svc *WebService) EditUser(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, &model.MessageResponse{Message: err.Error()})
 		return
 	}
+	if response.GetStatus() == user_and_post.EditUserResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, &model.MessageResponse{Message: "user not found"})
+		return
+	} else if response.GetStatus() == user_and_post.EditUserResponse_FORBIDDEN {
+		ctx.JSON(http.StatusForbidden, &model.MessageResponse{Message: "forbidden"})
+		return
+	} else if response.GetStatus() == user_and_post.EditUserResponse_INVALID_FIELD {
+		renderFieldErrors(ctx, response.GetFieldErrors())
+		return
+	}
 
 	ctx.JSON(http.StatusOK, &model.MessageResponse{Message: "Successfully edited user with id: " + fmt.Sprintf("%d", response.UserId)})
 }
 
+func (svc *WebService) ChangeUserName(ctx *gin.Context) {
+	var request model.ChangeUserNameRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, &model.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	response, err := svc.UserAndPostClient.ChangeUserName(ctx, &user_and_post.ChangeUserNameRequest{
+		UserId:   getCurrentUserId(ctx),
+		UserName: request.UserName,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, &model.MessageResponse{Message: err.Error()})
+		return
+	}
+	switch response.GetStatus() {
+	case user_and_post.ChangeUserNameResponse_USER_NOT_FOUND:
+		ctx.JSON(http.StatusBadRequest, &model.MessageResponse{Message: "user not found"})
+		return
+	case user_and_post.ChangeUserNameResponse_INVALID_FIELD, user_and_post.ChangeUserNameResponse_USERNAME_TAKEN:
+		renderFieldErrors(ctx, response.GetFieldErrors())
+		return
+	case user_and_post.ChangeUserNameResponse_TOO_SOON:
+		ctx.Header("Retry-After", strconv.FormatInt(response.GetRetryAfterSeconds(), 10))
+		ctx.JSON(http.StatusTooManyRequests, &model.MessageResponse{Message: "user name was changed recently, retry later"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &model.MessageResponse{Message: "user name changed to " + request.UserName})
+}
+
+// DeleteAccount logs the user out everywhere, logging in again before the
+// returned purge time restores the account
+func (svc *WebService) DeleteAccount(ctx *gin.Context) {
+	currentUserId := getCurrentUserId(ctx)
+
+	response, err := svc.UserAndPostClient.DeleteAccount(ctx, &user_and_post.DeleteAccountRequest{UserId: currentUserId})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, &model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if response.GetStatus() == user_and_post.DeleteAccountResponse_USER_NOT_FOUND {
+		ctx.JSON(http.StatusBadRequest, &model.MessageResponse{Message: "user not found"})
+		return
+	}
+
+	if err := svc.Sessions.DeleteAll(ctx, currentUserId); err != nil {
+		ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+		return
+	}
+	if svc.Tokens != nil {
+		if err := svc.Tokens.RevokeAll(ctx, currentUserId); err != nil {
+			ctx.JSON(http.StatusInternalServerError, model.MessageResponse{Message: err.Error()})
+			return
+		}
+	}
+	svc.clearSessionCookie(ctx)
+
+	ctx.JSON(http.StatusOK, model.DeleteAccountResponse{
+		Message:   "account deleted, log in before the purge time to restore it",
+		PurgeTime: response.GetPurgeTime().AsTime(),
+	})
+}
+
 func (svc *WebService) AuthentcateUser(ctx *gin.Context) {
 	start := time.Now()
 	countExporter.WithLabelValues("authenticate_user", "total").Inc()
@@ -115,6 +206,7 @@ func (svc *WebService) AuthentcateUser(ctx *gin.Context) {
 	response, err := svc.UserAndPostClient.AuthenticateUser(ctx, &user_and_post.AuthenticateUserRequest{
 		UserName:     request.UserName,
 		UserPassword: request.Password,
+		ClientIp:     ctx.ClientIP(),
 	})
 	if err != nil {
 		countExporter.WithLabelValues("authenticate_user", "call_api_failed").Inc()
@@ -123,11 +215,24 @@ func (svc *WebService) AuthentcateUser(ctx *gin.Context) {
 		return
 	}
 	if response.GetStatus() == user_and_post.AuthenticateUserResponse_OK {
-		countExporter.WithLabelValues("authenticate_user", "success").Inc()
-		ctx.JSON(http.StatusOK, &model.MessageResponse{Message: "ok"})
-
-		// use user id as session id
-		ctx.SetCookie("session_id", fmt.Sprintf("%d", response.UserId), 0, "", "", false, false)
+		svc.startLogin(ctx, "authenticate_user", response.GetUserId(), request.IssueTokens)
+	} else if response.GetStatus() == user_and_post.AuthenticateUserResponse_SECOND_FACTOR_REQUIRED {
+		countExporter.WithLabelValues("authenticate_user", "second_factor_required").Inc()
+		ctx.JSON(http.StatusOK, &model.SecondFactorChallengeResponse{
+			Message:     "second factor required",
+			ChallengeId: response.GetChallengeId(),
+		})
+	} else if response.GetStatus() == user_and_post.AuthenticateUserResponse_ACCOUNT_LOCKED {
+		countExporter.WithLabelValues("authenticate_user", "account_locked").Inc()
+		ctx.Header("Retry-After", strconv.FormatInt(response.GetRetryAfterSeconds(), 10))
+		ctx.JSON(http.StatusTooManyRequests, &model.MessageResponse{Message: "account locked"})
+	} else if response.GetStatus() == user_and_post.AuthenticateUserResponse_RETRY_LATER {
+		countExporter.WithLabelValues("authenticate_user", "retry_later").Inc()
+		ctx.Header("Retry-After", strconv.FormatInt(response.GetRetryAfterSeconds(), 10))
+		ctx.JSON(http.StatusTooManyRequests, &model.MessageResponse{Message: "too many attempts, retry later"})
+	} else if response.GetStatus() == user_and_post.AuthenticateUserResponse_ACCOUNT_SUSPENDED {
+		countExporter.WithLabelValues("authenticate_user", "account_suspended").Inc()
+		ctx.JSON(http.StatusForbidden, &model.MessageResponse{Message: "account suspended"})
 	} else if response.GetStatus() == user_and_post.AuthenticateUserResponse_USER_NOT_FOUND {
 		countExporter.WithLabelValues("authenticate_user", "not_found").Inc()
 		ctx.JSON(http.StatusOK, &model.MessageResponse{Message: "not found"})
@@ -141,3 +246,34 @@ func (svc *WebService) AuthentcateUser(ctx *gin.Context) {
 		latencyExporter.WithLabelValues("authenticate_user", strconv.Itoa(http.StatusOK)).Observe(float64(start.UnixMilli()))
 	}()
 }
+
+// startLogin hands out a session cookie, or a token pair when asked for, to an
+// authenticated user
+func (svc *WebService) startLogin(ctx *gin.Context, component string, userId int64, issueTokens bool) {
+	if issueTokens {
+		if svc.Tokens == nil {
+			countExporter.WithLabelValues(component, "bad_request").Inc()
+			ctx.JSON(http.StatusBadRequest, &model.MessageResponse{Message: "token authentication is disabled"})
+			return
+		}
+		pair, err := svc.Tokens.Issue(ctx, userId)
+		if err != nil {
+			countExporter.WithLabelValues(component, "issue_token_failed").Inc()
+			ctx.JSON(http.StatusInternalServerError, &model.MessageResponse{Message: err.Error()})
+			return
+		}
+		countExporter.WithLabelValues(component, "success").Inc()
+		ctx.JSON(http.StatusOK, tokenResponse(pair))
+		return
+	}
+
+	sessionToken, err := svc.Sessions.Create(ctx, userId)
+	if err != nil {
+		countExporter.WithLabelValues(component, "create_session_failed").Inc()
+		ctx.JSON(http.StatusInternalServerError, &model.MessageResponse{Message: err.Error()})
+		return
+	}
+	countExporter.WithLabelValues(component, "success").Inc()
+	svc.setSessionCookie(ctx, sessionToken)
+	ctx.JSON(http.StatusOK, &model.MessageResponse{Message: "ok"})
+}
@@ -21,2526 +21,14766 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type UserResult_UserStatus int32
+// Profile handler
+type Visibility int32
 
 const (
-	UserResult_OK             UserResult_UserStatus = 0
-	UserResult_USER_NOT_FOUND UserResult_UserStatus = 1
+	Visibility_PUBLIC    Visibility = 0
+	Visibility_FOLLOWERS Visibility = 1
+	Visibility_PRIVATE   Visibility = 2
 )
 
-// Enum value maps for UserResult_UserStatus.
+// Enum value maps for Visibility.
 var (
-	UserResult_UserStatus_name = map[int32]string{
-		0: "OK",
-		1: "USER_NOT_FOUND",
+	Visibility_name = map[int32]string{
+		0: "PUBLIC",
+		1: "FOLLOWERS",
+		2: "PRIVATE",
 	}
-	UserResult_UserStatus_value = map[string]int32{
-		"OK":             0,
-		"USER_NOT_FOUND": 1,
+	Visibility_value = map[string]int32{
+		"PUBLIC":    0,
+		"FOLLOWERS": 1,
+		"PRIVATE":   2,
 	}
 )
 
-func (x UserResult_UserStatus) Enum() *UserResult_UserStatus {
-	p := new(UserResult_UserStatus)
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
 	*p = x
 	return p
 }
 
-func (x UserResult_UserStatus) String() string {
+func (x Visibility) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (UserResult_UserStatus) Descriptor() protoreflect.EnumDescriptor {
+func (Visibility) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[0].Descriptor()
 }
 
-func (UserResult_UserStatus) Type() protoreflect.EnumType {
+func (Visibility) Type() protoreflect.EnumType {
 	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[0]
 }
 
-func (x UserResult_UserStatus) Number() protoreflect.EnumNumber {
+func (x Visibility) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use UserResult_UserStatus.Descriptor instead.
-func (UserResult_UserStatus) EnumDescriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use Visibility.Descriptor instead.
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{0}
 }
 
-type AuthenticateUserResponse_AuthenticateUserStatus int32
+type UserResult_UserStatus int32
 
 const (
-	AuthenticateUserResponse_OK             AuthenticateUserResponse_AuthenticateUserStatus = 0
-	AuthenticateUserResponse_USER_NOT_FOUND AuthenticateUserResponse_AuthenticateUserStatus = 1
-	AuthenticateUserResponse_WRONG_PASSWORD AuthenticateUserResponse_AuthenticateUserStatus = 2
+	UserResult_OK             UserResult_UserStatus = 0
+	UserResult_USER_NOT_FOUND UserResult_UserStatus = 1
+	UserResult_USERNAME_TAKEN UserResult_UserStatus = 2
+	UserResult_EMAIL_TAKEN    UserResult_UserStatus = 3
+	UserResult_INVALID_FIELD  UserResult_UserStatus = 4
 )
 
-// Enum value maps for AuthenticateUserResponse_AuthenticateUserStatus.
+// Enum value maps for UserResult_UserStatus.
 var (
-	AuthenticateUserResponse_AuthenticateUserStatus_name = map[int32]string{
+	UserResult_UserStatus_name = map[int32]string{
 		0: "OK",
 		1: "USER_NOT_FOUND",
-		2: "WRONG_PASSWORD",
+		2: "USERNAME_TAKEN",
+		3: "EMAIL_TAKEN",
+		4: "INVALID_FIELD",
 	}
-	AuthenticateUserResponse_AuthenticateUserStatus_value = map[string]int32{
+	UserResult_UserStatus_value = map[string]int32{
 		"OK":             0,
 		"USER_NOT_FOUND": 1,
-		"WRONG_PASSWORD": 2,
+		"USERNAME_TAKEN": 2,
+		"EMAIL_TAKEN":    3,
+		"INVALID_FIELD":  4,
 	}
 )
 
-func (x AuthenticateUserResponse_AuthenticateUserStatus) Enum() *AuthenticateUserResponse_AuthenticateUserStatus {
-	p := new(AuthenticateUserResponse_AuthenticateUserStatus)
+func (x UserResult_UserStatus) Enum() *UserResult_UserStatus {
+	p := new(UserResult_UserStatus)
 	*p = x
 	return p
 }
 
-func (x AuthenticateUserResponse_AuthenticateUserStatus) String() string {
+func (x UserResult_UserStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (AuthenticateUserResponse_AuthenticateUserStatus) Descriptor() protoreflect.EnumDescriptor {
+func (UserResult_UserStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[1].Descriptor()
 }
 
-func (AuthenticateUserResponse_AuthenticateUserStatus) Type() protoreflect.EnumType {
+func (UserResult_UserStatus) Type() protoreflect.EnumType {
 	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[1]
 }
 
-func (x AuthenticateUserResponse_AuthenticateUserStatus) Number() protoreflect.EnumNumber {
+func (x UserResult_UserStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use AuthenticateUserResponse_AuthenticateUserStatus.Descriptor instead.
-func (AuthenticateUserResponse_AuthenticateUserStatus) EnumDescriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use UserResult_UserStatus.Descriptor instead.
+func (UserResult_UserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{2, 0}
 }
 
-type FollowUserResponse_FollowStatus int32
+type EditUserResponse_EditUserStatus int32
 
 const (
-	FollowUserResponse_OK               FollowUserResponse_FollowStatus = 0
-	FollowUserResponse_USER_NOT_FOUND   FollowUserResponse_FollowStatus = 1
-	FollowUserResponse_ALREADY_FOLLOWED FollowUserResponse_FollowStatus = 2
+	EditUserResponse_OK             EditUserResponse_EditUserStatus = 0
+	EditUserResponse_USER_NOT_FOUND EditUserResponse_EditUserStatus = 1
+	EditUserResponse_INVALID_FIELD  EditUserResponse_EditUserStatus = 2
+	EditUserResponse_FORBIDDEN      EditUserResponse_EditUserStatus = 3
 )
 
-// Enum value maps for FollowUserResponse_FollowStatus.
+// Enum value maps for EditUserResponse_EditUserStatus.
 var (
-	FollowUserResponse_FollowStatus_name = map[int32]string{
+	EditUserResponse_EditUserStatus_name = map[int32]string{
 		0: "OK",
 		1: "USER_NOT_FOUND",
-		2: "ALREADY_FOLLOWED",
+		2: "INVALID_FIELD",
+		3: "FORBIDDEN",
 	}
-	FollowUserResponse_FollowStatus_value = map[string]int32{
-		"OK":               0,
-		"USER_NOT_FOUND":   1,
-		"ALREADY_FOLLOWED": 2,
+	EditUserResponse_EditUserStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"INVALID_FIELD":  2,
+		"FORBIDDEN":      3,
 	}
 )
 
-func (x FollowUserResponse_FollowStatus) Enum() *FollowUserResponse_FollowStatus {
-	p := new(FollowUserResponse_FollowStatus)
+func (x EditUserResponse_EditUserStatus) Enum() *EditUserResponse_EditUserStatus {
+	p := new(EditUserResponse_EditUserStatus)
 	*p = x
 	return p
 }
 
-func (x FollowUserResponse_FollowStatus) String() string {
+func (x EditUserResponse_EditUserStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (FollowUserResponse_FollowStatus) Descriptor() protoreflect.EnumDescriptor {
+func (EditUserResponse_EditUserStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[2].Descriptor()
 }
 
-func (FollowUserResponse_FollowStatus) Type() protoreflect.EnumType {
+func (EditUserResponse_EditUserStatus) Type() protoreflect.EnumType {
 	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[2]
 }
 
-func (x FollowUserResponse_FollowStatus) Number() protoreflect.EnumNumber {
+func (x EditUserResponse_EditUserStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use FollowUserResponse_FollowStatus.Descriptor instead.
-func (FollowUserResponse_FollowStatus) EnumDescriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use EditUserResponse_EditUserStatus.Descriptor instead.
+func (EditUserResponse_EditUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{4, 0}
 }
 
-type UnfollowUserResponse_UnfollowStatus int32
+type ChangeUserNameResponse_ChangeUserNameStatus int32
 
 const (
-	UnfollowUserResponse_OK             UnfollowUserResponse_UnfollowStatus = 0
-	UnfollowUserResponse_USER_NOT_FOUND UnfollowUserResponse_UnfollowStatus = 1
-	UnfollowUserResponse_NOT_FOLLOWED   UnfollowUserResponse_UnfollowStatus = 2
+	ChangeUserNameResponse_OK             ChangeUserNameResponse_ChangeUserNameStatus = 0
+	ChangeUserNameResponse_USER_NOT_FOUND ChangeUserNameResponse_ChangeUserNameStatus = 1
+	ChangeUserNameResponse_FORBIDDEN      ChangeUserNameResponse_ChangeUserNameStatus = 2
+	ChangeUserNameResponse_INVALID_FIELD  ChangeUserNameResponse_ChangeUserNameStatus = 3
+	ChangeUserNameResponse_USERNAME_TAKEN ChangeUserNameResponse_ChangeUserNameStatus = 4
+	ChangeUserNameResponse_TOO_SOON       ChangeUserNameResponse_ChangeUserNameStatus = 5
 )
 
-// Enum value maps for UnfollowUserResponse_UnfollowStatus.
+// Enum value maps for ChangeUserNameResponse_ChangeUserNameStatus.
 var (
-	UnfollowUserResponse_UnfollowStatus_name = map[int32]string{
+	ChangeUserNameResponse_ChangeUserNameStatus_name = map[int32]string{
 		0: "OK",
 		1: "USER_NOT_FOUND",
-		2: "NOT_FOLLOWED",
+		2: "FORBIDDEN",
+		3: "INVALID_FIELD",
+		4: "USERNAME_TAKEN",
+		5: "TOO_SOON",
 	}
-	UnfollowUserResponse_UnfollowStatus_value = map[string]int32{
+	ChangeUserNameResponse_ChangeUserNameStatus_value = map[string]int32{
 		"OK":             0,
 		"USER_NOT_FOUND": 1,
-		"NOT_FOLLOWED":   2,
+		"FORBIDDEN":      2,
+		"INVALID_FIELD":  3,
+		"USERNAME_TAKEN": 4,
+		"TOO_SOON":       5,
 	}
 )
 
-func (x UnfollowUserResponse_UnfollowStatus) Enum() *UnfollowUserResponse_UnfollowStatus {
-	p := new(UnfollowUserResponse_UnfollowStatus)
+func (x ChangeUserNameResponse_ChangeUserNameStatus) Enum() *ChangeUserNameResponse_ChangeUserNameStatus {
+	p := new(ChangeUserNameResponse_ChangeUserNameStatus)
 	*p = x
 	return p
 }
 
-func (x UnfollowUserResponse_UnfollowStatus) String() string {
+func (x ChangeUserNameResponse_ChangeUserNameStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (UnfollowUserResponse_UnfollowStatus) Descriptor() protoreflect.EnumDescriptor {
+func (ChangeUserNameResponse_ChangeUserNameStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[3].Descriptor()
 }
 
-func (UnfollowUserResponse_UnfollowStatus) Type() protoreflect.EnumType {
+func (ChangeUserNameResponse_ChangeUserNameStatus) Type() protoreflect.EnumType {
 	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[3]
 }
 
-func (x UnfollowUserResponse_UnfollowStatus) Number() protoreflect.EnumNumber {
+func (x ChangeUserNameResponse_ChangeUserNameStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use UnfollowUserResponse_UnfollowStatus.Descriptor instead.
-func (UnfollowUserResponse_UnfollowStatus) EnumDescriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use ChangeUserNameResponse_ChangeUserNameStatus.Descriptor instead.
+func (ChangeUserNameResponse_ChangeUserNameStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{6, 0}
 }
 
-type GetFollowerListResponse_GetFollowerListStatus int32
+type AuthenticateUserResponse_AuthenticateUserStatus int32
 
 const (
-	GetFollowerListResponse_OK             GetFollowerListResponse_GetFollowerListStatus = 0
-	GetFollowerListResponse_USER_NOT_FOUND GetFollowerListResponse_GetFollowerListStatus = 1
+	AuthenticateUserResponse_OK                     AuthenticateUserResponse_AuthenticateUserStatus = 0
+	AuthenticateUserResponse_USER_NOT_FOUND         AuthenticateUserResponse_AuthenticateUserStatus = 1
+	AuthenticateUserResponse_WRONG_PASSWORD         AuthenticateUserResponse_AuthenticateUserStatus = 2
+	AuthenticateUserResponse_ACCOUNT_LOCKED         AuthenticateUserResponse_AuthenticateUserStatus = 3
+	AuthenticateUserResponse_RETRY_LATER            AuthenticateUserResponse_AuthenticateUserStatus = 4
+	AuthenticateUserResponse_SECOND_FACTOR_REQUIRED AuthenticateUserResponse_AuthenticateUserStatus = 5
+	AuthenticateUserResponse_ACCOUNT_SUSPENDED      AuthenticateUserResponse_AuthenticateUserStatus = 6
 )
 
-// Enum value maps for GetFollowerListResponse_GetFollowerListStatus.
+// Enum value maps for AuthenticateUserResponse_AuthenticateUserStatus.
 var (
-	GetFollowerListResponse_GetFollowerListStatus_name = map[int32]string{
+	AuthenticateUserResponse_AuthenticateUserStatus_name = map[int32]string{
 		0: "OK",
 		1: "USER_NOT_FOUND",
+		2: "WRONG_PASSWORD",
+		3: "ACCOUNT_LOCKED",
+		4: "RETRY_LATER",
+		5: "SECOND_FACTOR_REQUIRED",
+		6: "ACCOUNT_SUSPENDED",
 	}
-	GetFollowerListResponse_GetFollowerListStatus_value = map[string]int32{
-		"OK":             0,
-		"USER_NOT_FOUND": 1,
+	AuthenticateUserResponse_AuthenticateUserStatus_value = map[string]int32{
+		"OK":                     0,
+		"USER_NOT_FOUND":         1,
+		"WRONG_PASSWORD":         2,
+		"ACCOUNT_LOCKED":         3,
+		"RETRY_LATER":            4,
+		"SECOND_FACTOR_REQUIRED": 5,
+		"ACCOUNT_SUSPENDED":      6,
 	}
 )
 
-func (x GetFollowerListResponse_GetFollowerListStatus) Enum() *GetFollowerListResponse_GetFollowerListStatus {
-	p := new(GetFollowerListResponse_GetFollowerListStatus)
+func (x AuthenticateUserResponse_AuthenticateUserStatus) Enum() *AuthenticateUserResponse_AuthenticateUserStatus {
+	p := new(AuthenticateUserResponse_AuthenticateUserStatus)
 	*p = x
 	return p
 }
 
-func (x GetFollowerListResponse_GetFollowerListStatus) String() string {
+func (x AuthenticateUserResponse_AuthenticateUserStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (GetFollowerListResponse_GetFollowerListStatus) Descriptor() protoreflect.EnumDescriptor {
+func (AuthenticateUserResponse_AuthenticateUserStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[4].Descriptor()
 }
 
-func (GetFollowerListResponse_GetFollowerListStatus) Type() protoreflect.EnumType {
+func (AuthenticateUserResponse_AuthenticateUserStatus) Type() protoreflect.EnumType {
 	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[4]
 }
 
-func (x GetFollowerListResponse_GetFollowerListStatus) Number() protoreflect.EnumNumber {
+func (x AuthenticateUserResponse_AuthenticateUserStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use GetFollowerListResponse_GetFollowerListStatus.Descriptor instead.
-func (GetFollowerListResponse_GetFollowerListStatus) EnumDescriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use AuthenticateUserResponse_AuthenticateUserStatus.Descriptor instead.
+func (AuthenticateUserResponse_AuthenticateUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{8, 0}
 }
 
-type CreatePostResponse_CreatePostStatus int32
+type EnrollSecondFactorResponse_EnrollSecondFactorStatus int32
 
 const (
-	CreatePostResponse_OK             CreatePostResponse_CreatePostStatus = 0
-	CreatePostResponse_USER_NOT_FOUND CreatePostResponse_CreatePostStatus = 1
+	EnrollSecondFactorResponse_OK              EnrollSecondFactorResponse_EnrollSecondFactorStatus = 0
+	EnrollSecondFactorResponse_USER_NOT_FOUND  EnrollSecondFactorResponse_EnrollSecondFactorStatus = 1
+	EnrollSecondFactorResponse_ALREADY_ENABLED EnrollSecondFactorResponse_EnrollSecondFactorStatus = 2
+	EnrollSecondFactorResponse_FORBIDDEN       EnrollSecondFactorResponse_EnrollSecondFactorStatus = 3
 )
 
-// Enum value maps for CreatePostResponse_CreatePostStatus.
+// Enum value maps for EnrollSecondFactorResponse_EnrollSecondFactorStatus.
 var (
-	CreatePostResponse_CreatePostStatus_name = map[int32]string{
+	EnrollSecondFactorResponse_EnrollSecondFactorStatus_name = map[int32]string{
 		0: "OK",
 		1: "USER_NOT_FOUND",
+		2: "ALREADY_ENABLED",
+		3: "FORBIDDEN",
 	}
-	CreatePostResponse_CreatePostStatus_value = map[string]int32{
-		"OK":             0,
-		"USER_NOT_FOUND": 1,
+	EnrollSecondFactorResponse_EnrollSecondFactorStatus_value = map[string]int32{
+		"OK":              0,
+		"USER_NOT_FOUND":  1,
+		"ALREADY_ENABLED": 2,
+		"FORBIDDEN":       3,
 	}
 )
 
-func (x CreatePostResponse_CreatePostStatus) Enum() *CreatePostResponse_CreatePostStatus {
-	p := new(CreatePostResponse_CreatePostStatus)
+func (x EnrollSecondFactorResponse_EnrollSecondFactorStatus) Enum() *EnrollSecondFactorResponse_EnrollSecondFactorStatus {
+	p := new(EnrollSecondFactorResponse_EnrollSecondFactorStatus)
 	*p = x
 	return p
 }
 
-func (x CreatePostResponse_CreatePostStatus) String() string {
+func (x EnrollSecondFactorResponse_EnrollSecondFactorStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (CreatePostResponse_CreatePostStatus) Descriptor() protoreflect.EnumDescriptor {
+func (EnrollSecondFactorResponse_EnrollSecondFactorStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[5].Descriptor()
 }
 
-func (CreatePostResponse_CreatePostStatus) Type() protoreflect.EnumType {
+func (EnrollSecondFactorResponse_EnrollSecondFactorStatus) Type() protoreflect.EnumType {
 	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[5]
 }
 
-func (x CreatePostResponse_CreatePostStatus) Number() protoreflect.EnumNumber {
+func (x EnrollSecondFactorResponse_EnrollSecondFactorStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use CreatePostResponse_CreatePostStatus.Descriptor instead.
-func (CreatePostResponse_CreatePostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{14, 0}
+// Deprecated: Use EnrollSecondFactorResponse_EnrollSecondFactorStatus.Descriptor instead.
+func (EnrollSecondFactorResponse_EnrollSecondFactorStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{10, 0}
 }
 
-type GetPostResponse_GetPostStatus int32
+type ConfirmSecondFactorResponse_ConfirmSecondFactorStatus int32
 
 const (
-	GetPostResponse_OK             GetPostResponse_GetPostStatus = 0
-	GetPostResponse_POST_NOT_FOUND GetPostResponse_GetPostStatus = 1
+	ConfirmSecondFactorResponse_OK              ConfirmSecondFactorResponse_ConfirmSecondFactorStatus = 0
+	ConfirmSecondFactorResponse_NOT_ENROLLED    ConfirmSecondFactorResponse_ConfirmSecondFactorStatus = 1
+	ConfirmSecondFactorResponse_ALREADY_ENABLED ConfirmSecondFactorResponse_ConfirmSecondFactorStatus = 2
+	ConfirmSecondFactorResponse_INVALID_CODE    ConfirmSecondFactorResponse_ConfirmSecondFactorStatus = 3
+	ConfirmSecondFactorResponse_FORBIDDEN       ConfirmSecondFactorResponse_ConfirmSecondFactorStatus = 4
 )
 
-// Enum value maps for GetPostResponse_GetPostStatus.
+// Enum value maps for ConfirmSecondFactorResponse_ConfirmSecondFactorStatus.
 var (
-	GetPostResponse_GetPostStatus_name = map[int32]string{
+	ConfirmSecondFactorResponse_ConfirmSecondFactorStatus_name = map[int32]string{
 		0: "OK",
-		1: "POST_NOT_FOUND",
-	}
-	GetPostResponse_GetPostStatus_value = map[string]int32{
-		"OK":             0,
-		"POST_NOT_FOUND": 1,
+		1: "NOT_ENROLLED",
+		2: "ALREADY_ENABLED",
+		3: "INVALID_CODE",
+		4: "FORBIDDEN",
+	}
+	ConfirmSecondFactorResponse_ConfirmSecondFactorStatus_value = map[string]int32{
+		"OK":              0,
+		"NOT_ENROLLED":    1,
+		"ALREADY_ENABLED": 2,
+		"INVALID_CODE":    3,
+		"FORBIDDEN":       4,
 	}
 )
 
-func (x GetPostResponse_GetPostStatus) Enum() *GetPostResponse_GetPostStatus {
-	p := new(GetPostResponse_GetPostStatus)
+func (x ConfirmSecondFactorResponse_ConfirmSecondFactorStatus) Enum() *ConfirmSecondFactorResponse_ConfirmSecondFactorStatus {
+	p := new(ConfirmSecondFactorResponse_ConfirmSecondFactorStatus)
 	*p = x
 	return p
 }
 
-func (x GetPostResponse_GetPostStatus) String() string {
+func (x ConfirmSecondFactorResponse_ConfirmSecondFactorStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (GetPostResponse_GetPostStatus) Descriptor() protoreflect.EnumDescriptor {
+func (ConfirmSecondFactorResponse_ConfirmSecondFactorStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[6].Descriptor()
 }
 
-func (GetPostResponse_GetPostStatus) Type() protoreflect.EnumType {
+func (ConfirmSecondFactorResponse_ConfirmSecondFactorStatus) Type() protoreflect.EnumType {
 	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[6]
 }
 
-func (x GetPostResponse_GetPostStatus) Number() protoreflect.EnumNumber {
+func (x ConfirmSecondFactorResponse_ConfirmSecondFactorStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use GetPostResponse_GetPostStatus.Descriptor instead.
-func (GetPostResponse_GetPostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{17, 0}
+// Deprecated: Use ConfirmSecondFactorResponse_ConfirmSecondFactorStatus.Descriptor instead.
+func (ConfirmSecondFactorResponse_ConfirmSecondFactorStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{12, 0}
 }
 
-type DeletePostResponse_DeletePostStatus int32
+type GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus int32
 
 const (
-	DeletePostResponse_OK             DeletePostResponse_DeletePostStatus = 0
-	DeletePostResponse_POST_NOT_FOUND DeletePostResponse_DeletePostStatus = 1
+	GenerateRecoveryCodesResponse_OK          GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus = 0
+	GenerateRecoveryCodesResponse_NOT_ENABLED GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus = 1
+	GenerateRecoveryCodesResponse_FORBIDDEN   GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus = 2
 )
 
-// Enum value maps for DeletePostResponse_DeletePostStatus.
+// Enum value maps for GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus.
 var (
-	DeletePostResponse_DeletePostStatus_name = map[int32]string{
+	GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus_name = map[int32]string{
 		0: "OK",
-		1: "POST_NOT_FOUND",
+		1: "NOT_ENABLED",
+		2: "FORBIDDEN",
 	}
-	DeletePostResponse_DeletePostStatus_value = map[string]int32{
-		"OK":             0,
-		"POST_NOT_FOUND": 1,
+	GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus_value = map[string]int32{
+		"OK":          0,
+		"NOT_ENABLED": 1,
+		"FORBIDDEN":   2,
 	}
 )
 
-func (x DeletePostResponse_DeletePostStatus) Enum() *DeletePostResponse_DeletePostStatus {
-	p := new(DeletePostResponse_DeletePostStatus)
+func (x GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus) Enum() *GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus {
+	p := new(GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus)
 	*p = x
 	return p
 }
 
-func (x DeletePostResponse_DeletePostStatus) String() string {
+func (x GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (DeletePostResponse_DeletePostStatus) Descriptor() protoreflect.EnumDescriptor {
+func (GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[7].Descriptor()
 }
 
-func (DeletePostResponse_DeletePostStatus) Type() protoreflect.EnumType {
+func (GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus) Type() protoreflect.EnumType {
 	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[7]
 }
 
-func (x DeletePostResponse_DeletePostStatus) Number() protoreflect.EnumNumber {
+func (x GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use DeletePostResponse_DeletePostStatus.Descriptor instead.
-func (DeletePostResponse_DeletePostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{19, 0}
+// Deprecated: Use GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus.Descriptor instead.
+func (GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{14, 0}
 }
 
-type EditPostResponse_EditPostStatus int32
+type VerifySecondFactorResponse_VerifySecondFactorStatus int32
 
 const (
-	EditPostResponse_OK             EditPostResponse_EditPostStatus = 0
-	EditPostResponse_POST_NOT_FOUND EditPostResponse_EditPostStatus = 1
+	VerifySecondFactorResponse_OK                  VerifySecondFactorResponse_VerifySecondFactorStatus = 0
+	VerifySecondFactorResponse_CHALLENGE_NOT_FOUND VerifySecondFactorResponse_VerifySecondFactorStatus = 1
+	VerifySecondFactorResponse_INVALID_CODE        VerifySecondFactorResponse_VerifySecondFactorStatus = 2
+	VerifySecondFactorResponse_ACCOUNT_LOCKED      VerifySecondFactorResponse_VerifySecondFactorStatus = 3
 )
 
-// Enum value maps for EditPostResponse_EditPostStatus.
+// Enum value maps for VerifySecondFactorResponse_VerifySecondFactorStatus.
 var (
-	EditPostResponse_EditPostStatus_name = map[int32]string{
+	VerifySecondFactorResponse_VerifySecondFactorStatus_name = map[int32]string{
 		0: "OK",
-		1: "POST_NOT_FOUND",
+		1: "CHALLENGE_NOT_FOUND",
+		2: "INVALID_CODE",
+		3: "ACCOUNT_LOCKED",
 	}
-	EditPostResponse_EditPostStatus_value = map[string]int32{
-		"OK":             0,
-		"POST_NOT_FOUND": 1,
+	VerifySecondFactorResponse_VerifySecondFactorStatus_value = map[string]int32{
+		"OK":                  0,
+		"CHALLENGE_NOT_FOUND": 1,
+		"INVALID_CODE":        2,
+		"ACCOUNT_LOCKED":      3,
 	}
 )
 
-func (x EditPostResponse_EditPostStatus) Enum() *EditPostResponse_EditPostStatus {
-	p := new(EditPostResponse_EditPostStatus)
+func (x VerifySecondFactorResponse_VerifySecondFactorStatus) Enum() *VerifySecondFactorResponse_VerifySecondFactorStatus {
+	p := new(VerifySecondFactorResponse_VerifySecondFactorStatus)
 	*p = x
 	return p
 }
 
-func (x EditPostResponse_EditPostStatus) String() string {
+func (x VerifySecondFactorResponse_VerifySecondFactorStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (EditPostResponse_EditPostStatus) Descriptor() protoreflect.EnumDescriptor {
+func (VerifySecondFactorResponse_VerifySecondFactorStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[8].Descriptor()
 }
 
-func (EditPostResponse_EditPostStatus) Type() protoreflect.EnumType {
+func (VerifySecondFactorResponse_VerifySecondFactorStatus) Type() protoreflect.EnumType {
 	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[8]
 }
 
-func (x EditPostResponse_EditPostStatus) Number() protoreflect.EnumNumber {
+func (x VerifySecondFactorResponse_VerifySecondFactorStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use EditPostResponse_EditPostStatus.Descriptor instead.
-func (EditPostResponse_EditPostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{21, 0}
+// Deprecated: Use VerifySecondFactorResponse_VerifySecondFactorStatus.Descriptor instead.
+func (VerifySecondFactorResponse_VerifySecondFactorStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{16, 0}
 }
 
-type CommentPostResponse_CommentPostStatus int32
+type RequestEmailVerificationResponse_RequestEmailVerificationStatus int32
 
 const (
-	CommentPostResponse_OK             CommentPostResponse_CommentPostStatus = 0
-	CommentPostResponse_USER_NOT_FOUND CommentPostResponse_CommentPostStatus = 1
-	CommentPostResponse_POST_NOT_FOUND CommentPostResponse_CommentPostStatus = 2
+	RequestEmailVerificationResponse_OK               RequestEmailVerificationResponse_RequestEmailVerificationStatus = 0
+	RequestEmailVerificationResponse_USER_NOT_FOUND   RequestEmailVerificationResponse_RequestEmailVerificationStatus = 1
+	RequestEmailVerificationResponse_ALREADY_VERIFIED RequestEmailVerificationResponse_RequestEmailVerificationStatus = 2
+	RequestEmailVerificationResponse_FORBIDDEN        RequestEmailVerificationResponse_RequestEmailVerificationStatus = 3
 )
 
-// Enum value maps for CommentPostResponse_CommentPostStatus.
+// Enum value maps for RequestEmailVerificationResponse_RequestEmailVerificationStatus.
 var (
-	CommentPostResponse_CommentPostStatus_name = map[int32]string{
+	RequestEmailVerificationResponse_RequestEmailVerificationStatus_name = map[int32]string{
 		0: "OK",
 		1: "USER_NOT_FOUND",
-		2: "POST_NOT_FOUND",
+		2: "ALREADY_VERIFIED",
+		3: "FORBIDDEN",
 	}
-	CommentPostResponse_CommentPostStatus_value = map[string]int32{
-		"OK":             0,
-		"USER_NOT_FOUND": 1,
-		"POST_NOT_FOUND": 2,
+	RequestEmailVerificationResponse_RequestEmailVerificationStatus_value = map[string]int32{
+		"OK":               0,
+		"USER_NOT_FOUND":   1,
+		"ALREADY_VERIFIED": 2,
+		"FORBIDDEN":        3,
 	}
 )
 
-func (x CommentPostResponse_CommentPostStatus) Enum() *CommentPostResponse_CommentPostStatus {
-	p := new(CommentPostResponse_CommentPostStatus)
+func (x RequestEmailVerificationResponse_RequestEmailVerificationStatus) Enum() *RequestEmailVerificationResponse_RequestEmailVerificationStatus {
+	p := new(RequestEmailVerificationResponse_RequestEmailVerificationStatus)
 	*p = x
 	return p
 }
 
-func (x CommentPostResponse_CommentPostStatus) String() string {
+func (x RequestEmailVerificationResponse_RequestEmailVerificationStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (CommentPostResponse_CommentPostStatus) Descriptor() protoreflect.EnumDescriptor {
+func (RequestEmailVerificationResponse_RequestEmailVerificationStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[9].Descriptor()
 }
 
-func (CommentPostResponse_CommentPostStatus) Type() protoreflect.EnumType {
+func (RequestEmailVerificationResponse_RequestEmailVerificationStatus) Type() protoreflect.EnumType {
 	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[9]
 }
 
-func (x CommentPostResponse_CommentPostStatus) Number() protoreflect.EnumNumber {
+func (x RequestEmailVerificationResponse_RequestEmailVerificationStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use CommentPostResponse_CommentPostStatus.Descriptor instead.
-func (CommentPostResponse_CommentPostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{23, 0}
+// Deprecated: Use RequestEmailVerificationResponse_RequestEmailVerificationStatus.Descriptor instead.
+func (RequestEmailVerificationResponse_RequestEmailVerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{18, 0}
 }
 
-type LikePostResponse_LikePostStatus int32
+type VerifyEmailResponse_VerifyEmailStatus int32
 
 const (
-	LikePostResponse_OK             LikePostResponse_LikePostStatus = 0
-	LikePostResponse_USER_NOT_FOUND LikePostResponse_LikePostStatus = 1
-	LikePostResponse_POST_NOT_FOUND LikePostResponse_LikePostStatus = 2
+	VerifyEmailResponse_OK            VerifyEmailResponse_VerifyEmailStatus = 0
+	VerifyEmailResponse_INVALID_TOKEN VerifyEmailResponse_VerifyEmailStatus = 1
 )
 
-// Enum value maps for LikePostResponse_LikePostStatus.
+// Enum value maps for VerifyEmailResponse_VerifyEmailStatus.
 var (
-	LikePostResponse_LikePostStatus_name = map[int32]string{
+	VerifyEmailResponse_VerifyEmailStatus_name = map[int32]string{
 		0: "OK",
-		1: "USER_NOT_FOUND",
-		2: "POST_NOT_FOUND",
+		1: "INVALID_TOKEN",
 	}
-	LikePostResponse_LikePostStatus_value = map[string]int32{
-		"OK":             0,
-		"USER_NOT_FOUND": 1,
-		"POST_NOT_FOUND": 2,
+	VerifyEmailResponse_VerifyEmailStatus_value = map[string]int32{
+		"OK":            0,
+		"INVALID_TOKEN": 1,
 	}
 )
 
-func (x LikePostResponse_LikePostStatus) Enum() *LikePostResponse_LikePostStatus {
-	p := new(LikePostResponse_LikePostStatus)
+func (x VerifyEmailResponse_VerifyEmailStatus) Enum() *VerifyEmailResponse_VerifyEmailStatus {
+	p := new(VerifyEmailResponse_VerifyEmailStatus)
 	*p = x
 	return p
 }
 
-func (x LikePostResponse_LikePostStatus) String() string {
+func (x VerifyEmailResponse_VerifyEmailStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (LikePostResponse_LikePostStatus) Descriptor() protoreflect.EnumDescriptor {
+func (VerifyEmailResponse_VerifyEmailStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[10].Descriptor()
 }
 
-func (LikePostResponse_LikePostStatus) Type() protoreflect.EnumType {
+func (VerifyEmailResponse_VerifyEmailStatus) Type() protoreflect.EnumType {
 	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[10]
 }
 
-func (x LikePostResponse_LikePostStatus) Number() protoreflect.EnumNumber {
+func (x VerifyEmailResponse_VerifyEmailStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use LikePostResponse_LikePostStatus.Descriptor instead.
-func (LikePostResponse_LikePostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{25, 0}
+// Deprecated: Use VerifyEmailResponse_VerifyEmailStatus.Descriptor instead.
+func (VerifyEmailResponse_VerifyEmailStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{20, 0}
 }
 
-// Users handler
-type UserDetailInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type RequestPasswordResetResponse_RequestPasswordResetStatus int32
 
-	UserId       int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName     string               `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserPassword string               `protobuf:"bytes,3,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
-	FirstName    string               `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName     string               `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Dob          *timestamp.Timestamp `protobuf:"bytes,6,opt,name=dob,proto3" json:"dob,omitempty"`
-	Email        string               `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
-}
+const (
+	RequestPasswordResetResponse_OK RequestPasswordResetResponse_RequestPasswordResetStatus = 0
+)
 
-func (x *UserDetailInfo) Reset() {
-	*x = UserDetailInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for RequestPasswordResetResponse_RequestPasswordResetStatus.
+var (
+	RequestPasswordResetResponse_RequestPasswordResetStatus_name = map[int32]string{
+		0: "OK",
 	}
+	RequestPasswordResetResponse_RequestPasswordResetStatus_value = map[string]int32{
+		"OK": 0,
+	}
+)
+
+func (x RequestPasswordResetResponse_RequestPasswordResetStatus) Enum() *RequestPasswordResetResponse_RequestPasswordResetStatus {
+	p := new(RequestPasswordResetResponse_RequestPasswordResetStatus)
+	*p = x
+	return p
 }
 
-func (x *UserDetailInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x RequestPasswordResetResponse_RequestPasswordResetStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*UserDetailInfo) ProtoMessage() {}
+func (RequestPasswordResetResponse_RequestPasswordResetStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[11].Descriptor()
+}
 
-func (x *UserDetailInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (RequestPasswordResetResponse_RequestPasswordResetStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[11]
 }
 
-// Deprecated: Use UserDetailInfo.ProtoReflect.Descriptor instead.
-func (*UserDetailInfo) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{0}
+func (x RequestPasswordResetResponse_RequestPasswordResetStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UserDetailInfo) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use RequestPasswordResetResponse_RequestPasswordResetStatus.Descriptor instead.
+func (RequestPasswordResetResponse_RequestPasswordResetStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{22, 0}
 }
 
-func (x *UserDetailInfo) GetUserName() string {
-	if x != nil {
-		return x.UserName
+type ResetPasswordResponse_ResetPasswordStatus int32
+
+const (
+	ResetPasswordResponse_OK            ResetPasswordResponse_ResetPasswordStatus = 0
+	ResetPasswordResponse_INVALID_TOKEN ResetPasswordResponse_ResetPasswordStatus = 1
+	ResetPasswordResponse_INVALID_FIELD ResetPasswordResponse_ResetPasswordStatus = 2
+)
+
+// Enum value maps for ResetPasswordResponse_ResetPasswordStatus.
+var (
+	ResetPasswordResponse_ResetPasswordStatus_name = map[int32]string{
+		0: "OK",
+		1: "INVALID_TOKEN",
+		2: "INVALID_FIELD",
 	}
-	return ""
+	ResetPasswordResponse_ResetPasswordStatus_value = map[string]int32{
+		"OK":            0,
+		"INVALID_TOKEN": 1,
+		"INVALID_FIELD": 2,
+	}
+)
+
+func (x ResetPasswordResponse_ResetPasswordStatus) Enum() *ResetPasswordResponse_ResetPasswordStatus {
+	p := new(ResetPasswordResponse_ResetPasswordStatus)
+	*p = x
+	return p
 }
 
-func (x *UserDetailInfo) GetUserPassword() string {
-	if x != nil {
-		return x.UserPassword
-	}
-	return ""
+func (x ResetPasswordResponse_ResetPasswordStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *UserDetailInfo) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+func (ResetPasswordResponse_ResetPasswordStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[12].Descriptor()
 }
 
-func (x *UserDetailInfo) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
+func (ResetPasswordResponse_ResetPasswordStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[12]
 }
 
-func (x *UserDetailInfo) GetDob() *timestamp.Timestamp {
-	if x != nil {
-		return x.Dob
-	}
-	return nil
+func (x ResetPasswordResponse_ResetPasswordStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UserDetailInfo) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use ResetPasswordResponse_ResetPasswordStatus.Descriptor instead.
+func (ResetPasswordResponse_ResetPasswordStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{24, 0}
 }
 
-type UserResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type LoginWithIdentityResponse_LoginWithIdentityStatus int32
 
-	Status UserResult_UserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.UserResult_UserStatus" json:"status,omitempty"`
-	Info   *UserDetailInfo       `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
-}
+const (
+	LoginWithIdentityResponse_OK LoginWithIdentityResponse_LoginWithIdentityStatus = 0
+	// the email belongs to an existing account, the owner has to log in and link the identity
+	LoginWithIdentityResponse_EMAIL_TAKEN            LoginWithIdentityResponse_LoginWithIdentityStatus = 1
+	LoginWithIdentityResponse_INVALID_EMAIL          LoginWithIdentityResponse_LoginWithIdentityStatus = 2
+	LoginWithIdentityResponse_SECOND_FACTOR_REQUIRED LoginWithIdentityResponse_LoginWithIdentityStatus = 3
+	LoginWithIdentityResponse_ACCOUNT_SUSPENDED      LoginWithIdentityResponse_LoginWithIdentityStatus = 4
+)
 
-func (x *UserResult) Reset() {
-	*x = UserResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for LoginWithIdentityResponse_LoginWithIdentityStatus.
+var (
+	LoginWithIdentityResponse_LoginWithIdentityStatus_name = map[int32]string{
+		0: "OK",
+		1: "EMAIL_TAKEN",
+		2: "INVALID_EMAIL",
+		3: "SECOND_FACTOR_REQUIRED",
+		4: "ACCOUNT_SUSPENDED",
+	}
+	LoginWithIdentityResponse_LoginWithIdentityStatus_value = map[string]int32{
+		"OK":                     0,
+		"EMAIL_TAKEN":            1,
+		"INVALID_EMAIL":          2,
+		"SECOND_FACTOR_REQUIRED": 3,
+		"ACCOUNT_SUSPENDED":      4,
 	}
-}
+)
 
-func (x *UserResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x LoginWithIdentityResponse_LoginWithIdentityStatus) Enum() *LoginWithIdentityResponse_LoginWithIdentityStatus {
+	p := new(LoginWithIdentityResponse_LoginWithIdentityStatus)
+	*p = x
+	return p
 }
 
-func (*UserResult) ProtoMessage() {}
+func (x LoginWithIdentityResponse_LoginWithIdentityStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *UserResult) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (LoginWithIdentityResponse_LoginWithIdentityStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[13].Descriptor()
 }
 
-// Deprecated: Use UserResult.ProtoReflect.Descriptor instead.
-func (*UserResult) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{1}
+func (LoginWithIdentityResponse_LoginWithIdentityStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[13]
 }
 
-func (x *UserResult) GetStatus() UserResult_UserStatus {
-	if x != nil {
-		return x.Status
-	}
-	return UserResult_OK
+func (x LoginWithIdentityResponse_LoginWithIdentityStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UserResult) GetInfo() *UserDetailInfo {
-	if x != nil {
-		return x.Info
-	}
-	return nil
+// Deprecated: Use LoginWithIdentityResponse_LoginWithIdentityStatus.Descriptor instead.
+func (LoginWithIdentityResponse_LoginWithIdentityStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{26, 0}
 }
 
-type EditUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type LinkIdentityResponse_LinkIdentityStatus int32
 
-	UserId       int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserPassword *string              `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3,oneof" json:"user_password,omitempty"`
-	FirstName    *string              `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
-	LastName     *string              `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
-	Dob          *timestamp.Timestamp `protobuf:"bytes,5,opt,name=dob,proto3,oneof" json:"dob,omitempty"`
-}
+const (
+	LinkIdentityResponse_OK             LinkIdentityResponse_LinkIdentityStatus = 0
+	LinkIdentityResponse_USER_NOT_FOUND LinkIdentityResponse_LinkIdentityStatus = 1
+	LinkIdentityResponse_ALREADY_LINKED LinkIdentityResponse_LinkIdentityStatus = 2
+	LinkIdentityResponse_FORBIDDEN      LinkIdentityResponse_LinkIdentityStatus = 3
+)
 
-func (x *EditUserRequest) Reset() {
-	*x = EditUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for LinkIdentityResponse_LinkIdentityStatus.
+var (
+	LinkIdentityResponse_LinkIdentityStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "ALREADY_LINKED",
+		3: "FORBIDDEN",
 	}
-}
+	LinkIdentityResponse_LinkIdentityStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"ALREADY_LINKED": 2,
+		"FORBIDDEN":      3,
+	}
+)
 
-func (x *EditUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x LinkIdentityResponse_LinkIdentityStatus) Enum() *LinkIdentityResponse_LinkIdentityStatus {
+	p := new(LinkIdentityResponse_LinkIdentityStatus)
+	*p = x
+	return p
 }
 
-func (*EditUserRequest) ProtoMessage() {}
-
-func (x *EditUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x LinkIdentityResponse_LinkIdentityStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Deprecated: Use EditUserRequest.ProtoReflect.Descriptor instead.
-func (*EditUserRequest) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{2}
+func (LinkIdentityResponse_LinkIdentityStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[14].Descriptor()
 }
 
-func (x *EditUserRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (LinkIdentityResponse_LinkIdentityStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[14]
 }
 
-func (x *EditUserRequest) GetUserPassword() string {
-	if x != nil && x.UserPassword != nil {
-		return *x.UserPassword
-	}
-	return ""
+func (x LinkIdentityResponse_LinkIdentityStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditUserRequest) GetFirstName() string {
-	if x != nil && x.FirstName != nil {
-		return *x.FirstName
-	}
-	return ""
+// Deprecated: Use LinkIdentityResponse_LinkIdentityStatus.Descriptor instead.
+func (LinkIdentityResponse_LinkIdentityStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{28, 0}
 }
 
-func (x *EditUserRequest) GetLastName() string {
-	if x != nil && x.LastName != nil {
-		return *x.LastName
+type UnlinkIdentityResponse_UnlinkIdentityStatus int32
+
+const (
+	UnlinkIdentityResponse_OK        UnlinkIdentityResponse_UnlinkIdentityStatus = 0
+	UnlinkIdentityResponse_NOT_FOUND UnlinkIdentityResponse_UnlinkIdentityStatus = 1
+	UnlinkIdentityResponse_FORBIDDEN UnlinkIdentityResponse_UnlinkIdentityStatus = 2
+)
+
+// Enum value maps for UnlinkIdentityResponse_UnlinkIdentityStatus.
+var (
+	UnlinkIdentityResponse_UnlinkIdentityStatus_name = map[int32]string{
+		0: "OK",
+		1: "NOT_FOUND",
+		2: "FORBIDDEN",
 	}
-	return ""
+	UnlinkIdentityResponse_UnlinkIdentityStatus_value = map[string]int32{
+		"OK":        0,
+		"NOT_FOUND": 1,
+		"FORBIDDEN": 2,
+	}
+)
+
+func (x UnlinkIdentityResponse_UnlinkIdentityStatus) Enum() *UnlinkIdentityResponse_UnlinkIdentityStatus {
+	p := new(UnlinkIdentityResponse_UnlinkIdentityStatus)
+	*p = x
+	return p
 }
 
-func (x *EditUserRequest) GetDob() *timestamp.Timestamp {
-	if x != nil {
-		return x.Dob
-	}
-	return nil
+func (x UnlinkIdentityResponse_UnlinkIdentityStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type EditUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (UnlinkIdentityResponse_UnlinkIdentityStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[15].Descriptor()
+}
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (UnlinkIdentityResponse_UnlinkIdentityStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[15]
 }
 
-func (x *EditUserResponse) Reset() {
-	*x = EditUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x UnlinkIdentityResponse_UnlinkIdentityStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use UnlinkIdentityResponse_UnlinkIdentityStatus.Descriptor instead.
+func (UnlinkIdentityResponse_UnlinkIdentityStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{30, 0}
 }
 
-func (*EditUserResponse) ProtoMessage() {}
+type ListIdentitiesResponse_ListIdentitiesStatus int32
 
-func (x *EditUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ListIdentitiesResponse_OK        ListIdentitiesResponse_ListIdentitiesStatus = 0
+	ListIdentitiesResponse_FORBIDDEN ListIdentitiesResponse_ListIdentitiesStatus = 1
+)
+
+// Enum value maps for ListIdentitiesResponse_ListIdentitiesStatus.
+var (
+	ListIdentitiesResponse_ListIdentitiesStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
 	}
-	return mi.MessageOf(x)
-}
+	ListIdentitiesResponse_ListIdentitiesStatus_value = map[string]int32{
+		"OK":        0,
+		"FORBIDDEN": 1,
+	}
+)
 
-// Deprecated: Use EditUserResponse.ProtoReflect.Descriptor instead.
-func (*EditUserResponse) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{3}
+func (x ListIdentitiesResponse_ListIdentitiesStatus) Enum() *ListIdentitiesResponse_ListIdentitiesStatus {
+	p := new(ListIdentitiesResponse_ListIdentitiesStatus)
+	*p = x
+	return p
 }
 
-func (x *EditUserResponse) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x ListIdentitiesResponse_ListIdentitiesStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type AuthenticateUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ListIdentitiesResponse_ListIdentitiesStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[16].Descriptor()
+}
 
-	UserName     string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserPassword string `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
+func (ListIdentitiesResponse_ListIdentitiesStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[16]
 }
 
-func (x *AuthenticateUserRequest) Reset() {
-	*x = AuthenticateUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ListIdentitiesResponse_ListIdentitiesStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *AuthenticateUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ListIdentitiesResponse_ListIdentitiesStatus.Descriptor instead.
+func (ListIdentitiesResponse_ListIdentitiesStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{33, 0}
 }
 
-func (*AuthenticateUserRequest) ProtoMessage() {}
+type DeleteAccountResponse_DeleteAccountStatus int32
 
-func (x *AuthenticateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	DeleteAccountResponse_OK             DeleteAccountResponse_DeleteAccountStatus = 0
+	DeleteAccountResponse_USER_NOT_FOUND DeleteAccountResponse_DeleteAccountStatus = 1
+	DeleteAccountResponse_FORBIDDEN      DeleteAccountResponse_DeleteAccountStatus = 2
+)
+
+// Enum value maps for DeleteAccountResponse_DeleteAccountStatus.
+var (
+	DeleteAccountResponse_DeleteAccountStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "FORBIDDEN",
 	}
-	return mi.MessageOf(x)
-}
+	DeleteAccountResponse_DeleteAccountStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"FORBIDDEN":      2,
+	}
+)
 
-// Deprecated: Use AuthenticateUserRequest.ProtoReflect.Descriptor instead.
-func (*AuthenticateUserRequest) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{4}
+func (x DeleteAccountResponse_DeleteAccountStatus) Enum() *DeleteAccountResponse_DeleteAccountStatus {
+	p := new(DeleteAccountResponse_DeleteAccountStatus)
+	*p = x
+	return p
 }
 
-func (x *AuthenticateUserRequest) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+func (x DeleteAccountResponse_DeleteAccountStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *AuthenticateUserRequest) GetUserPassword() string {
-	if x != nil {
-		return x.UserPassword
-	}
-	return ""
+func (DeleteAccountResponse_DeleteAccountStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[17].Descriptor()
 }
 
-type AuthenticateUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status AuthenticateUserResponse_AuthenticateUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.AuthenticateUserResponse_AuthenticateUserStatus" json:"status,omitempty"`
-	UserId int64                                           `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (DeleteAccountResponse_DeleteAccountStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[17]
 }
 
-func (x *AuthenticateUserResponse) Reset() {
-	*x = AuthenticateUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x DeleteAccountResponse_DeleteAccountStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *AuthenticateUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use DeleteAccountResponse_DeleteAccountStatus.Descriptor instead.
+func (DeleteAccountResponse_DeleteAccountStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{35, 0}
 }
 
-func (*AuthenticateUserResponse) ProtoMessage() {}
+type RequestDataExportResponse_RequestDataExportStatus int32
 
-func (x *AuthenticateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	RequestDataExportResponse_OK        RequestDataExportResponse_RequestDataExportStatus = 0
+	RequestDataExportResponse_FORBIDDEN RequestDataExportResponse_RequestDataExportStatus = 1
+)
+
+// Enum value maps for RequestDataExportResponse_RequestDataExportStatus.
+var (
+	RequestDataExportResponse_RequestDataExportStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
 	}
-	return mi.MessageOf(x)
-}
+	RequestDataExportResponse_RequestDataExportStatus_value = map[string]int32{
+		"OK":        0,
+		"FORBIDDEN": 1,
+	}
+)
 
-// Deprecated: Use AuthenticateUserResponse.ProtoReflect.Descriptor instead.
-func (*AuthenticateUserResponse) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{5}
+func (x RequestDataExportResponse_RequestDataExportStatus) Enum() *RequestDataExportResponse_RequestDataExportStatus {
+	p := new(RequestDataExportResponse_RequestDataExportStatus)
+	*p = x
+	return p
 }
 
-func (x *AuthenticateUserResponse) GetStatus() AuthenticateUserResponse_AuthenticateUserStatus {
-	if x != nil {
-		return x.Status
-	}
-	return AuthenticateUserResponse_OK
+func (x RequestDataExportResponse_RequestDataExportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *AuthenticateUserResponse) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (RequestDataExportResponse_RequestDataExportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[18].Descriptor()
 }
 
-// Follow handler
-type FollowUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId          int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FollowingUserId int64 `protobuf:"varint,2,opt,name=following_user_id,json=followingUserId,proto3" json:"following_user_id,omitempty"`
+func (RequestDataExportResponse_RequestDataExportStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[18]
 }
 
-func (x *FollowUserRequest) Reset() {
-	*x = FollowUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x RequestDataExportResponse_RequestDataExportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *FollowUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use RequestDataExportResponse_RequestDataExportStatus.Descriptor instead.
+func (RequestDataExportResponse_RequestDataExportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{37, 0}
 }
 
-func (*FollowUserRequest) ProtoMessage() {}
+type DataExportInfo_DataExportState int32
 
-func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	DataExportInfo_PENDING DataExportInfo_DataExportState = 0
+	DataExportInfo_RUNNING DataExportInfo_DataExportState = 1
+	DataExportInfo_READY   DataExportInfo_DataExportState = 2
+	DataExportInfo_FAILED  DataExportInfo_DataExportState = 3
+	DataExportInfo_EXPIRED DataExportInfo_DataExportState = 4
+)
+
+// Enum value maps for DataExportInfo_DataExportState.
+var (
+	DataExportInfo_DataExportState_name = map[int32]string{
+		0: "PENDING",
+		1: "RUNNING",
+		2: "READY",
+		3: "FAILED",
+		4: "EXPIRED",
+	}
+	DataExportInfo_DataExportState_value = map[string]int32{
+		"PENDING": 0,
+		"RUNNING": 1,
+		"READY":   2,
+		"FAILED":  3,
+		"EXPIRED": 4,
 	}
-	return mi.MessageOf(x)
-}
+)
 
-// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
-func (*FollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{6}
+func (x DataExportInfo_DataExportState) Enum() *DataExportInfo_DataExportState {
+	p := new(DataExportInfo_DataExportState)
+	*p = x
+	return p
 }
 
-func (x *FollowUserRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x DataExportInfo_DataExportState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *FollowUserRequest) GetFollowingUserId() int64 {
-	if x != nil {
-		return x.FollowingUserId
-	}
-	return 0
+func (DataExportInfo_DataExportState) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[19].Descriptor()
 }
 
-type FollowUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status FollowUserResponse_FollowStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.FollowUserResponse_FollowStatus" json:"status,omitempty"`
+func (DataExportInfo_DataExportState) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[19]
 }
 
-func (x *FollowUserResponse) Reset() {
-	*x = FollowUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x DataExportInfo_DataExportState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *FollowUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use DataExportInfo_DataExportState.Descriptor instead.
+func (DataExportInfo_DataExportState) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{38, 0}
 }
 
-func (*FollowUserResponse) ProtoMessage() {}
+type GetDataExportResponse_GetDataExportStatus int32
 
-func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	GetDataExportResponse_OK               GetDataExportResponse_GetDataExportStatus = 0
+	GetDataExportResponse_FORBIDDEN        GetDataExportResponse_GetDataExportStatus = 1
+	GetDataExportResponse_EXPORT_NOT_FOUND GetDataExportResponse_GetDataExportStatus = 2
+)
+
+// Enum value maps for GetDataExportResponse_GetDataExportStatus.
+var (
+	GetDataExportResponse_GetDataExportStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+		2: "EXPORT_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
-}
+	GetDataExportResponse_GetDataExportStatus_value = map[string]int32{
+		"OK":               0,
+		"FORBIDDEN":        1,
+		"EXPORT_NOT_FOUND": 2,
+	}
+)
 
-// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
-func (*FollowUserResponse) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{7}
+func (x GetDataExportResponse_GetDataExportStatus) Enum() *GetDataExportResponse_GetDataExportStatus {
+	p := new(GetDataExportResponse_GetDataExportStatus)
+	*p = x
+	return p
 }
 
-func (x *FollowUserResponse) GetStatus() FollowUserResponse_FollowStatus {
-	if x != nil {
-		return x.Status
-	}
-	return FollowUserResponse_OK
+func (x GetDataExportResponse_GetDataExportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type UnfollowUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (GetDataExportResponse_GetDataExportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[20].Descriptor()
+}
 
-	UserId          int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FollowingUserId int64 `protobuf:"varint,2,opt,name=following_user_id,json=followingUserId,proto3" json:"following_user_id,omitempty"`
+func (GetDataExportResponse_GetDataExportStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[20]
 }
 
-func (x *UnfollowUserRequest) Reset() {
-	*x = UnfollowUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x GetDataExportResponse_GetDataExportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UnfollowUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetDataExportResponse_GetDataExportStatus.Descriptor instead.
+func (GetDataExportResponse_GetDataExportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{40, 0}
 }
 
-func (*UnfollowUserRequest) ProtoMessage() {}
+type DownloadDataExportResponse_DownloadDataExportStatus int32
 
-func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	DownloadDataExportResponse_OK               DownloadDataExportResponse_DownloadDataExportStatus = 0
+	DownloadDataExportResponse_FORBIDDEN        DownloadDataExportResponse_DownloadDataExportStatus = 1
+	DownloadDataExportResponse_EXPORT_NOT_FOUND DownloadDataExportResponse_DownloadDataExportStatus = 2
+	DownloadDataExportResponse_NOT_READY        DownloadDataExportResponse_DownloadDataExportStatus = 3
+)
+
+// Enum value maps for DownloadDataExportResponse_DownloadDataExportStatus.
+var (
+	DownloadDataExportResponse_DownloadDataExportStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+		2: "EXPORT_NOT_FOUND",
+		3: "NOT_READY",
 	}
-	return mi.MessageOf(x)
+	DownloadDataExportResponse_DownloadDataExportStatus_value = map[string]int32{
+		"OK":               0,
+		"FORBIDDEN":        1,
+		"EXPORT_NOT_FOUND": 2,
+		"NOT_READY":        3,
+	}
+)
+
+func (x DownloadDataExportResponse_DownloadDataExportStatus) Enum() *DownloadDataExportResponse_DownloadDataExportStatus {
+	p := new(DownloadDataExportResponse_DownloadDataExportStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
-func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{8}
+func (x DownloadDataExportResponse_DownloadDataExportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *UnfollowUserRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (DownloadDataExportResponse_DownloadDataExportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[21].Descriptor()
 }
 
-func (x *UnfollowUserRequest) GetFollowingUserId() int64 {
-	if x != nil {
-		return x.FollowingUserId
-	}
-	return 0
+func (DownloadDataExportResponse_DownloadDataExportStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[21]
 }
 
-type UnfollowUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x DownloadDataExportResponse_DownloadDataExportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	Status UnfollowUserResponse_UnfollowStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.UnfollowUserResponse_UnfollowStatus" json:"status,omitempty"`
+// Deprecated: Use DownloadDataExportResponse_DownloadDataExportStatus.Descriptor instead.
+func (DownloadDataExportResponse_DownloadDataExportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{42, 0}
 }
 
-func (x *UnfollowUserResponse) Reset() {
-	*x = UnfollowUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type ImportAccountResponse_ImportAccountStatus int32
+
+const (
+	ImportAccountResponse_OK              ImportAccountResponse_ImportAccountStatus = 0
+	ImportAccountResponse_FORBIDDEN       ImportAccountResponse_ImportAccountStatus = 1
+	ImportAccountResponse_INVALID_ARCHIVE ImportAccountResponse_ImportAccountStatus = 2
+	ImportAccountResponse_USERNAME_TAKEN  ImportAccountResponse_ImportAccountStatus = 3
+	ImportAccountResponse_EMAIL_TAKEN     ImportAccountResponse_ImportAccountStatus = 4
+)
+
+// Enum value maps for ImportAccountResponse_ImportAccountStatus.
+var (
+	ImportAccountResponse_ImportAccountStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+		2: "INVALID_ARCHIVE",
+		3: "USERNAME_TAKEN",
+		4: "EMAIL_TAKEN",
+	}
+	ImportAccountResponse_ImportAccountStatus_value = map[string]int32{
+		"OK":              0,
+		"FORBIDDEN":       1,
+		"INVALID_ARCHIVE": 2,
+		"USERNAME_TAKEN":  3,
+		"EMAIL_TAKEN":     4,
 	}
+)
+
+func (x ImportAccountResponse_ImportAccountStatus) Enum() *ImportAccountResponse_ImportAccountStatus {
+	p := new(ImportAccountResponse_ImportAccountStatus)
+	*p = x
+	return p
 }
 
-func (x *UnfollowUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ImportAccountResponse_ImportAccountStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*UnfollowUserResponse) ProtoMessage() {}
+func (ImportAccountResponse_ImportAccountStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[22].Descriptor()
+}
 
-func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ImportAccountResponse_ImportAccountStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[22]
 }
 
-// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
-func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{9}
+func (x ImportAccountResponse_ImportAccountStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UnfollowUserResponse) GetStatus() UnfollowUserResponse_UnfollowStatus {
-	if x != nil {
-		return x.Status
-	}
-	return UnfollowUserResponse_OK
+// Deprecated: Use ImportAccountResponse_ImportAccountStatus.Descriptor instead.
+func (ImportAccountResponse_ImportAccountStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{45, 0}
 }
 
-type GetFollowerListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetUserResponse_GetUserStatus int32
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-}
+const (
+	GetUserResponse_OK             GetUserResponse_GetUserStatus = 0
+	GetUserResponse_USER_NOT_FOUND GetUserResponse_GetUserStatus = 1
+)
 
-func (x *GetFollowerListRequest) Reset() {
-	*x = GetFollowerListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetUserResponse_GetUserStatus.
+var (
+	GetUserResponse_GetUserStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
 	}
+	GetUserResponse_GetUserStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+	}
+)
+
+func (x GetUserResponse_GetUserStatus) Enum() *GetUserResponse_GetUserStatus {
+	p := new(GetUserResponse_GetUserStatus)
+	*p = x
+	return p
 }
 
-func (x *GetFollowerListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetUserResponse_GetUserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetFollowerListRequest) ProtoMessage() {}
+func (GetUserResponse_GetUserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[23].Descriptor()
+}
 
-func (x *GetFollowerListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetUserResponse_GetUserStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[23]
 }
 
-// Deprecated: Use GetFollowerListRequest.ProtoReflect.Descriptor instead.
-func (*GetFollowerListRequest) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{10}
+func (x GetUserResponse_GetUserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetFollowerListRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use GetUserResponse_GetUserStatus.Descriptor instead.
+func (GetUserResponse_GetUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{50, 0}
 }
 
-type GetFollowerListResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetUsersResponse_GetUsersStatus int32
 
-	Status    GetFollowerListResponse_GetFollowerListStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.GetFollowerListResponse_GetFollowerListStatus" json:"status,omitempty"`
-	Followers []*GetFollowerListResponse_FollowerInfo       `protobuf:"bytes,2,rep,name=followers,proto3" json:"followers,omitempty"`
-}
+const (
+	GetUsersResponse_OK             GetUsersResponse_GetUsersStatus = 0
+	GetUsersResponse_TOO_MANY_USERS GetUsersResponse_GetUsersStatus = 1
+)
 
-func (x *GetFollowerListResponse) Reset() {
-	*x = GetFollowerListResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetUsersResponse_GetUsersStatus.
+var (
+	GetUsersResponse_GetUsersStatus_name = map[int32]string{
+		0: "OK",
+		1: "TOO_MANY_USERS",
 	}
-}
+	GetUsersResponse_GetUsersStatus_value = map[string]int32{
+		"OK":             0,
+		"TOO_MANY_USERS": 1,
+	}
+)
 
-func (x *GetFollowerListResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetUsersResponse_GetUsersStatus) Enum() *GetUsersResponse_GetUsersStatus {
+	p := new(GetUsersResponse_GetUsersStatus)
+	*p = x
+	return p
 }
 
-func (*GetFollowerListResponse) ProtoMessage() {}
+func (x GetUsersResponse_GetUsersStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *GetFollowerListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetUsersResponse_GetUsersStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[24].Descriptor()
 }
 
-// Deprecated: Use GetFollowerListResponse.ProtoReflect.Descriptor instead.
-func (*GetFollowerListResponse) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{11}
+func (GetUsersResponse_GetUsersStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[24]
 }
 
-func (x *GetFollowerListResponse) GetStatus() GetFollowerListResponse_GetFollowerListStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetFollowerListResponse_OK
+func (x GetUsersResponse_GetUsersStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetFollowerListResponse) GetFollowers() []*GetFollowerListResponse_FollowerInfo {
-	if x != nil {
-		return x.Followers
-	}
-	return nil
+// Deprecated: Use GetUsersResponse_GetUsersStatus.Descriptor instead.
+func (GetUsersResponse_GetUsersStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{52, 0}
 }
 
-type UserInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type SuspendUserResponse_SuspendUserStatus int32
 
-	UserId       int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName     string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserPassword string `protobuf:"bytes,3,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
-}
+const (
+	SuspendUserResponse_OK             SuspendUserResponse_SuspendUserStatus = 0
+	SuspendUserResponse_USER_NOT_FOUND SuspendUserResponse_SuspendUserStatus = 1
+	SuspendUserResponse_FORBIDDEN      SuspendUserResponse_SuspendUserStatus = 2
+)
 
-func (x *UserInfo) Reset() {
-	*x = UserInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for SuspendUserResponse_SuspendUserStatus.
+var (
+	SuspendUserResponse_SuspendUserStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "FORBIDDEN",
 	}
+	SuspendUserResponse_SuspendUserStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"FORBIDDEN":      2,
+	}
+)
+
+func (x SuspendUserResponse_SuspendUserStatus) Enum() *SuspendUserResponse_SuspendUserStatus {
+	p := new(SuspendUserResponse_SuspendUserStatus)
+	*p = x
+	return p
 }
 
-func (x *UserInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x SuspendUserResponse_SuspendUserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*UserInfo) ProtoMessage() {}
+func (SuspendUserResponse_SuspendUserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[25].Descriptor()
+}
 
-func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (SuspendUserResponse_SuspendUserStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[25]
 }
 
-// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
-func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{12}
+func (x SuspendUserResponse_SuspendUserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UserInfo) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use SuspendUserResponse_SuspendUserStatus.Descriptor instead.
+func (SuspendUserResponse_SuspendUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{56, 0}
 }
 
-func (x *UserInfo) GetUserName() string {
-	if x != nil {
-		return x.UserName
+type ForceDeletePostResponse_ForceDeletePostStatus int32
+
+const (
+	ForceDeletePostResponse_OK             ForceDeletePostResponse_ForceDeletePostStatus = 0
+	ForceDeletePostResponse_POST_NOT_FOUND ForceDeletePostResponse_ForceDeletePostStatus = 1
+	ForceDeletePostResponse_FORBIDDEN      ForceDeletePostResponse_ForceDeletePostStatus = 2
+)
+
+// Enum value maps for ForceDeletePostResponse_ForceDeletePostStatus.
+var (
+	ForceDeletePostResponse_ForceDeletePostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "FORBIDDEN",
 	}
-	return ""
+	ForceDeletePostResponse_ForceDeletePostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"FORBIDDEN":      2,
+	}
+)
+
+func (x ForceDeletePostResponse_ForceDeletePostStatus) Enum() *ForceDeletePostResponse_ForceDeletePostStatus {
+	p := new(ForceDeletePostResponse_ForceDeletePostStatus)
+	*p = x
+	return p
 }
 
-func (x *UserInfo) GetUserPassword() string {
-	if x != nil {
-		return x.UserPassword
-	}
-	return ""
+func (x ForceDeletePostResponse_ForceDeletePostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Post handler
-type CreatePostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ForceDeletePostResponse_ForceDeletePostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[26].Descriptor()
+}
 
-	UserId           int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContentText      string `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
-	ContentImagePath string `protobuf:"bytes,3,opt,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
-	Visible          bool   `protobuf:"varint,4,opt,name=visible,proto3" json:"visible,omitempty"`
+func (ForceDeletePostResponse_ForceDeletePostStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[26]
 }
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ForceDeletePostResponse_ForceDeletePostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreatePostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ForceDeletePostResponse_ForceDeletePostStatus.Descriptor instead.
+func (ForceDeletePostResponse_ForceDeletePostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{58, 0}
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+type ReportPostResponse_ReportPostStatus int32
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ReportPostResponse_OK               ReportPostResponse_ReportPostStatus = 0
+	ReportPostResponse_POST_NOT_FOUND   ReportPostResponse_ReportPostStatus = 1
+	ReportPostResponse_ALREADY_REPORTED ReportPostResponse_ReportPostStatus = 2
+	ReportPostResponse_FORBIDDEN        ReportPostResponse_ReportPostStatus = 3
+)
+
+// Enum value maps for ReportPostResponse_ReportPostStatus.
+var (
+	ReportPostResponse_ReportPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "ALREADY_REPORTED",
+		3: "FORBIDDEN",
 	}
-	return mi.MessageOf(x)
+	ReportPostResponse_ReportPostStatus_value = map[string]int32{
+		"OK":               0,
+		"POST_NOT_FOUND":   1,
+		"ALREADY_REPORTED": 2,
+		"FORBIDDEN":        3,
+	}
+)
+
+func (x ReportPostResponse_ReportPostStatus) Enum() *ReportPostResponse_ReportPostStatus {
+	p := new(ReportPostResponse_ReportPostStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{13}
+func (x ReportPostResponse_ReportPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CreatePostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (ReportPostResponse_ReportPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[27].Descriptor()
 }
 
-func (x *CreatePostRequest) GetContentText() string {
-	if x != nil {
-		return x.ContentText
-	}
-	return ""
+func (ReportPostResponse_ReportPostStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[27]
 }
 
-func (x *CreatePostRequest) GetContentImagePath() string {
-	if x != nil {
-		return x.ContentImagePath
-	}
-	return ""
+func (x ReportPostResponse_ReportPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreatePostRequest) GetVisible() bool {
-	if x != nil {
-		return x.Visible
-	}
-	return false
+// Deprecated: Use ReportPostResponse_ReportPostStatus.Descriptor instead.
+func (ReportPostResponse_ReportPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{60, 0}
 }
 
-type CreatePostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ListReportsResponse_ListReportsStatus int32
 
-	Status CreatePostResponse_CreatePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.CreatePostResponse_CreatePostStatus" json:"status,omitempty"`
-	PostId int64                               `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-}
+const (
+	ListReportsResponse_OK        ListReportsResponse_ListReportsStatus = 0
+	ListReportsResponse_FORBIDDEN ListReportsResponse_ListReportsStatus = 1
+)
 
-func (x *CreatePostResponse) Reset() {
-	*x = CreatePostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ListReportsResponse_ListReportsStatus.
+var (
+	ListReportsResponse_ListReportsStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+	}
+	ListReportsResponse_ListReportsStatus_value = map[string]int32{
+		"OK":        0,
+		"FORBIDDEN": 1,
 	}
+)
+
+func (x ListReportsResponse_ListReportsStatus) Enum() *ListReportsResponse_ListReportsStatus {
+	p := new(ListReportsResponse_ListReportsStatus)
+	*p = x
+	return p
 }
 
-func (x *CreatePostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ListReportsResponse_ListReportsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*CreatePostResponse) ProtoMessage() {}
+func (ListReportsResponse_ListReportsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[28].Descriptor()
+}
 
-func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ListReportsResponse_ListReportsStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[28]
 }
 
-// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
-func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{14}
+func (x ListReportsResponse_ListReportsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreatePostResponse) GetStatus() CreatePostResponse_CreatePostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CreatePostResponse_OK
+// Deprecated: Use ListReportsResponse_ListReportsStatus.Descriptor instead.
+func (ListReportsResponse_ListReportsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{63, 0}
 }
 
-func (x *CreatePostResponse) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
+type FollowUserResponse_FollowStatus int32
+
+const (
+	FollowUserResponse_OK               FollowUserResponse_FollowStatus = 0
+	FollowUserResponse_USER_NOT_FOUND   FollowUserResponse_FollowStatus = 1
+	FollowUserResponse_ALREADY_FOLLOWED FollowUserResponse_FollowStatus = 2
+	FollowUserResponse_BLOCKED          FollowUserResponse_FollowStatus = 3
+	// the target is private, a follow request waits for its approval
+	FollowUserResponse_REQUESTED          FollowUserResponse_FollowStatus = 4
+	FollowUserResponse_FORBIDDEN          FollowUserResponse_FollowStatus = 5
+	FollowUserResponse_CANNOT_FOLLOW_SELF FollowUserResponse_FollowStatus = 6
+)
+
+// Enum value maps for FollowUserResponse_FollowStatus.
+var (
+	FollowUserResponse_FollowStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "ALREADY_FOLLOWED",
+		3: "BLOCKED",
+		4: "REQUESTED",
+		5: "FORBIDDEN",
+		6: "CANNOT_FOLLOW_SELF",
 	}
-	return 0
+	FollowUserResponse_FollowStatus_value = map[string]int32{
+		"OK":                 0,
+		"USER_NOT_FOUND":     1,
+		"ALREADY_FOLLOWED":   2,
+		"BLOCKED":            3,
+		"REQUESTED":          4,
+		"FORBIDDEN":          5,
+		"CANNOT_FOLLOW_SELF": 6,
+	}
+)
+
+func (x FollowUserResponse_FollowStatus) Enum() *FollowUserResponse_FollowStatus {
+	p := new(FollowUserResponse_FollowStatus)
+	*p = x
+	return p
 }
 
-type GetPostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x FollowUserResponse_FollowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+func (FollowUserResponse_FollowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[29].Descriptor()
 }
 
-func (x *GetPostRequest) Reset() {
-	*x = GetPostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (FollowUserResponse_FollowStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[29]
 }
 
-func (x *GetPostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x FollowUserResponse_FollowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*GetPostRequest) ProtoMessage() {}
+// Deprecated: Use FollowUserResponse_FollowStatus.Descriptor instead.
+func (FollowUserResponse_FollowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{65, 0}
+}
 
-func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type UnfollowUserResponse_UnfollowStatus int32
+
+const (
+	UnfollowUserResponse_OK             UnfollowUserResponse_UnfollowStatus = 0
+	UnfollowUserResponse_USER_NOT_FOUND UnfollowUserResponse_UnfollowStatus = 1
+	UnfollowUserResponse_NOT_FOLLOWED   UnfollowUserResponse_UnfollowStatus = 2
+	UnfollowUserResponse_FORBIDDEN      UnfollowUserResponse_UnfollowStatus = 3
+)
+
+// Enum value maps for UnfollowUserResponse_UnfollowStatus.
+var (
+	UnfollowUserResponse_UnfollowStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "NOT_FOLLOWED",
+		3: "FORBIDDEN",
 	}
-	return mi.MessageOf(x)
-}
+	UnfollowUserResponse_UnfollowStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"NOT_FOLLOWED":   2,
+		"FORBIDDEN":      3,
+	}
+)
 
-// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
-func (*GetPostRequest) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{15}
+func (x UnfollowUserResponse_UnfollowStatus) Enum() *UnfollowUserResponse_UnfollowStatus {
+	p := new(UnfollowUserResponse_UnfollowStatus)
+	*p = x
+	return p
 }
 
-func (x *GetPostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (x UnfollowUserResponse_UnfollowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type Post struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (UnfollowUserResponse_UnfollowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[30].Descriptor()
+}
 
-	PostId           int64                `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId           int64                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContentText      string               `protobuf:"bytes,4,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
-	ContentImagePath string               `protobuf:"bytes,5,opt,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
-	Visible          bool                 `protobuf:"varint,6,opt,name=visible,proto3" json:"visible,omitempty"`
-	CreatedTime      *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+func (UnfollowUserResponse_UnfollowStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[30]
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x UnfollowUserResponse_UnfollowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Post) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use UnfollowUserResponse_UnfollowStatus.Descriptor instead.
+func (UnfollowUserResponse_UnfollowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{67, 0}
 }
 
-func (*Post) ProtoMessage() {}
+type GetFollowerListResponse_GetFollowerListStatus int32
 
-func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	GetFollowerListResponse_OK             GetFollowerListResponse_GetFollowerListStatus = 0
+	GetFollowerListResponse_USER_NOT_FOUND GetFollowerListResponse_GetFollowerListStatus = 1
+	GetFollowerListResponse_INVALID_CURSOR GetFollowerListResponse_GetFollowerListStatus = 2
+)
+
+// Enum value maps for GetFollowerListResponse_GetFollowerListStatus.
+var (
+	GetFollowerListResponse_GetFollowerListStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "INVALID_CURSOR",
 	}
-	return mi.MessageOf(x)
+	GetFollowerListResponse_GetFollowerListStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"INVALID_CURSOR": 2,
+	}
+)
+
+func (x GetFollowerListResponse_GetFollowerListStatus) Enum() *GetFollowerListResponse_GetFollowerListStatus {
+	p := new(GetFollowerListResponse_GetFollowerListStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{16}
+func (x GetFollowerListResponse_GetFollowerListStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Post) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (GetFollowerListResponse_GetFollowerListStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[31].Descriptor()
 }
 
-func (x *Post) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (GetFollowerListResponse_GetFollowerListStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[31]
 }
 
-func (x *Post) GetContentText() string {
-	if x != nil {
-		return x.ContentText
-	}
-	return ""
+func (x GetFollowerListResponse_GetFollowerListStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Post) GetContentImagePath() string {
-	if x != nil {
-		return x.ContentImagePath
-	}
-	return ""
+// Deprecated: Use GetFollowerListResponse_GetFollowerListStatus.Descriptor instead.
+func (GetFollowerListResponse_GetFollowerListStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{70, 0}
 }
 
-func (x *Post) GetVisible() bool {
-	if x != nil {
-		return x.Visible
+type GetFollowingListResponse_GetFollowingListStatus int32
+
+const (
+	GetFollowingListResponse_OK             GetFollowingListResponse_GetFollowingListStatus = 0
+	GetFollowingListResponse_USER_NOT_FOUND GetFollowingListResponse_GetFollowingListStatus = 1
+	GetFollowingListResponse_INVALID_CURSOR GetFollowingListResponse_GetFollowingListStatus = 2
+)
+
+// Enum value maps for GetFollowingListResponse_GetFollowingListStatus.
+var (
+	GetFollowingListResponse_GetFollowingListStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "INVALID_CURSOR",
 	}
-	return false
+	GetFollowingListResponse_GetFollowingListStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"INVALID_CURSOR": 2,
+	}
+)
+
+func (x GetFollowingListResponse_GetFollowingListStatus) Enum() *GetFollowingListResponse_GetFollowingListStatus {
+	p := new(GetFollowingListResponse_GetFollowingListStatus)
+	*p = x
+	return p
 }
 
-func (x *Post) GetCreatedTime() *timestamp.Timestamp {
-	if x != nil {
-		return x.CreatedTime
-	}
-	return nil
+func (x GetFollowingListResponse_GetFollowingListStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetPostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (GetFollowingListResponse_GetFollowingListStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[32].Descriptor()
+}
 
-	Status GetPostResponse_GetPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.GetPostResponse_GetPostStatus" json:"status,omitempty"`
-	Post   *Post                         `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+func (GetFollowingListResponse_GetFollowingListStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[32]
 }
 
-func (x *GetPostResponse) Reset() {
-	*x = GetPostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x GetFollowingListResponse_GetFollowingListStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetPostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetFollowingListResponse_GetFollowingListStatus.Descriptor instead.
+func (GetFollowingListResponse_GetFollowingListStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{72, 0}
 }
 
-func (*GetPostResponse) ProtoMessage() {}
+type ListFollowRequestsResponse_ListFollowRequestsStatus int32
 
-func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ListFollowRequestsResponse_OK        ListFollowRequestsResponse_ListFollowRequestsStatus = 0
+	ListFollowRequestsResponse_FORBIDDEN ListFollowRequestsResponse_ListFollowRequestsStatus = 1
+)
+
+// Enum value maps for ListFollowRequestsResponse_ListFollowRequestsStatus.
+var (
+	ListFollowRequestsResponse_ListFollowRequestsStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
 	}
-	return mi.MessageOf(x)
+	ListFollowRequestsResponse_ListFollowRequestsStatus_value = map[string]int32{
+		"OK":        0,
+		"FORBIDDEN": 1,
+	}
+)
+
+func (x ListFollowRequestsResponse_ListFollowRequestsStatus) Enum() *ListFollowRequestsResponse_ListFollowRequestsStatus {
+	p := new(ListFollowRequestsResponse_ListFollowRequestsStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
-func (*GetPostResponse) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{17}
+func (x ListFollowRequestsResponse_ListFollowRequestsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetPostResponse) GetStatus() GetPostResponse_GetPostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetPostResponse_OK
+func (ListFollowRequestsResponse_ListFollowRequestsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[33].Descriptor()
 }
 
-func (x *GetPostResponse) GetPost() *Post {
-	if x != nil {
-		return x.Post
-	}
-	return nil
+func (ListFollowRequestsResponse_ListFollowRequestsStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[33]
 }
 
-type DeletePostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x ListFollowRequestsResponse_ListFollowRequestsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+// Deprecated: Use ListFollowRequestsResponse_ListFollowRequestsStatus.Descriptor instead.
+func (ListFollowRequestsResponse_ListFollowRequestsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{74, 0}
 }
 
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type ApproveFollowRequestResponse_ApproveFollowRequestStatus int32
+
+const (
+	ApproveFollowRequestResponse_OK                ApproveFollowRequestResponse_ApproveFollowRequestStatus = 0
+	ApproveFollowRequestResponse_FORBIDDEN         ApproveFollowRequestResponse_ApproveFollowRequestStatus = 1
+	ApproveFollowRequestResponse_REQUEST_NOT_FOUND ApproveFollowRequestResponse_ApproveFollowRequestStatus = 2
+)
+
+// Enum value maps for ApproveFollowRequestResponse_ApproveFollowRequestStatus.
+var (
+	ApproveFollowRequestResponse_ApproveFollowRequestStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+		2: "REQUEST_NOT_FOUND",
 	}
+	ApproveFollowRequestResponse_ApproveFollowRequestStatus_value = map[string]int32{
+		"OK":                0,
+		"FORBIDDEN":         1,
+		"REQUEST_NOT_FOUND": 2,
+	}
+)
+
+func (x ApproveFollowRequestResponse_ApproveFollowRequestStatus) Enum() *ApproveFollowRequestResponse_ApproveFollowRequestStatus {
+	p := new(ApproveFollowRequestResponse_ApproveFollowRequestStatus)
+	*p = x
+	return p
 }
 
-func (x *DeletePostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ApproveFollowRequestResponse_ApproveFollowRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
+func (ApproveFollowRequestResponse_ApproveFollowRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[34].Descriptor()
+}
 
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ApproveFollowRequestResponse_ApproveFollowRequestStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[34]
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{18}
+func (x ApproveFollowRequestResponse_ApproveFollowRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *DeletePostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+// Deprecated: Use ApproveFollowRequestResponse_ApproveFollowRequestStatus.Descriptor instead.
+func (ApproveFollowRequestResponse_ApproveFollowRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{76, 0}
 }
 
-type DeletePostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type RejectFollowRequestResponse_RejectFollowRequestStatus int32
 
-	Status DeletePostResponse_DeletePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.DeletePostResponse_DeletePostStatus" json:"status,omitempty"`
-}
+const (
+	RejectFollowRequestResponse_OK                RejectFollowRequestResponse_RejectFollowRequestStatus = 0
+	RejectFollowRequestResponse_FORBIDDEN         RejectFollowRequestResponse_RejectFollowRequestStatus = 1
+	RejectFollowRequestResponse_REQUEST_NOT_FOUND RejectFollowRequestResponse_RejectFollowRequestStatus = 2
+)
 
-func (x *DeletePostResponse) Reset() {
-	*x = DeletePostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for RejectFollowRequestResponse_RejectFollowRequestStatus.
+var (
+	RejectFollowRequestResponse_RejectFollowRequestStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+		2: "REQUEST_NOT_FOUND",
+	}
+	RejectFollowRequestResponse_RejectFollowRequestStatus_value = map[string]int32{
+		"OK":                0,
+		"FORBIDDEN":         1,
+		"REQUEST_NOT_FOUND": 2,
 	}
+)
+
+func (x RejectFollowRequestResponse_RejectFollowRequestStatus) Enum() *RejectFollowRequestResponse_RejectFollowRequestStatus {
+	p := new(RejectFollowRequestResponse_RejectFollowRequestStatus)
+	*p = x
+	return p
 }
 
-func (x *DeletePostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x RejectFollowRequestResponse_RejectFollowRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*DeletePostResponse) ProtoMessage() {}
+func (RejectFollowRequestResponse_RejectFollowRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[35].Descriptor()
+}
 
-func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (RejectFollowRequestResponse_RejectFollowRequestStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[35]
 }
 
-// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
-func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{19}
+func (x RejectFollowRequestResponse_RejectFollowRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *DeletePostResponse) GetStatus() DeletePostResponse_DeletePostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return DeletePostResponse_OK
+// Deprecated: Use RejectFollowRequestResponse_RejectFollowRequestStatus.Descriptor instead.
+func (RejectFollowRequestResponse_RejectFollowRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{78, 0}
 }
 
-type EditPostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type CancelFollowRequestResponse_CancelFollowRequestStatus int32
 
-	PostId           int64   `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	ContentText      *string `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3,oneof" json:"content_text,omitempty"`
-	ContentImagePath *string `protobuf:"bytes,3,opt,name=content_image_path,json=contentImagePath,proto3,oneof" json:"content_image_path,omitempty"`
-	Visible          *bool   `protobuf:"varint,4,opt,name=visible,proto3,oneof" json:"visible,omitempty"`
-}
+const (
+	CancelFollowRequestResponse_OK                CancelFollowRequestResponse_CancelFollowRequestStatus = 0
+	CancelFollowRequestResponse_FORBIDDEN         CancelFollowRequestResponse_CancelFollowRequestStatus = 1
+	CancelFollowRequestResponse_REQUEST_NOT_FOUND CancelFollowRequestResponse_CancelFollowRequestStatus = 2
+)
 
-func (x *EditPostRequest) Reset() {
-	*x = EditPostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for CancelFollowRequestResponse_CancelFollowRequestStatus.
+var (
+	CancelFollowRequestResponse_CancelFollowRequestStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+		2: "REQUEST_NOT_FOUND",
 	}
-}
+	CancelFollowRequestResponse_CancelFollowRequestStatus_value = map[string]int32{
+		"OK":                0,
+		"FORBIDDEN":         1,
+		"REQUEST_NOT_FOUND": 2,
+	}
+)
 
-func (x *EditPostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x CancelFollowRequestResponse_CancelFollowRequestStatus) Enum() *CancelFollowRequestResponse_CancelFollowRequestStatus {
+	p := new(CancelFollowRequestResponse_CancelFollowRequestStatus)
+	*p = x
+	return p
 }
 
-func (*EditPostRequest) ProtoMessage() {}
+func (x CancelFollowRequestResponse_CancelFollowRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *EditPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (CancelFollowRequestResponse_CancelFollowRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[36].Descriptor()
 }
 
-// Deprecated: Use EditPostRequest.ProtoReflect.Descriptor instead.
-func (*EditPostRequest) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{20}
+func (CancelFollowRequestResponse_CancelFollowRequestStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[36]
 }
 
-func (x *EditPostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (x CancelFollowRequestResponse_CancelFollowRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditPostRequest) GetContentText() string {
-	if x != nil && x.ContentText != nil {
-		return *x.ContentText
-	}
-	return ""
+// Deprecated: Use CancelFollowRequestResponse_CancelFollowRequestStatus.Descriptor instead.
+func (CancelFollowRequestResponse_CancelFollowRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{80, 0}
 }
 
-func (x *EditPostRequest) GetContentImagePath() string {
-	if x != nil && x.ContentImagePath != nil {
-		return *x.ContentImagePath
+type GetRelationshipResponse_GetRelationshipStatus int32
+
+const (
+	GetRelationshipResponse_OK             GetRelationshipResponse_GetRelationshipStatus = 0
+	GetRelationshipResponse_FORBIDDEN      GetRelationshipResponse_GetRelationshipStatus = 1
+	GetRelationshipResponse_USER_NOT_FOUND GetRelationshipResponse_GetRelationshipStatus = 2
+)
+
+// Enum value maps for GetRelationshipResponse_GetRelationshipStatus.
+var (
+	GetRelationshipResponse_GetRelationshipStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+		2: "USER_NOT_FOUND",
 	}
-	return ""
+	GetRelationshipResponse_GetRelationshipStatus_value = map[string]int32{
+		"OK":             0,
+		"FORBIDDEN":      1,
+		"USER_NOT_FOUND": 2,
+	}
+)
+
+func (x GetRelationshipResponse_GetRelationshipStatus) Enum() *GetRelationshipResponse_GetRelationshipStatus {
+	p := new(GetRelationshipResponse_GetRelationshipStatus)
+	*p = x
+	return p
 }
 
-func (x *EditPostRequest) GetVisible() bool {
-	if x != nil && x.Visible != nil {
-		return *x.Visible
-	}
-	return false
+func (x GetRelationshipResponse_GetRelationshipStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type EditPostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (GetRelationshipResponse_GetRelationshipStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[37].Descriptor()
+}
 
-	Status EditPostResponse_EditPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.EditPostResponse_EditPostStatus" json:"status,omitempty"`
+func (GetRelationshipResponse_GetRelationshipStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[37]
 }
 
-func (x *EditPostResponse) Reset() {
-	*x = EditPostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x GetRelationshipResponse_GetRelationshipStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditPostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetRelationshipResponse_GetRelationshipStatus.Descriptor instead.
+func (GetRelationshipResponse_GetRelationshipStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{83, 0}
 }
 
-func (*EditPostResponse) ProtoMessage() {}
+type GetRelationshipsResponse_GetRelationshipsStatus int32
 
-func (x *EditPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	GetRelationshipsResponse_OK             GetRelationshipsResponse_GetRelationshipsStatus = 0
+	GetRelationshipsResponse_FORBIDDEN      GetRelationshipsResponse_GetRelationshipsStatus = 1
+	GetRelationshipsResponse_TOO_MANY_USERS GetRelationshipsResponse_GetRelationshipsStatus = 2
+)
+
+// Enum value maps for GetRelationshipsResponse_GetRelationshipsStatus.
+var (
+	GetRelationshipsResponse_GetRelationshipsStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+		2: "TOO_MANY_USERS",
 	}
-	return mi.MessageOf(x)
-}
+	GetRelationshipsResponse_GetRelationshipsStatus_value = map[string]int32{
+		"OK":             0,
+		"FORBIDDEN":      1,
+		"TOO_MANY_USERS": 2,
+	}
+)
 
-// Deprecated: Use EditPostResponse.ProtoReflect.Descriptor instead.
-func (*EditPostResponse) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{21}
+func (x GetRelationshipsResponse_GetRelationshipsStatus) Enum() *GetRelationshipsResponse_GetRelationshipsStatus {
+	p := new(GetRelationshipsResponse_GetRelationshipsStatus)
+	*p = x
+	return p
 }
 
-func (x *EditPostResponse) GetStatus() EditPostResponse_EditPostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return EditPostResponse_OK
+func (x GetRelationshipsResponse_GetRelationshipsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type CommentPostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (GetRelationshipsResponse_GetRelationshipsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[38].Descriptor()
+}
 
-	PostId      int64  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId      int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContentText string `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+func (GetRelationshipsResponse_GetRelationshipsStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[38]
 }
 
-func (x *CommentPostRequest) Reset() {
-	*x = CommentPostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x GetRelationshipsResponse_GetRelationshipsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CommentPostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetRelationshipsResponse_GetRelationshipsStatus.Descriptor instead.
+func (GetRelationshipsResponse_GetRelationshipsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{85, 0}
 }
 
-func (*CommentPostRequest) ProtoMessage() {}
+type FollowEdgeResult_FollowEdgeOutcome int32
 
-func (x *CommentPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	FollowEdgeResult_APPLIED FollowEdgeResult_FollowEdgeOutcome = 0
+	// ALREADY_APPLIED is an edge that already existed on follow, or did
+	// not exist on unfollow, or came twice in the request
+	FollowEdgeResult_ALREADY_APPLIED FollowEdgeResult_FollowEdgeOutcome = 1
+	FollowEdgeResult_USER_NOT_FOUND  FollowEdgeResult_FollowEdgeOutcome = 2
+	FollowEdgeResult_INVALID_EDGE    FollowEdgeResult_FollowEdgeOutcome = 3
+	FollowEdgeResult_BLOCKED         FollowEdgeResult_FollowEdgeOutcome = 4
+	// REQUESTED is a follow of a private account, it waits for approval
+	FollowEdgeResult_REQUESTED FollowEdgeResult_FollowEdgeOutcome = 5
+)
+
+// Enum value maps for FollowEdgeResult_FollowEdgeOutcome.
+var (
+	FollowEdgeResult_FollowEdgeOutcome_name = map[int32]string{
+		0: "APPLIED",
+		1: "ALREADY_APPLIED",
+		2: "USER_NOT_FOUND",
+		3: "INVALID_EDGE",
+		4: "BLOCKED",
+		5: "REQUESTED",
+	}
+	FollowEdgeResult_FollowEdgeOutcome_value = map[string]int32{
+		"APPLIED":         0,
+		"ALREADY_APPLIED": 1,
+		"USER_NOT_FOUND":  2,
+		"INVALID_EDGE":    3,
+		"BLOCKED":         4,
+		"REQUESTED":       5,
 	}
-	return mi.MessageOf(x)
-}
+)
 
-// Deprecated: Use CommentPostRequest.ProtoReflect.Descriptor instead.
-func (*CommentPostRequest) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{22}
+func (x FollowEdgeResult_FollowEdgeOutcome) Enum() *FollowEdgeResult_FollowEdgeOutcome {
+	p := new(FollowEdgeResult_FollowEdgeOutcome)
+	*p = x
+	return p
 }
 
-func (x *CommentPostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (x FollowEdgeResult_FollowEdgeOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CommentPostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (FollowEdgeResult_FollowEdgeOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[39].Descriptor()
 }
 
-func (x *CommentPostRequest) GetContentText() string {
-	if x != nil {
-		return x.ContentText
-	}
-	return ""
+func (FollowEdgeResult_FollowEdgeOutcome) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[39]
 }
 
-type CommentPostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x FollowEdgeResult_FollowEdgeOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	Status    CommentPostResponse_CommentPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.CommentPostResponse_CommentPostStatus" json:"status,omitempty"`
-	CommentId int64                                 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+// Deprecated: Use FollowEdgeResult_FollowEdgeOutcome.Descriptor instead.
+func (FollowEdgeResult_FollowEdgeOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{88, 0}
 }
 
-func (x *CommentPostResponse) Reset() {
-	*x = CommentPostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type BulkFollowResponse_BulkFollowStatus int32
+
+const (
+	BulkFollowResponse_OK             BulkFollowResponse_BulkFollowStatus = 0
+	BulkFollowResponse_FORBIDDEN      BulkFollowResponse_BulkFollowStatus = 1
+	BulkFollowResponse_TOO_MANY_EDGES BulkFollowResponse_BulkFollowStatus = 2
+)
+
+// Enum value maps for BulkFollowResponse_BulkFollowStatus.
+var (
+	BulkFollowResponse_BulkFollowStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+		2: "TOO_MANY_EDGES",
+	}
+	BulkFollowResponse_BulkFollowStatus_value = map[string]int32{
+		"OK":             0,
+		"FORBIDDEN":      1,
+		"TOO_MANY_EDGES": 2,
 	}
+)
+
+func (x BulkFollowResponse_BulkFollowStatus) Enum() *BulkFollowResponse_BulkFollowStatus {
+	p := new(BulkFollowResponse_BulkFollowStatus)
+	*p = x
+	return p
 }
 
-func (x *CommentPostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x BulkFollowResponse_BulkFollowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*CommentPostResponse) ProtoMessage() {}
+func (BulkFollowResponse_BulkFollowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[40].Descriptor()
+}
 
-func (x *CommentPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (BulkFollowResponse_BulkFollowStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[40]
 }
 
-// Deprecated: Use CommentPostResponse.ProtoReflect.Descriptor instead.
-func (*CommentPostResponse) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{23}
+func (x BulkFollowResponse_BulkFollowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CommentPostResponse) GetStatus() CommentPostResponse_CommentPostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CommentPostResponse_OK
+// Deprecated: Use BulkFollowResponse_BulkFollowStatus.Descriptor instead.
+func (BulkFollowResponse_BulkFollowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{89, 0}
 }
 
-func (x *CommentPostResponse) GetCommentId() int64 {
-	if x != nil {
-		return x.CommentId
+type SuggestFollowsResponse_SuggestFollowsStatus int32
+
+const (
+	SuggestFollowsResponse_OK        SuggestFollowsResponse_SuggestFollowsStatus = 0
+	SuggestFollowsResponse_FORBIDDEN SuggestFollowsResponse_SuggestFollowsStatus = 1
+)
+
+// Enum value maps for SuggestFollowsResponse_SuggestFollowsStatus.
+var (
+	SuggestFollowsResponse_SuggestFollowsStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
 	}
-	return 0
+	SuggestFollowsResponse_SuggestFollowsStatus_value = map[string]int32{
+		"OK":        0,
+		"FORBIDDEN": 1,
+	}
+)
+
+func (x SuggestFollowsResponse_SuggestFollowsStatus) Enum() *SuggestFollowsResponse_SuggestFollowsStatus {
+	p := new(SuggestFollowsResponse_SuggestFollowsStatus)
+	*p = x
+	return p
 }
 
-type LikePostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x SuggestFollowsResponse_SuggestFollowsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+func (SuggestFollowsResponse_SuggestFollowsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[41].Descriptor()
 }
 
-func (x *LikePostRequest) Reset() {
-	*x = LikePostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (SuggestFollowsResponse_SuggestFollowsStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[41]
 }
 
-func (x *LikePostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x SuggestFollowsResponse_SuggestFollowsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*LikePostRequest) ProtoMessage() {}
+// Deprecated: Use SuggestFollowsResponse_SuggestFollowsStatus.Descriptor instead.
+func (SuggestFollowsResponse_SuggestFollowsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{92, 0}
+}
 
-func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type BlockUserResponse_BlockUserStatus int32
+
+const (
+	BlockUserResponse_OK              BlockUserResponse_BlockUserStatus = 0
+	BlockUserResponse_USER_NOT_FOUND  BlockUserResponse_BlockUserStatus = 1
+	BlockUserResponse_FORBIDDEN       BlockUserResponse_BlockUserStatus = 2
+	BlockUserResponse_ALREADY_BLOCKED BlockUserResponse_BlockUserStatus = 3
+	BlockUserResponse_INVALID_TARGET  BlockUserResponse_BlockUserStatus = 4
+)
+
+// Enum value maps for BlockUserResponse_BlockUserStatus.
+var (
+	BlockUserResponse_BlockUserStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "FORBIDDEN",
+		3: "ALREADY_BLOCKED",
+		4: "INVALID_TARGET",
 	}
-	return mi.MessageOf(x)
+	BlockUserResponse_BlockUserStatus_value = map[string]int32{
+		"OK":              0,
+		"USER_NOT_FOUND":  1,
+		"FORBIDDEN":       2,
+		"ALREADY_BLOCKED": 3,
+		"INVALID_TARGET":  4,
+	}
+)
+
+func (x BlockUserResponse_BlockUserStatus) Enum() *BlockUserResponse_BlockUserStatus {
+	p := new(BlockUserResponse_BlockUserStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
-func (*LikePostRequest) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{24}
+func (x BlockUserResponse_BlockUserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *LikePostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (BlockUserResponse_BlockUserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[42].Descriptor()
 }
 
-func (x *LikePostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (BlockUserResponse_BlockUserStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[42]
 }
 
-type LikePostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x BlockUserResponse_BlockUserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	Status LikePostResponse_LikePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.LikePostResponse_LikePostStatus" json:"status,omitempty"`
+// Deprecated: Use BlockUserResponse_BlockUserStatus.Descriptor instead.
+func (BlockUserResponse_BlockUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{96, 0}
 }
 
-func (x *LikePostResponse) Reset() {
-	*x = LikePostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type UnblockUserResponse_UnblockUserStatus int32
+
+const (
+	UnblockUserResponse_OK          UnblockUserResponse_UnblockUserStatus = 0
+	UnblockUserResponse_FORBIDDEN   UnblockUserResponse_UnblockUserStatus = 1
+	UnblockUserResponse_NOT_BLOCKED UnblockUserResponse_UnblockUserStatus = 2
+)
+
+// Enum value maps for UnblockUserResponse_UnblockUserStatus.
+var (
+	UnblockUserResponse_UnblockUserStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+		2: "NOT_BLOCKED",
 	}
+	UnblockUserResponse_UnblockUserStatus_value = map[string]int32{
+		"OK":          0,
+		"FORBIDDEN":   1,
+		"NOT_BLOCKED": 2,
+	}
+)
+
+func (x UnblockUserResponse_UnblockUserStatus) Enum() *UnblockUserResponse_UnblockUserStatus {
+	p := new(UnblockUserResponse_UnblockUserStatus)
+	*p = x
+	return p
 }
 
-func (x *LikePostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x UnblockUserResponse_UnblockUserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*LikePostResponse) ProtoMessage() {}
+func (UnblockUserResponse_UnblockUserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[43].Descriptor()
+}
 
-func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (UnblockUserResponse_UnblockUserStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[43]
 }
 
-// Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
-func (*LikePostResponse) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{25}
+func (x UnblockUserResponse_UnblockUserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LikePostResponse) GetStatus() LikePostResponse_LikePostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return LikePostResponse_OK
+// Deprecated: Use UnblockUserResponse_UnblockUserStatus.Descriptor instead.
+func (UnblockUserResponse_UnblockUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{98, 0}
 }
 
-type GetFollowerListResponse_FollowerInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ListBlockedUsersResponse_ListBlockedUsersStatus int32
 
-	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-}
+const (
+	ListBlockedUsersResponse_OK        ListBlockedUsersResponse_ListBlockedUsersStatus = 0
+	ListBlockedUsersResponse_FORBIDDEN ListBlockedUsersResponse_ListBlockedUsersStatus = 1
+)
 
-func (x *GetFollowerListResponse_FollowerInfo) Reset() {
-	*x = GetFollowerListResponse_FollowerInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ListBlockedUsersResponse_ListBlockedUsersStatus.
+var (
+	ListBlockedUsersResponse_ListBlockedUsersStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
 	}
-}
+	ListBlockedUsersResponse_ListBlockedUsersStatus_value = map[string]int32{
+		"OK":        0,
+		"FORBIDDEN": 1,
+	}
+)
 
-func (x *GetFollowerListResponse_FollowerInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ListBlockedUsersResponse_ListBlockedUsersStatus) Enum() *ListBlockedUsersResponse_ListBlockedUsersStatus {
+	p := new(ListBlockedUsersResponse_ListBlockedUsersStatus)
+	*p = x
+	return p
 }
 
-func (*GetFollowerListResponse_FollowerInfo) ProtoMessage() {}
+func (x ListBlockedUsersResponse_ListBlockedUsersStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *GetFollowerListResponse_FollowerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ListBlockedUsersResponse_ListBlockedUsersStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[44].Descriptor()
 }
 
-// Deprecated: Use GetFollowerListResponse_FollowerInfo.ProtoReflect.Descriptor instead.
-func (*GetFollowerListResponse_FollowerInfo) Descriptor() ([]byte, []int) {
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{11, 0}
+func (ListBlockedUsersResponse_ListBlockedUsersStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[44]
 }
 
-func (x *GetFollowerListResponse_FollowerInfo) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x ListBlockedUsersResponse_ListBlockedUsersStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetFollowerListResponse_FollowerInfo) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+// Deprecated: Use ListBlockedUsersResponse_ListBlockedUsersStatus.Descriptor instead.
+func (ListBlockedUsersResponse_ListBlockedUsersStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{100, 0}
 }
 
-var File_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto protoreflect.FileDescriptor
+type MuteUserResponse_MuteUserStatus int32
 
-var file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDesc = []byte{
-	0x0a, 0x44, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
-	0x73, 0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
-	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xeb, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x2c, 0x0a, 0x03, 0x64, 0x6f, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x64, 0x6f, 0x62, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x22, 0xa7, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x31, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
-	0x69, 0x6e, 0x66, 0x6f, 0x22, 0x28, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
-	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x84,
-	0x02, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0d, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x66, 0x69, 0x72,
-	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08,
-	0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x03, 0x64,
-	0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x03, 0x64, 0x6f, 0x62, 0x88, 0x01, 0x01, 0x42, 0x10,
-	0x0a, 0x0e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x06, 0x0a,
-	0x04, 0x5f, 0x64, 0x6f, 0x62, 0x22, 0x2b, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x5b, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0xd5, 0x01, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x48, 0x0a,
-	0x16, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12,
-	0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x53,
-	0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x22, 0x58, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
-	0x6e, 0x67, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x9e, 0x01, 0x0a, 0x12, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x40, 0x0a, 0x0c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52,
-	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
-	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44,
-	0x10, 0x02, 0x22, 0x5a, 0x0a, 0x13, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa2,
-	0x01, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
-	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x3e, 0x0a, 0x0e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a,
-	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
-	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45,
-	0x44, 0x10, 0x02, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbd, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x54, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x1a, 0x44, 0x0a, 0x0c, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x33, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
-	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
-	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x65, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x97, 0x01,
-	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12,
-	0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73,
-	0x74, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12,
-	0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x10, 0x01, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xe2,
-	0x01, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x12,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69,
-	0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73,
-	0x69, 0x62, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
-	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a,
-	0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12,
-	0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x10, 0x01, 0x22, 0x2c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x64, 0x22, 0x90, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x2e, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
-	0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
-	0x4e, 0x44, 0x10, 0x01, 0x22, 0xd8, 0x01, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
-	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52,
-	0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x42, 0x15, 0x0a, 0x13,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22,
-	0x88, 0x01, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
-	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2c, 0x0a, 0x0e,
-	0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
-	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x69, 0x0a, 0x12, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x54, 0x65, 0x78, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x11, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50,
-	0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22,
-	0x43, 0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
-	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f,
-	0x73, 0x74, 0x49, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x40, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55,
-	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
-	0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x10, 0x02, 0x32, 0x8d, 0x08, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x1d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
-	0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x1a, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x4d, 0x0a,
-	0x08, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x10,
-	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x26, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
-	0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
-	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65,
-	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
-	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
-	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
-	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
-	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
-	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a,
-	0x08, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x08,
-	0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0b, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x69, 0x5a, 0x67, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6b, 0x68, 0x61, 0x69, 0x6c, 0x65, 0x71, 0x75, 0x61, 0x6e, 0x67, 0x33, 0x33, 0x34,
-	0x2f, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
-	0x3b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+const (
+	MuteUserResponse_OK             MuteUserResponse_MuteUserStatus = 0
+	MuteUserResponse_USER_NOT_FOUND MuteUserResponse_MuteUserStatus = 1
+	MuteUserResponse_FORBIDDEN      MuteUserResponse_MuteUserStatus = 2
+	MuteUserResponse_ALREADY_MUTED  MuteUserResponse_MuteUserStatus = 3
+	MuteUserResponse_INVALID_TARGET MuteUserResponse_MuteUserStatus = 4
+)
+
+// Enum value maps for MuteUserResponse_MuteUserStatus.
+var (
+	MuteUserResponse_MuteUserStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "FORBIDDEN",
+		3: "ALREADY_MUTED",
+		4: "INVALID_TARGET",
+	}
+	MuteUserResponse_MuteUserStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"FORBIDDEN":      2,
+		"ALREADY_MUTED":  3,
+		"INVALID_TARGET": 4,
+	}
+)
+
+func (x MuteUserResponse_MuteUserStatus) Enum() *MuteUserResponse_MuteUserStatus {
+	p := new(MuteUserResponse_MuteUserStatus)
+	*p = x
+	return p
+}
+
+func (x MuteUserResponse_MuteUserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MuteUserResponse_MuteUserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[45].Descriptor()
+}
+
+func (MuteUserResponse_MuteUserStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[45]
+}
+
+func (x MuteUserResponse_MuteUserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MuteUserResponse_MuteUserStatus.Descriptor instead.
+func (MuteUserResponse_MuteUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{102, 0}
 }
 
+type UnmuteUserResponse_UnmuteUserStatus int32
+
+const (
+	UnmuteUserResponse_OK        UnmuteUserResponse_UnmuteUserStatus = 0
+	UnmuteUserResponse_FORBIDDEN UnmuteUserResponse_UnmuteUserStatus = 1
+	UnmuteUserResponse_NOT_MUTED UnmuteUserResponse_UnmuteUserStatus = 2
+)
+
+// Enum value maps for UnmuteUserResponse_UnmuteUserStatus.
 var (
-	file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescOnce sync.Once
-	file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescData = file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDesc
+	UnmuteUserResponse_UnmuteUserStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+		2: "NOT_MUTED",
+	}
+	UnmuteUserResponse_UnmuteUserStatus_value = map[string]int32{
+		"OK":        0,
+		"FORBIDDEN": 1,
+		"NOT_MUTED": 2,
+	}
 )
 
-func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP() []byte {
-	file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescOnce.Do(func() {
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescData)
-	})
-	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescData
+func (x UnmuteUserResponse_UnmuteUserStatus) Enum() *UnmuteUserResponse_UnmuteUserStatus {
+	p := new(UnmuteUserResponse_UnmuteUserStatus)
+	*p = x
+	return p
 }
 
-var file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_goTypes = []interface{}{
-	(UserResult_UserStatus)(0),                           // 0: user_and_post.UserResult.UserStatus
-	(AuthenticateUserResponse_AuthenticateUserStatus)(0), // 1: user_and_post.AuthenticateUserResponse.AuthenticateUserStatus
-	(FollowUserResponse_FollowStatus)(0),                 // 2: user_and_post.FollowUserResponse.FollowStatus
-	(UnfollowUserResponse_UnfollowStatus)(0),             // 3: user_and_post.UnfollowUserResponse.UnfollowStatus
-	(GetFollowerListResponse_GetFollowerListStatus)(0),   // 4: user_and_post.GetFollowerListResponse.GetFollowerListStatus
-	(CreatePostResponse_CreatePostStatus)(0),             // 5: user_and_post.CreatePostResponse.CreatePostStatus
-	(GetPostResponse_GetPostStatus)(0),                   // 6: user_and_post.GetPostResponse.GetPostStatus
-	(DeletePostResponse_DeletePostStatus)(0),             // 7: user_and_post.DeletePostResponse.DeletePostStatus
-	(EditPostResponse_EditPostStatus)(0),                 // 8: user_and_post.EditPostResponse.EditPostStatus
-	(CommentPostResponse_CommentPostStatus)(0),           // 9: user_and_post.CommentPostResponse.CommentPostStatus
-	(LikePostResponse_LikePostStatus)(0),                 // 10: user_and_post.LikePostResponse.LikePostStatus
-	(*UserDetailInfo)(nil),                               // 11: user_and_post.UserDetailInfo
-	(*UserResult)(nil),                                   // 12: user_and_post.UserResult
-	(*EditUserRequest)(nil),                              // 13: user_and_post.EditUserRequest
-	(*EditUserResponse)(nil),                             // 14: user_and_post.EditUserResponse
-	(*AuthenticateUserRequest)(nil),                      // 15: user_and_post.AuthenticateUserRequest
-	(*AuthenticateUserResponse)(nil),                     // 16: user_and_post.AuthenticateUserResponse
-	(*FollowUserRequest)(nil),                            // 17: user_and_post.FollowUserRequest
-	(*FollowUserResponse)(nil),                           // 18: user_and_post.FollowUserResponse
-	(*UnfollowUserRequest)(nil),                          // 19: user_and_post.UnfollowUserRequest
-	(*UnfollowUserResponse)(nil),                         // 20: user_and_post.UnfollowUserResponse
-	(*GetFollowerListRequest)(nil),                       // 21: user_and_post.GetFollowerListRequest
-	(*GetFollowerListResponse)(nil),                      // 22: user_and_post.GetFollowerListResponse
-	(*UserInfo)(nil),                                     // 23: user_and_post.UserInfo
-	(*CreatePostRequest)(nil),                            // 24: user_and_post.CreatePostRequest
-	(*CreatePostResponse)(nil),                           // 25: user_and_post.CreatePostResponse
-	(*GetPostRequest)(nil),                               // 26: user_and_post.GetPostRequest
-	(*Post)(nil),                                         // 27: user_and_post.Post
-	(*GetPostResponse)(nil),                              // 28: user_and_post.GetPostResponse
-	(*DeletePostRequest)(nil),                            // 29: user_and_post.DeletePostRequest
-	(*DeletePostResponse)(nil),                           // 30: user_and_post.DeletePostResponse
-	(*EditPostRequest)(nil),                              // 31: user_and_post.EditPostRequest
-	(*EditPostResponse)(nil),                             // 32: user_and_post.EditPostResponse
-	(*CommentPostRequest)(nil),                           // 33: user_and_post.CommentPostRequest
-	(*CommentPostResponse)(nil),                          // 34: user_and_post.CommentPostResponse
-	(*LikePostRequest)(nil),                              // 35: user_and_post.LikePostRequest
-	(*LikePostResponse)(nil),                             // 36: user_and_post.LikePostResponse
-	(*GetFollowerListResponse_FollowerInfo)(nil),         // 37: user_and_post.GetFollowerListResponse.FollowerInfo
-	(*timestamp.Timestamp)(nil),                          // 38: google.protobuf.Timestamp
+func (x UnmuteUserResponse_UnmuteUserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
-var file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_depIdxs = []int32{
-	38, // 0: user_and_post.UserDetailInfo.dob:type_name -> google.protobuf.Timestamp
-	0,  // 1: user_and_post.UserResult.status:type_name -> user_and_post.UserResult.UserStatus
-	11, // 2: user_and_post.UserResult.info:type_name -> user_and_post.UserDetailInfo
-	38, // 3: user_and_post.EditUserRequest.dob:type_name -> google.protobuf.Timestamp
-	1,  // 4: user_and_post.AuthenticateUserResponse.status:type_name -> user_and_post.AuthenticateUserResponse.AuthenticateUserStatus
-	2,  // 5: user_and_post.FollowUserResponse.status:type_name -> user_and_post.FollowUserResponse.FollowStatus
-	3,  // 6: user_and_post.UnfollowUserResponse.status:type_name -> user_and_post.UnfollowUserResponse.UnfollowStatus
-	4,  // 7: user_and_post.GetFollowerListResponse.status:type_name -> user_and_post.GetFollowerListResponse.GetFollowerListStatus
-	37, // 8: user_and_post.GetFollowerListResponse.followers:type_name -> user_and_post.GetFollowerListResponse.FollowerInfo
-	5,  // 9: user_and_post.CreatePostResponse.status:type_name -> user_and_post.CreatePostResponse.CreatePostStatus
-	38, // 10: user_and_post.Post.created_time:type_name -> google.protobuf.Timestamp
-	6,  // 11: user_and_post.GetPostResponse.status:type_name -> user_and_post.GetPostResponse.GetPostStatus
-	27, // 12: user_and_post.GetPostResponse.post:type_name -> user_and_post.Post
-	7,  // 13: user_and_post.DeletePostResponse.status:type_name -> user_and_post.DeletePostResponse.DeletePostStatus
-	8,  // 14: user_and_post.EditPostResponse.status:type_name -> user_and_post.EditPostResponse.EditPostStatus
-	9,  // 15: user_and_post.CommentPostResponse.status:type_name -> user_and_post.CommentPostResponse.CommentPostStatus
-	10, // 16: user_and_post.LikePostResponse.status:type_name -> user_and_post.LikePostResponse.LikePostStatus
-	11, // 17: user_and_post.UserAndPost.CreateUser:input_type -> user_and_post.UserDetailInfo
-	13, // 18: user_and_post.UserAndPost.EditUser:input_type -> user_and_post.EditUserRequest
-	15, // 19: user_and_post.UserAndPost.AuthenticateUser:input_type -> user_and_post.AuthenticateUserRequest
-	17, // 20: user_and_post.UserAndPost.FollowUser:input_type -> user_and_post.FollowUserRequest
-	19, // 21: user_and_post.UserAndPost.UnfollowUser:input_type -> user_and_post.UnfollowUserRequest
-	21, // 22: user_and_post.UserAndPost.GetFollowerList:input_type -> user_and_post.GetFollowerListRequest
-	24, // 23: user_and_post.UserAndPost.CreatePost:input_type -> user_and_post.CreatePostRequest
-	26, // 24: user_and_post.UserAndPost.GetPost:input_type -> user_and_post.GetPostRequest
-	29, // 25: user_and_post.UserAndPost.DeletePost:input_type -> user_and_post.DeletePostRequest
-	31, // 26: user_and_post.UserAndPost.EditPost:input_type -> user_and_post.EditPostRequest
-	35, // 27: user_and_post.UserAndPost.LikePost:input_type -> user_and_post.LikePostRequest
-	33, // 28: user_and_post.UserAndPost.CommentPost:input_type -> user_and_post.CommentPostRequest
-	12, // 29: user_and_post.UserAndPost.CreateUser:output_type -> user_and_post.UserResult
-	14, // 30: user_and_post.UserAndPost.EditUser:output_type -> user_and_post.EditUserResponse
-	16, // 31: user_and_post.UserAndPost.AuthenticateUser:output_type -> user_and_post.AuthenticateUserResponse
-	18, // 32: user_and_post.UserAndPost.FollowUser:output_type -> user_and_post.FollowUserResponse
-	20, // 33: user_and_post.UserAndPost.UnfollowUser:output_type -> user_and_post.UnfollowUserResponse
-	22, // 34: user_and_post.UserAndPost.GetFollowerList:output_type -> user_and_post.GetFollowerListResponse
-	25, // 35: user_and_post.UserAndPost.CreatePost:output_type -> user_and_post.CreatePostResponse
-	28, // 36: user_and_post.UserAndPost.GetPost:output_type -> user_and_post.GetPostResponse
-	30, // 37: user_and_post.UserAndPost.DeletePost:output_type -> user_and_post.DeletePostResponse
-	32, // 38: user_and_post.UserAndPost.EditPost:output_type -> user_and_post.EditPostResponse
-	36, // 39: user_and_post.UserAndPost.LikePost:output_type -> user_and_post.LikePostResponse
-	34, // 40: user_and_post.UserAndPost.CommentPost:output_type -> user_and_post.CommentPostResponse
-	29, // [29:41] is the sub-list for method output_type
-	17, // [17:29] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+
+func (UnmuteUserResponse_UnmuteUserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[46].Descriptor()
 }
 
-func init() { file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_init() }
-func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_init() {
-	if File_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto != nil {
-		return
+func (UnmuteUserResponse_UnmuteUserStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[46]
+}
+
+func (x UnmuteUserResponse_UnmuteUserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnmuteUserResponse_UnmuteUserStatus.Descriptor instead.
+func (UnmuteUserResponse_UnmuteUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{104, 0}
+}
+
+type ListMutedUsersResponse_ListMutedUsersStatus int32
+
+const (
+	ListMutedUsersResponse_OK        ListMutedUsersResponse_ListMutedUsersStatus = 0
+	ListMutedUsersResponse_FORBIDDEN ListMutedUsersResponse_ListMutedUsersStatus = 1
+)
+
+// Enum value maps for ListMutedUsersResponse_ListMutedUsersStatus.
+var (
+	ListMutedUsersResponse_ListMutedUsersStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserDetailInfo); i {
+	ListMutedUsersResponse_ListMutedUsersStatus_value = map[string]int32{
+		"OK":        0,
+		"FORBIDDEN": 1,
+	}
+)
+
+func (x ListMutedUsersResponse_ListMutedUsersStatus) Enum() *ListMutedUsersResponse_ListMutedUsersStatus {
+	p := new(ListMutedUsersResponse_ListMutedUsersStatus)
+	*p = x
+	return p
+}
+
+func (x ListMutedUsersResponse_ListMutedUsersStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListMutedUsersResponse_ListMutedUsersStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[47].Descriptor()
+}
+
+func (ListMutedUsersResponse_ListMutedUsersStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[47]
+}
+
+func (x ListMutedUsersResponse_ListMutedUsersStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListMutedUsersResponse_ListMutedUsersStatus.Descriptor instead.
+func (ListMutedUsersResponse_ListMutedUsersStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{106, 0}
+}
+
+type AddCloseFriendResponse_AddCloseFriendStatus int32
+
+const (
+	AddCloseFriendResponse_OK                   AddCloseFriendResponse_AddCloseFriendStatus = 0
+	AddCloseFriendResponse_USER_NOT_FOUND       AddCloseFriendResponse_AddCloseFriendStatus = 1
+	AddCloseFriendResponse_FORBIDDEN            AddCloseFriendResponse_AddCloseFriendStatus = 2
+	AddCloseFriendResponse_ALREADY_CLOSE_FRIEND AddCloseFriendResponse_AddCloseFriendStatus = 3
+	AddCloseFriendResponse_INVALID_TARGET       AddCloseFriendResponse_AddCloseFriendStatus = 4
+	AddCloseFriendResponse_BLOCKED              AddCloseFriendResponse_AddCloseFriendStatus = 5
+)
+
+// Enum value maps for AddCloseFriendResponse_AddCloseFriendStatus.
+var (
+	AddCloseFriendResponse_AddCloseFriendStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "FORBIDDEN",
+		3: "ALREADY_CLOSE_FRIEND",
+		4: "INVALID_TARGET",
+		5: "BLOCKED",
+	}
+	AddCloseFriendResponse_AddCloseFriendStatus_value = map[string]int32{
+		"OK":                   0,
+		"USER_NOT_FOUND":       1,
+		"FORBIDDEN":            2,
+		"ALREADY_CLOSE_FRIEND": 3,
+		"INVALID_TARGET":       4,
+		"BLOCKED":              5,
+	}
+)
+
+func (x AddCloseFriendResponse_AddCloseFriendStatus) Enum() *AddCloseFriendResponse_AddCloseFriendStatus {
+	p := new(AddCloseFriendResponse_AddCloseFriendStatus)
+	*p = x
+	return p
+}
+
+func (x AddCloseFriendResponse_AddCloseFriendStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddCloseFriendResponse_AddCloseFriendStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[48].Descriptor()
+}
+
+func (AddCloseFriendResponse_AddCloseFriendStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[48]
+}
+
+func (x AddCloseFriendResponse_AddCloseFriendStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddCloseFriendResponse_AddCloseFriendStatus.Descriptor instead.
+func (AddCloseFriendResponse_AddCloseFriendStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{108, 0}
+}
+
+type RemoveCloseFriendResponse_RemoveCloseFriendStatus int32
+
+const (
+	RemoveCloseFriendResponse_OK               RemoveCloseFriendResponse_RemoveCloseFriendStatus = 0
+	RemoveCloseFriendResponse_FORBIDDEN        RemoveCloseFriendResponse_RemoveCloseFriendStatus = 1
+	RemoveCloseFriendResponse_NOT_CLOSE_FRIEND RemoveCloseFriendResponse_RemoveCloseFriendStatus = 2
+)
+
+// Enum value maps for RemoveCloseFriendResponse_RemoveCloseFriendStatus.
+var (
+	RemoveCloseFriendResponse_RemoveCloseFriendStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+		2: "NOT_CLOSE_FRIEND",
+	}
+	RemoveCloseFriendResponse_RemoveCloseFriendStatus_value = map[string]int32{
+		"OK":               0,
+		"FORBIDDEN":        1,
+		"NOT_CLOSE_FRIEND": 2,
+	}
+)
+
+func (x RemoveCloseFriendResponse_RemoveCloseFriendStatus) Enum() *RemoveCloseFriendResponse_RemoveCloseFriendStatus {
+	p := new(RemoveCloseFriendResponse_RemoveCloseFriendStatus)
+	*p = x
+	return p
+}
+
+func (x RemoveCloseFriendResponse_RemoveCloseFriendStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RemoveCloseFriendResponse_RemoveCloseFriendStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[49].Descriptor()
+}
+
+func (RemoveCloseFriendResponse_RemoveCloseFriendStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[49]
+}
+
+func (x RemoveCloseFriendResponse_RemoveCloseFriendStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RemoveCloseFriendResponse_RemoveCloseFriendStatus.Descriptor instead.
+func (RemoveCloseFriendResponse_RemoveCloseFriendStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{110, 0}
+}
+
+type ListCloseFriendsResponse_ListCloseFriendsStatus int32
+
+const (
+	ListCloseFriendsResponse_OK        ListCloseFriendsResponse_ListCloseFriendsStatus = 0
+	ListCloseFriendsResponse_FORBIDDEN ListCloseFriendsResponse_ListCloseFriendsStatus = 1
+)
+
+// Enum value maps for ListCloseFriendsResponse_ListCloseFriendsStatus.
+var (
+	ListCloseFriendsResponse_ListCloseFriendsStatus_name = map[int32]string{
+		0: "OK",
+		1: "FORBIDDEN",
+	}
+	ListCloseFriendsResponse_ListCloseFriendsStatus_value = map[string]int32{
+		"OK":        0,
+		"FORBIDDEN": 1,
+	}
+)
+
+func (x ListCloseFriendsResponse_ListCloseFriendsStatus) Enum() *ListCloseFriendsResponse_ListCloseFriendsStatus {
+	p := new(ListCloseFriendsResponse_ListCloseFriendsStatus)
+	*p = x
+	return p
+}
+
+func (x ListCloseFriendsResponse_ListCloseFriendsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListCloseFriendsResponse_ListCloseFriendsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[50].Descriptor()
+}
+
+func (ListCloseFriendsResponse_ListCloseFriendsStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[50]
+}
+
+func (x ListCloseFriendsResponse_ListCloseFriendsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListCloseFriendsResponse_ListCloseFriendsStatus.Descriptor instead.
+func (ListCloseFriendsResponse_ListCloseFriendsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{112, 0}
+}
+
+type CreatePostResponse_CreatePostStatus int32
+
+const (
+	CreatePostResponse_OK             CreatePostResponse_CreatePostStatus = 0
+	CreatePostResponse_USER_NOT_FOUND CreatePostResponse_CreatePostStatus = 1
+	CreatePostResponse_FORBIDDEN      CreatePostResponse_CreatePostStatus = 2
+)
+
+// Enum value maps for CreatePostResponse_CreatePostStatus.
+var (
+	CreatePostResponse_CreatePostStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "FORBIDDEN",
+	}
+	CreatePostResponse_CreatePostStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"FORBIDDEN":      2,
+	}
+)
+
+func (x CreatePostResponse_CreatePostStatus) Enum() *CreatePostResponse_CreatePostStatus {
+	p := new(CreatePostResponse_CreatePostStatus)
+	*p = x
+	return p
+}
+
+func (x CreatePostResponse_CreatePostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreatePostResponse_CreatePostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[51].Descriptor()
+}
+
+func (CreatePostResponse_CreatePostStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[51]
+}
+
+func (x CreatePostResponse_CreatePostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreatePostResponse_CreatePostStatus.Descriptor instead.
+func (CreatePostResponse_CreatePostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{114, 0}
+}
+
+// Audience decides who besides the author may read the post, on top of
+// the blocks and the private flag of the author
+type Post_Audience int32
+
+const (
+	Post_PUBLIC        Post_Audience = 0
+	Post_FOLLOWERS     Post_Audience = 1
+	Post_CLOSE_FRIENDS Post_Audience = 2
+	Post_ONLY_ME       Post_Audience = 3
+)
+
+// Enum value maps for Post_Audience.
+var (
+	Post_Audience_name = map[int32]string{
+		0: "PUBLIC",
+		1: "FOLLOWERS",
+		2: "CLOSE_FRIENDS",
+		3: "ONLY_ME",
+	}
+	Post_Audience_value = map[string]int32{
+		"PUBLIC":        0,
+		"FOLLOWERS":     1,
+		"CLOSE_FRIENDS": 2,
+		"ONLY_ME":       3,
+	}
+)
+
+func (x Post_Audience) Enum() *Post_Audience {
+	p := new(Post_Audience)
+	*p = x
+	return p
+}
+
+func (x Post_Audience) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Post_Audience) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[52].Descriptor()
+}
+
+func (Post_Audience) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[52]
+}
+
+func (x Post_Audience) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Post_Audience.Descriptor instead.
+func (Post_Audience) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{116, 0}
+}
+
+type GetPostResponse_GetPostStatus int32
+
+const (
+	GetPostResponse_OK             GetPostResponse_GetPostStatus = 0
+	GetPostResponse_POST_NOT_FOUND GetPostResponse_GetPostStatus = 1
+)
+
+// Enum value maps for GetPostResponse_GetPostStatus.
+var (
+	GetPostResponse_GetPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+	}
+	GetPostResponse_GetPostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+	}
+)
+
+func (x GetPostResponse_GetPostStatus) Enum() *GetPostResponse_GetPostStatus {
+	p := new(GetPostResponse_GetPostStatus)
+	*p = x
+	return p
+}
+
+func (x GetPostResponse_GetPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetPostResponse_GetPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[53].Descriptor()
+}
+
+func (GetPostResponse_GetPostStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[53]
+}
+
+func (x GetPostResponse_GetPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetPostResponse_GetPostStatus.Descriptor instead.
+func (GetPostResponse_GetPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{117, 0}
+}
+
+type DeletePostResponse_DeletePostStatus int32
+
+const (
+	DeletePostResponse_OK             DeletePostResponse_DeletePostStatus = 0
+	DeletePostResponse_POST_NOT_FOUND DeletePostResponse_DeletePostStatus = 1
+	DeletePostResponse_FORBIDDEN      DeletePostResponse_DeletePostStatus = 2
+)
+
+// Enum value maps for DeletePostResponse_DeletePostStatus.
+var (
+	DeletePostResponse_DeletePostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "FORBIDDEN",
+	}
+	DeletePostResponse_DeletePostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"FORBIDDEN":      2,
+	}
+)
+
+func (x DeletePostResponse_DeletePostStatus) Enum() *DeletePostResponse_DeletePostStatus {
+	p := new(DeletePostResponse_DeletePostStatus)
+	*p = x
+	return p
+}
+
+func (x DeletePostResponse_DeletePostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeletePostResponse_DeletePostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[54].Descriptor()
+}
+
+func (DeletePostResponse_DeletePostStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[54]
+}
+
+func (x DeletePostResponse_DeletePostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeletePostResponse_DeletePostStatus.Descriptor instead.
+func (DeletePostResponse_DeletePostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{119, 0}
+}
+
+type EditPostResponse_EditPostStatus int32
+
+const (
+	EditPostResponse_OK             EditPostResponse_EditPostStatus = 0
+	EditPostResponse_POST_NOT_FOUND EditPostResponse_EditPostStatus = 1
+	EditPostResponse_FORBIDDEN      EditPostResponse_EditPostStatus = 2
+)
+
+// Enum value maps for EditPostResponse_EditPostStatus.
+var (
+	EditPostResponse_EditPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "FORBIDDEN",
+	}
+	EditPostResponse_EditPostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"FORBIDDEN":      2,
+	}
+)
+
+func (x EditPostResponse_EditPostStatus) Enum() *EditPostResponse_EditPostStatus {
+	p := new(EditPostResponse_EditPostStatus)
+	*p = x
+	return p
+}
+
+func (x EditPostResponse_EditPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EditPostResponse_EditPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[55].Descriptor()
+}
+
+func (EditPostResponse_EditPostStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[55]
+}
+
+func (x EditPostResponse_EditPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EditPostResponse_EditPostStatus.Descriptor instead.
+func (EditPostResponse_EditPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{121, 0}
+}
+
+type ListUserPostsResponse_ListUserPostsStatus int32
+
+const (
+	ListUserPostsResponse_OK             ListUserPostsResponse_ListUserPostsStatus = 0
+	ListUserPostsResponse_USER_NOT_FOUND ListUserPostsResponse_ListUserPostsStatus = 1
+	ListUserPostsResponse_INVALID_CURSOR ListUserPostsResponse_ListUserPostsStatus = 2
+	// HIDDEN is a private account the caller does not follow, or a block
+	// between the caller and the user
+	ListUserPostsResponse_HIDDEN ListUserPostsResponse_ListUserPostsStatus = 3
+)
+
+// Enum value maps for ListUserPostsResponse_ListUserPostsStatus.
+var (
+	ListUserPostsResponse_ListUserPostsStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "INVALID_CURSOR",
+		3: "HIDDEN",
+	}
+	ListUserPostsResponse_ListUserPostsStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"INVALID_CURSOR": 2,
+		"HIDDEN":         3,
+	}
+)
+
+func (x ListUserPostsResponse_ListUserPostsStatus) Enum() *ListUserPostsResponse_ListUserPostsStatus {
+	p := new(ListUserPostsResponse_ListUserPostsStatus)
+	*p = x
+	return p
+}
+
+func (x ListUserPostsResponse_ListUserPostsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListUserPostsResponse_ListUserPostsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[56].Descriptor()
+}
+
+func (ListUserPostsResponse_ListUserPostsStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[56]
+}
+
+func (x ListUserPostsResponse_ListUserPostsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListUserPostsResponse_ListUserPostsStatus.Descriptor instead.
+func (ListUserPostsResponse_ListUserPostsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{123, 0}
+}
+
+type PinPostResponse_PinPostStatus int32
+
+const (
+	PinPostResponse_OK              PinPostResponse_PinPostStatus = 0
+	PinPostResponse_POST_NOT_FOUND  PinPostResponse_PinPostStatus = 1
+	PinPostResponse_FORBIDDEN       PinPostResponse_PinPostStatus = 2
+	PinPostResponse_TOO_MANY_PINNED PinPostResponse_PinPostStatus = 3
+)
+
+// Enum value maps for PinPostResponse_PinPostStatus.
+var (
+	PinPostResponse_PinPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "FORBIDDEN",
+		3: "TOO_MANY_PINNED",
+	}
+	PinPostResponse_PinPostStatus_value = map[string]int32{
+		"OK":              0,
+		"POST_NOT_FOUND":  1,
+		"FORBIDDEN":       2,
+		"TOO_MANY_PINNED": 3,
+	}
+)
+
+func (x PinPostResponse_PinPostStatus) Enum() *PinPostResponse_PinPostStatus {
+	p := new(PinPostResponse_PinPostStatus)
+	*p = x
+	return p
+}
+
+func (x PinPostResponse_PinPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PinPostResponse_PinPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[57].Descriptor()
+}
+
+func (PinPostResponse_PinPostStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[57]
+}
+
+func (x PinPostResponse_PinPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PinPostResponse_PinPostStatus.Descriptor instead.
+func (PinPostResponse_PinPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{125, 0}
+}
+
+type UnpinPostResponse_UnpinPostStatus int32
+
+const (
+	UnpinPostResponse_OK             UnpinPostResponse_UnpinPostStatus = 0
+	UnpinPostResponse_POST_NOT_FOUND UnpinPostResponse_UnpinPostStatus = 1
+	UnpinPostResponse_FORBIDDEN      UnpinPostResponse_UnpinPostStatus = 2
+	UnpinPostResponse_NOT_PINNED     UnpinPostResponse_UnpinPostStatus = 3
+)
+
+// Enum value maps for UnpinPostResponse_UnpinPostStatus.
+var (
+	UnpinPostResponse_UnpinPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "FORBIDDEN",
+		3: "NOT_PINNED",
+	}
+	UnpinPostResponse_UnpinPostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"FORBIDDEN":      2,
+		"NOT_PINNED":     3,
+	}
+)
+
+func (x UnpinPostResponse_UnpinPostStatus) Enum() *UnpinPostResponse_UnpinPostStatus {
+	p := new(UnpinPostResponse_UnpinPostStatus)
+	*p = x
+	return p
+}
+
+func (x UnpinPostResponse_UnpinPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnpinPostResponse_UnpinPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[58].Descriptor()
+}
+
+func (UnpinPostResponse_UnpinPostStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[58]
+}
+
+func (x UnpinPostResponse_UnpinPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnpinPostResponse_UnpinPostStatus.Descriptor instead.
+func (UnpinPostResponse_UnpinPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{127, 0}
+}
+
+type ListPostRevisionsResponse_ListPostRevisionsStatus int32
+
+const (
+	ListPostRevisionsResponse_OK             ListPostRevisionsResponse_ListPostRevisionsStatus = 0
+	ListPostRevisionsResponse_POST_NOT_FOUND ListPostRevisionsResponse_ListPostRevisionsStatus = 1
+	ListPostRevisionsResponse_FORBIDDEN      ListPostRevisionsResponse_ListPostRevisionsStatus = 2
+)
+
+// Enum value maps for ListPostRevisionsResponse_ListPostRevisionsStatus.
+var (
+	ListPostRevisionsResponse_ListPostRevisionsStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "FORBIDDEN",
+	}
+	ListPostRevisionsResponse_ListPostRevisionsStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"FORBIDDEN":      2,
+	}
+)
+
+func (x ListPostRevisionsResponse_ListPostRevisionsStatus) Enum() *ListPostRevisionsResponse_ListPostRevisionsStatus {
+	p := new(ListPostRevisionsResponse_ListPostRevisionsStatus)
+	*p = x
+	return p
+}
+
+func (x ListPostRevisionsResponse_ListPostRevisionsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListPostRevisionsResponse_ListPostRevisionsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[59].Descriptor()
+}
+
+func (ListPostRevisionsResponse_ListPostRevisionsStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[59]
+}
+
+func (x ListPostRevisionsResponse_ListPostRevisionsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListPostRevisionsResponse_ListPostRevisionsStatus.Descriptor instead.
+func (ListPostRevisionsResponse_ListPostRevisionsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{130, 0}
+}
+
+type GetPostRevisionResponse_GetPostRevisionStatus int32
+
+const (
+	GetPostRevisionResponse_OK                 GetPostRevisionResponse_GetPostRevisionStatus = 0
+	GetPostRevisionResponse_POST_NOT_FOUND     GetPostRevisionResponse_GetPostRevisionStatus = 1
+	GetPostRevisionResponse_FORBIDDEN          GetPostRevisionResponse_GetPostRevisionStatus = 2
+	GetPostRevisionResponse_REVISION_NOT_FOUND GetPostRevisionResponse_GetPostRevisionStatus = 3
+)
+
+// Enum value maps for GetPostRevisionResponse_GetPostRevisionStatus.
+var (
+	GetPostRevisionResponse_GetPostRevisionStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "FORBIDDEN",
+		3: "REVISION_NOT_FOUND",
+	}
+	GetPostRevisionResponse_GetPostRevisionStatus_value = map[string]int32{
+		"OK":                 0,
+		"POST_NOT_FOUND":     1,
+		"FORBIDDEN":          2,
+		"REVISION_NOT_FOUND": 3,
+	}
+)
+
+func (x GetPostRevisionResponse_GetPostRevisionStatus) Enum() *GetPostRevisionResponse_GetPostRevisionStatus {
+	p := new(GetPostRevisionResponse_GetPostRevisionStatus)
+	*p = x
+	return p
+}
+
+func (x GetPostRevisionResponse_GetPostRevisionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetPostRevisionResponse_GetPostRevisionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[60].Descriptor()
+}
+
+func (GetPostRevisionResponse_GetPostRevisionStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[60]
+}
+
+func (x GetPostRevisionResponse_GetPostRevisionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetPostRevisionResponse_GetPostRevisionStatus.Descriptor instead.
+func (GetPostRevisionResponse_GetPostRevisionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{132, 0}
+}
+
+type CommentPostResponse_CommentPostStatus int32
+
+const (
+	CommentPostResponse_OK             CommentPostResponse_CommentPostStatus = 0
+	CommentPostResponse_USER_NOT_FOUND CommentPostResponse_CommentPostStatus = 1
+	CommentPostResponse_POST_NOT_FOUND CommentPostResponse_CommentPostStatus = 2
+	CommentPostResponse_FORBIDDEN      CommentPostResponse_CommentPostStatus = 3
+	CommentPostResponse_BLOCKED        CommentPostResponse_CommentPostStatus = 4
+)
+
+// Enum value maps for CommentPostResponse_CommentPostStatus.
+var (
+	CommentPostResponse_CommentPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "POST_NOT_FOUND",
+		3: "FORBIDDEN",
+		4: "BLOCKED",
+	}
+	CommentPostResponse_CommentPostStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"POST_NOT_FOUND": 2,
+		"FORBIDDEN":      3,
+		"BLOCKED":        4,
+	}
+)
+
+func (x CommentPostResponse_CommentPostStatus) Enum() *CommentPostResponse_CommentPostStatus {
+	p := new(CommentPostResponse_CommentPostStatus)
+	*p = x
+	return p
+}
+
+func (x CommentPostResponse_CommentPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentPostResponse_CommentPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[61].Descriptor()
+}
+
+func (CommentPostResponse_CommentPostStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[61]
+}
+
+func (x CommentPostResponse_CommentPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentPostResponse_CommentPostStatus.Descriptor instead.
+func (CommentPostResponse_CommentPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{134, 0}
+}
+
+type LikePostResponse_LikePostStatus int32
+
+const (
+	LikePostResponse_OK             LikePostResponse_LikePostStatus = 0
+	LikePostResponse_USER_NOT_FOUND LikePostResponse_LikePostStatus = 1
+	LikePostResponse_POST_NOT_FOUND LikePostResponse_LikePostStatus = 2
+	LikePostResponse_FORBIDDEN      LikePostResponse_LikePostStatus = 3
+	LikePostResponse_BLOCKED        LikePostResponse_LikePostStatus = 4
+)
+
+// Enum value maps for LikePostResponse_LikePostStatus.
+var (
+	LikePostResponse_LikePostStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "POST_NOT_FOUND",
+		3: "FORBIDDEN",
+		4: "BLOCKED",
+	}
+	LikePostResponse_LikePostStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"POST_NOT_FOUND": 2,
+		"FORBIDDEN":      3,
+		"BLOCKED":        4,
+	}
+)
+
+func (x LikePostResponse_LikePostStatus) Enum() *LikePostResponse_LikePostStatus {
+	p := new(LikePostResponse_LikePostStatus)
+	*p = x
+	return p
+}
+
+func (x LikePostResponse_LikePostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LikePostResponse_LikePostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[62].Descriptor()
+}
+
+func (LikePostResponse_LikePostStatus) Type() protoreflect.EnumType {
+	return &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes[62]
+}
+
+func (x LikePostResponse_LikePostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LikePostResponse_LikePostStatus.Descriptor instead.
+func (LikePostResponse_LikePostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{136, 0}
+}
+
+// Users handler
+type UserDetailInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName     string               `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserPassword string               `protobuf:"bytes,3,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
+	FirstName    string               `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName     string               `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Dob          *timestamp.Timestamp `protobuf:"bytes,6,opt,name=dob,proto3" json:"dob,omitempty"`
+	Email        string               `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *UserDetailInfo) Reset() {
+	*x = UserDetailInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDetailInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDetailInfo) ProtoMessage() {}
+
+func (x *UserDetailInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDetailInfo.ProtoReflect.Descriptor instead.
+func (*UserDetailInfo) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserDetailInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserDetailInfo) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetUserPassword() string {
+	if x != nil {
+		return x.UserPassword
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetDob() *timestamp.Timestamp {
+	if x != nil {
+		return x.Dob
+	}
+	return nil
+}
+
+func (x *UserDetailInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type FieldError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field   string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *FieldError) Reset() {
+	*x = FieldError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldError) ProtoMessage() {}
+
+func (x *FieldError) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldError.ProtoReflect.Descriptor instead.
+func (*FieldError) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FieldError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UserResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      UserResult_UserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.UserResult_UserStatus" json:"status,omitempty"`
+	Info        *UserDetailInfo       `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	FieldErrors []*FieldError         `protobuf:"bytes,3,rep,name=field_errors,json=fieldErrors,proto3" json:"field_errors,omitempty"`
+}
+
+func (x *UserResult) Reset() {
+	*x = UserResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserResult) ProtoMessage() {}
+
+func (x *UserResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserResult.ProtoReflect.Descriptor instead.
+func (*UserResult) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UserResult) GetStatus() UserResult_UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserResult_OK
+}
+
+func (x *UserResult) GetInfo() *UserDetailInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *UserResult) GetFieldErrors() []*FieldError {
+	if x != nil {
+		return x.FieldErrors
+	}
+	return nil
+}
+
+type EditUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId             int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserPassword       *string              `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3,oneof" json:"user_password,omitempty"`
+	FirstName          *string              `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
+	LastName           *string              `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	Dob                *timestamp.Timestamp `protobuf:"bytes,5,opt,name=dob,proto3,oneof" json:"dob,omitempty"`
+	Bio                *string              `protobuf:"bytes,6,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	AvatarPath         *string              `protobuf:"bytes,7,opt,name=avatar_path,json=avatarPath,proto3,oneof" json:"avatar_path,omitempty"`
+	Location           *string              `protobuf:"bytes,8,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Website            *string              `protobuf:"bytes,9,opt,name=website,proto3,oneof" json:"website,omitempty"`
+	Pronouns           *string              `protobuf:"bytes,10,opt,name=pronouns,proto3,oneof" json:"pronouns,omitempty"`
+	BioVisibility      *Visibility          `protobuf:"varint,11,opt,name=bio_visibility,json=bioVisibility,proto3,enum=user_and_post.Visibility,oneof" json:"bio_visibility,omitempty"`
+	AvatarVisibility   *Visibility          `protobuf:"varint,12,opt,name=avatar_visibility,json=avatarVisibility,proto3,enum=user_and_post.Visibility,oneof" json:"avatar_visibility,omitempty"`
+	LocationVisibility *Visibility          `protobuf:"varint,13,opt,name=location_visibility,json=locationVisibility,proto3,enum=user_and_post.Visibility,oneof" json:"location_visibility,omitempty"`
+	WebsiteVisibility  *Visibility          `protobuf:"varint,14,opt,name=website_visibility,json=websiteVisibility,proto3,enum=user_and_post.Visibility,oneof" json:"website_visibility,omitempty"`
+	PronounsVisibility *Visibility          `protobuf:"varint,15,opt,name=pronouns_visibility,json=pronounsVisibility,proto3,enum=user_and_post.Visibility,oneof" json:"pronouns_visibility,omitempty"`
+	// switching a private account to public approves its pending follow requests
+	Private *bool `protobuf:"varint,16,opt,name=private,proto3,oneof" json:"private,omitempty"`
+}
+
+func (x *EditUserRequest) Reset() {
+	*x = EditUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditUserRequest) ProtoMessage() {}
+
+func (x *EditUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditUserRequest.ProtoReflect.Descriptor instead.
+func (*EditUserRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EditUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditUserRequest) GetUserPassword() string {
+	if x != nil && x.UserPassword != nil {
+		return *x.UserPassword
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetDob() *timestamp.Timestamp {
+	if x != nil {
+		return x.Dob
+	}
+	return nil
+}
+
+func (x *EditUserRequest) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetAvatarPath() string {
+	if x != nil && x.AvatarPath != nil {
+		return *x.AvatarPath
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetWebsite() string {
+	if x != nil && x.Website != nil {
+		return *x.Website
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetPronouns() string {
+	if x != nil && x.Pronouns != nil {
+		return *x.Pronouns
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetBioVisibility() Visibility {
+	if x != nil && x.BioVisibility != nil {
+		return *x.BioVisibility
+	}
+	return Visibility_PUBLIC
+}
+
+func (x *EditUserRequest) GetAvatarVisibility() Visibility {
+	if x != nil && x.AvatarVisibility != nil {
+		return *x.AvatarVisibility
+	}
+	return Visibility_PUBLIC
+}
+
+func (x *EditUserRequest) GetLocationVisibility() Visibility {
+	if x != nil && x.LocationVisibility != nil {
+		return *x.LocationVisibility
+	}
+	return Visibility_PUBLIC
+}
+
+func (x *EditUserRequest) GetWebsiteVisibility() Visibility {
+	if x != nil && x.WebsiteVisibility != nil {
+		return *x.WebsiteVisibility
+	}
+	return Visibility_PUBLIC
+}
+
+func (x *EditUserRequest) GetPronounsVisibility() Visibility {
+	if x != nil && x.PronounsVisibility != nil {
+		return *x.PronounsVisibility
+	}
+	return Visibility_PUBLIC
+}
+
+func (x *EditUserRequest) GetPrivate() bool {
+	if x != nil && x.Private != nil {
+		return *x.Private
+	}
+	return false
+}
+
+type EditUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64                           `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status      EditUserResponse_EditUserStatus `protobuf:"varint,2,opt,name=status,proto3,enum=user_and_post.EditUserResponse_EditUserStatus" json:"status,omitempty"`
+	FieldErrors []*FieldError                   `protobuf:"bytes,3,rep,name=field_errors,json=fieldErrors,proto3" json:"field_errors,omitempty"`
+}
+
+func (x *EditUserResponse) Reset() {
+	*x = EditUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditUserResponse) ProtoMessage() {}
+
+func (x *EditUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditUserResponse.ProtoReflect.Descriptor instead.
+func (*EditUserResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EditUserResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditUserResponse) GetStatus() EditUserResponse_EditUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EditUserResponse_OK
+}
+
+func (x *EditUserResponse) GetFieldErrors() []*FieldError {
+	if x != nil {
+		return x.FieldErrors
+	}
+	return nil
+}
+
+type ChangeUserNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+}
+
+func (x *ChangeUserNameRequest) Reset() {
+	*x = ChangeUserNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeUserNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserNameRequest) ProtoMessage() {}
+
+func (x *ChangeUserNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserNameRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserNameRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChangeUserNameRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChangeUserNameRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type ChangeUserNameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      ChangeUserNameResponse_ChangeUserNameStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ChangeUserNameResponse_ChangeUserNameStatus" json:"status,omitempty"`
+	FieldErrors []*FieldError                               `protobuf:"bytes,2,rep,name=field_errors,json=fieldErrors,proto3" json:"field_errors,omitempty"`
+	// retry_after_seconds is set with TOO_SOON, until the cooldown is over
+	RetryAfterSeconds int64 `protobuf:"varint,3,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"`
+}
+
+func (x *ChangeUserNameResponse) Reset() {
+	*x = ChangeUserNameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeUserNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserNameResponse) ProtoMessage() {}
+
+func (x *ChangeUserNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserNameResponse.ProtoReflect.Descriptor instead.
+func (*ChangeUserNameResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChangeUserNameResponse) GetStatus() ChangeUserNameResponse_ChangeUserNameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ChangeUserNameResponse_OK
+}
+
+func (x *ChangeUserNameResponse) GetFieldErrors() []*FieldError {
+	if x != nil {
+		return x.FieldErrors
+	}
+	return nil
+}
+
+func (x *ChangeUserNameResponse) GetRetryAfterSeconds() int64 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+type AuthenticateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName     string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserPassword string `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
+	ClientIp     string `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+}
+
+func (x *AuthenticateUserRequest) Reset() {
+	*x = AuthenticateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateUserRequest) ProtoMessage() {}
+
+func (x *AuthenticateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateUserRequest.ProtoReflect.Descriptor instead.
+func (*AuthenticateUserRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AuthenticateUserRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *AuthenticateUserRequest) GetUserPassword() string {
+	if x != nil {
+		return x.UserPassword
+	}
+	return ""
+}
+
+func (x *AuthenticateUserRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+type AuthenticateUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status            AuthenticateUserResponse_AuthenticateUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.AuthenticateUserResponse_AuthenticateUserStatus" json:"status,omitempty"`
+	UserId            int64                                           `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RetryAfterSeconds int64                                           `protobuf:"varint,3,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"`
+	// set with SECOND_FACTOR_REQUIRED, pass it to VerifySecondFactor
+	ChallengeId string `protobuf:"bytes,4,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+}
+
+func (x *AuthenticateUserResponse) Reset() {
+	*x = AuthenticateUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateUserResponse) ProtoMessage() {}
+
+func (x *AuthenticateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateUserResponse.ProtoReflect.Descriptor instead.
+func (*AuthenticateUserResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AuthenticateUserResponse) GetStatus() AuthenticateUserResponse_AuthenticateUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AuthenticateUserResponse_OK
+}
+
+func (x *AuthenticateUserResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AuthenticateUserResponse) GetRetryAfterSeconds() int64 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+func (x *AuthenticateUserResponse) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+// Two-factor authentication handler
+type EnrollSecondFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *EnrollSecondFactorRequest) Reset() {
+	*x = EnrollSecondFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollSecondFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollSecondFactorRequest) ProtoMessage() {}
+
+func (x *EnrollSecondFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollSecondFactorRequest.ProtoReflect.Descriptor instead.
+func (*EnrollSecondFactorRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EnrollSecondFactorRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type EnrollSecondFactorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     EnrollSecondFactorResponse_EnrollSecondFactorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.EnrollSecondFactorResponse_EnrollSecondFactorStatus" json:"status,omitempty"`
+	Secret     string                                              `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri string                                              `protobuf:"bytes,3,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+}
+
+func (x *EnrollSecondFactorResponse) Reset() {
+	*x = EnrollSecondFactorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollSecondFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollSecondFactorResponse) ProtoMessage() {}
+
+func (x *EnrollSecondFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollSecondFactorResponse.ProtoReflect.Descriptor instead.
+func (*EnrollSecondFactorResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EnrollSecondFactorResponse) GetStatus() EnrollSecondFactorResponse_EnrollSecondFactorStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnrollSecondFactorResponse_OK
+}
+
+func (x *EnrollSecondFactorResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollSecondFactorResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+type ConfirmSecondFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *ConfirmSecondFactorRequest) Reset() {
+	*x = ConfirmSecondFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmSecondFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmSecondFactorRequest) ProtoMessage() {}
+
+func (x *ConfirmSecondFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmSecondFactorRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmSecondFactorRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConfirmSecondFactorRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ConfirmSecondFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmSecondFactorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        ConfirmSecondFactorResponse_ConfirmSecondFactorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ConfirmSecondFactorResponse_ConfirmSecondFactorStatus" json:"status,omitempty"`
+	RecoveryCodes []string                                              `protobuf:"bytes,2,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+}
+
+func (x *ConfirmSecondFactorResponse) Reset() {
+	*x = ConfirmSecondFactorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmSecondFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmSecondFactorResponse) ProtoMessage() {}
+
+func (x *ConfirmSecondFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmSecondFactorResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmSecondFactorResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConfirmSecondFactorResponse) GetStatus() ConfirmSecondFactorResponse_ConfirmSecondFactorStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ConfirmSecondFactorResponse_OK
+}
+
+func (x *ConfirmSecondFactorResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type GenerateRecoveryCodesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GenerateRecoveryCodesRequest) Reset() {
+	*x = GenerateRecoveryCodesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *GenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GenerateRecoveryCodesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GenerateRecoveryCodesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus" json:"status,omitempty"`
+	RecoveryCodes []string                                                  `protobuf:"bytes,2,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+}
+
+func (x *GenerateRecoveryCodesResponse) Reset() {
+	*x = GenerateRecoveryCodesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateRecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRecoveryCodesResponse) ProtoMessage() {}
+
+func (x *GenerateRecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*GenerateRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GenerateRecoveryCodesResponse) GetStatus() GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GenerateRecoveryCodesResponse_OK
+}
+
+func (x *GenerateRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type VerifySecondFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChallengeId string `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// either a TOTP code or one of the recovery codes
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	ClientIp string `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+}
+
+func (x *VerifySecondFactorRequest) Reset() {
+	*x = VerifySecondFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifySecondFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySecondFactorRequest) ProtoMessage() {}
+
+func (x *VerifySecondFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySecondFactorRequest.ProtoReflect.Descriptor instead.
+func (*VerifySecondFactorRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VerifySecondFactorRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *VerifySecondFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifySecondFactorRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+type VerifySecondFactorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status            VerifySecondFactorResponse_VerifySecondFactorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.VerifySecondFactorResponse_VerifySecondFactorStatus" json:"status,omitempty"`
+	UserId            int64                                               `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RetryAfterSeconds int64                                               `protobuf:"varint,3,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"`
+}
+
+func (x *VerifySecondFactorResponse) Reset() {
+	*x = VerifySecondFactorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifySecondFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySecondFactorResponse) ProtoMessage() {}
+
+func (x *VerifySecondFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySecondFactorResponse.ProtoReflect.Descriptor instead.
+func (*VerifySecondFactorResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VerifySecondFactorResponse) GetStatus() VerifySecondFactorResponse_VerifySecondFactorStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VerifySecondFactorResponse_OK
+}
+
+func (x *VerifySecondFactorResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VerifySecondFactorResponse) GetRetryAfterSeconds() int64 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+// Email verification and password reset handler
+type RequestEmailVerificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RequestEmailVerificationRequest) Reset() {
+	*x = RequestEmailVerificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestEmailVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailVerificationRequest) ProtoMessage() {}
+
+func (x *RequestEmailVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailVerificationRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RequestEmailVerificationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RequestEmailVerificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RequestEmailVerificationResponse_RequestEmailVerificationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.RequestEmailVerificationResponse_RequestEmailVerificationStatus" json:"status,omitempty"`
+}
+
+func (x *RequestEmailVerificationResponse) Reset() {
+	*x = RequestEmailVerificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestEmailVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailVerificationResponse) ProtoMessage() {}
+
+func (x *RequestEmailVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailVerificationResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmailVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RequestEmailVerificationResponse) GetStatus() RequestEmailVerificationResponse_RequestEmailVerificationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RequestEmailVerificationResponse_OK
+}
+
+type VerifyEmailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status VerifyEmailResponse_VerifyEmailStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.VerifyEmailResponse_VerifyEmailStatus" json:"status,omitempty"`
+	UserId int64                                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *VerifyEmailResponse) GetStatus() VerifyEmailResponse_VerifyEmailStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VerifyEmailResponse_OK
+}
+
+func (x *VerifyEmailResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// RequestPasswordReset always answers OK so it can not be used to probe emails
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RequestPasswordResetResponse_RequestPasswordResetStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.RequestPasswordResetResponse_RequestPasswordResetStatus" json:"status,omitempty"`
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RequestPasswordResetResponse) GetStatus() RequestPasswordResetResponse_RequestPasswordResetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RequestPasswordResetResponse_OK
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token       string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      ResetPasswordResponse_ResetPasswordStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ResetPasswordResponse_ResetPasswordStatus" json:"status,omitempty"`
+	UserId      int64                                     `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FieldErrors []*FieldError                             `protobuf:"bytes,3,rep,name=field_errors,json=fieldErrors,proto3" json:"field_errors,omitempty"`
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResetPasswordResponse) GetStatus() ResetPasswordResponse_ResetPasswordStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResetPasswordResponse_OK
+}
+
+func (x *ResetPasswordResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ResetPasswordResponse) GetFieldErrors() []*FieldError {
+	if x != nil {
+		return x.FieldErrors
+	}
+	return nil
+}
+
+// External identity handler, the web server verified the ID token before calling
+type LoginWithIdentityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issuer            string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Subject           string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email             string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	EmailVerified     bool   `protobuf:"varint,4,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	PreferredUserName string `protobuf:"bytes,5,opt,name=preferred_user_name,json=preferredUserName,proto3" json:"preferred_user_name,omitempty"`
+	FirstName         string `protobuf:"bytes,6,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName          string `protobuf:"bytes,7,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+}
+
+func (x *LoginWithIdentityRequest) Reset() {
+	*x = LoginWithIdentityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginWithIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithIdentityRequest) ProtoMessage() {}
+
+func (x *LoginWithIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LoginWithIdentityRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *LoginWithIdentityRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *LoginWithIdentityRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginWithIdentityRequest) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *LoginWithIdentityRequest) GetPreferredUserName() string {
+	if x != nil {
+		return x.PreferredUserName
+	}
+	return ""
+}
+
+func (x *LoginWithIdentityRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *LoginWithIdentityRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+type LoginWithIdentityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status LoginWithIdentityResponse_LoginWithIdentityStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.LoginWithIdentityResponse_LoginWithIdentityStatus" json:"status,omitempty"`
+	UserId int64                                             `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// created is true when the account was provisioned by this login
+	Created     bool   `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	ChallengeId string `protobuf:"bytes,4,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+}
+
+func (x *LoginWithIdentityResponse) Reset() {
+	*x = LoginWithIdentityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginWithIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithIdentityResponse) ProtoMessage() {}
+
+func (x *LoginWithIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithIdentityResponse.ProtoReflect.Descriptor instead.
+func (*LoginWithIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LoginWithIdentityResponse) GetStatus() LoginWithIdentityResponse_LoginWithIdentityStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LoginWithIdentityResponse_OK
+}
+
+func (x *LoginWithIdentityResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LoginWithIdentityResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *LoginWithIdentityResponse) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+type LinkIdentityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Issuer  string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email   string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *LinkIdentityRequest) Reset() {
+	*x = LinkIdentityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityRequest) ProtoMessage() {}
+
+func (x *LinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LinkIdentityRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LinkIdentityRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *LinkIdentityRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *LinkIdentityRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type LinkIdentityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status LinkIdentityResponse_LinkIdentityStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.LinkIdentityResponse_LinkIdentityStatus" json:"status,omitempty"`
+}
+
+func (x *LinkIdentityResponse) Reset() {
+	*x = LinkIdentityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityResponse) ProtoMessage() {}
+
+func (x *LinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*LinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LinkIdentityResponse) GetStatus() LinkIdentityResponse_LinkIdentityStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LinkIdentityResponse_OK
+}
+
+type UnlinkIdentityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IdentityId int64 `protobuf:"varint,2,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+}
+
+func (x *UnlinkIdentityRequest) Reset() {
+	*x = UnlinkIdentityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityRequest) ProtoMessage() {}
+
+func (x *UnlinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnlinkIdentityRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnlinkIdentityRequest) GetIdentityId() int64 {
+	if x != nil {
+		return x.IdentityId
+	}
+	return 0
+}
+
+type UnlinkIdentityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnlinkIdentityResponse_UnlinkIdentityStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.UnlinkIdentityResponse_UnlinkIdentityStatus" json:"status,omitempty"`
+}
+
+func (x *UnlinkIdentityResponse) Reset() {
+	*x = UnlinkIdentityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityResponse) ProtoMessage() {}
+
+func (x *UnlinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UnlinkIdentityResponse) GetStatus() UnlinkIdentityResponse_UnlinkIdentityStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnlinkIdentityResponse_OK
+}
+
+type ListIdentitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ListIdentitiesRequest) Reset() {
+	*x = ListIdentitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIdentitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesRequest) ProtoMessage() {}
+
+func (x *ListIdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListIdentitiesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type IdentityInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdentityId int64                `protobuf:"varint,1,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+	Issuer     string               `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Subject    string               `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email      string               `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	LinkedTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=linked_time,json=linkedTime,proto3" json:"linked_time,omitempty"`
+}
+
+func (x *IdentityInfo) Reset() {
+	*x = IdentityInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentityInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityInfo) ProtoMessage() {}
+
+func (x *IdentityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityInfo.ProtoReflect.Descriptor instead.
+func (*IdentityInfo) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *IdentityInfo) GetIdentityId() int64 {
+	if x != nil {
+		return x.IdentityId
+	}
+	return 0
+}
+
+func (x *IdentityInfo) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *IdentityInfo) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *IdentityInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *IdentityInfo) GetLinkedTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LinkedTime
+	}
+	return nil
+}
+
+type ListIdentitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     ListIdentitiesResponse_ListIdentitiesStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ListIdentitiesResponse_ListIdentitiesStatus" json:"status,omitempty"`
+	Identities []*IdentityInfo                             `protobuf:"bytes,2,rep,name=identities,proto3" json:"identities,omitempty"`
+}
+
+func (x *ListIdentitiesResponse) Reset() {
+	*x = ListIdentitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesResponse) ProtoMessage() {}
+
+func (x *ListIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListIdentitiesResponse) GetStatus() ListIdentitiesResponse_ListIdentitiesStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListIdentitiesResponse_OK
+}
+
+func (x *ListIdentitiesResponse) GetIdentities() []*IdentityInfo {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+// Account deletion handler
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteAccountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DeleteAccountResponse_DeleteAccountStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.DeleteAccountResponse_DeleteAccountStatus" json:"status,omitempty"`
+	// purge_time is when the account stops being restorable by logging in
+	PurgeTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=purge_time,json=purgeTime,proto3" json:"purge_time,omitempty"`
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteAccountResponse) GetStatus() DeleteAccountResponse_DeleteAccountStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeleteAccountResponse_OK
+}
+
+func (x *DeleteAccountResponse) GetPurgeTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.PurgeTime
+	}
+	return nil
+}
+
+// Data export handler
+type RequestDataExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RequestDataExportRequest) Reset() {
+	*x = RequestDataExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportRequest) ProtoMessage() {}
+
+func (x *RequestDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataExportRequest.ProtoReflect.Descriptor instead.
+func (*RequestDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RequestDataExportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RequestDataExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RequestDataExportResponse_RequestDataExportStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.RequestDataExportResponse_RequestDataExportStatus" json:"status,omitempty"`
+	// export_id is the export already in progress, if there is one
+	ExportId int64 `protobuf:"varint,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+}
+
+func (x *RequestDataExportResponse) Reset() {
+	*x = RequestDataExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportResponse) ProtoMessage() {}
+
+func (x *RequestDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataExportResponse.ProtoReflect.Descriptor instead.
+func (*RequestDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RequestDataExportResponse) GetStatus() RequestDataExportResponse_RequestDataExportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RequestDataExportResponse_OK
+}
+
+func (x *RequestDataExportResponse) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+type DataExportInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExportId      int64                          `protobuf:"varint,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	State         DataExportInfo_DataExportState `protobuf:"varint,2,opt,name=state,proto3,enum=user_and_post.DataExportInfo_DataExportState" json:"state,omitempty"`
+	CreatedTime   *timestamp.Timestamp           `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	CompletedTime *timestamp.Timestamp           `protobuf:"bytes,4,opt,name=completed_time,json=completedTime,proto3" json:"completed_time,omitempty"`
+	ExpiresTime   *timestamp.Timestamp           `protobuf:"bytes,5,opt,name=expires_time,json=expiresTime,proto3" json:"expires_time,omitempty"`
+	SizeBytes     int64                          `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *DataExportInfo) Reset() {
+	*x = DataExportInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataExportInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExportInfo) ProtoMessage() {}
+
+func (x *DataExportInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExportInfo.ProtoReflect.Descriptor instead.
+func (*DataExportInfo) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DataExportInfo) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+func (x *DataExportInfo) GetState() DataExportInfo_DataExportState {
+	if x != nil {
+		return x.State
+	}
+	return DataExportInfo_PENDING
+}
+
+func (x *DataExportInfo) GetCreatedTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *DataExportInfo) GetCompletedTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CompletedTime
+	}
+	return nil
+}
+
+func (x *DataExportInfo) GetExpiresTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresTime
+	}
+	return nil
+}
+
+func (x *DataExportInfo) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type GetDataExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExportId int64 `protobuf:"varint,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+}
+
+func (x *GetDataExportRequest) Reset() {
+	*x = GetDataExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportRequest) ProtoMessage() {}
+
+func (x *GetDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetDataExportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetDataExportRequest) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+type GetDataExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetDataExportResponse_GetDataExportStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.GetDataExportResponse_GetDataExportStatus" json:"status,omitempty"`
+	Export *DataExportInfo                           `protobuf:"bytes,2,opt,name=export,proto3" json:"export,omitempty"`
+}
+
+func (x *GetDataExportResponse) Reset() {
+	*x = GetDataExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportResponse) ProtoMessage() {}
+
+func (x *GetDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetDataExportResponse) GetStatus() GetDataExportResponse_GetDataExportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetDataExportResponse_OK
+}
+
+func (x *GetDataExportResponse) GetExport() *DataExportInfo {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type DownloadDataExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExportId int64 `protobuf:"varint,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+}
+
+func (x *DownloadDataExportRequest) Reset() {
+	*x = DownloadDataExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDataExportRequest) ProtoMessage() {}
+
+func (x *DownloadDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDataExportRequest.ProtoReflect.Descriptor instead.
+func (*DownloadDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DownloadDataExportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DownloadDataExportRequest) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+// DownloadDataExportResponse is streamed, the first message carries the
+// status and the following ones the archive in chunks
+type DownloadDataExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DownloadDataExportResponse_DownloadDataExportStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.DownloadDataExportResponse_DownloadDataExportStatus" json:"status,omitempty"`
+	Chunk  []byte                                              `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *DownloadDataExportResponse) Reset() {
+	*x = DownloadDataExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDataExportResponse) ProtoMessage() {}
+
+func (x *DownloadDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDataExportResponse.ProtoReflect.Descriptor instead.
+func (*DownloadDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DownloadDataExportResponse) GetStatus() DownloadDataExportResponse_DownloadDataExportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DownloadDataExportResponse_OK
+}
+
+func (x *DownloadDataExportResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// Account import handler
+type ImportAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// archive is a takeout archive as produced by DownloadDataExport
+	Archive []byte `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	// dry_run performs the import and rolls it back, only the report is kept
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ImportAccountRequest) Reset() {
+	*x = ImportAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAccountRequest) ProtoMessage() {}
+
+func (x *ImportAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAccountRequest.ProtoReflect.Descriptor instead.
+func (*ImportAccountRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ImportAccountRequest) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ImportAccountRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostsImported     int64 `protobuf:"varint,1,opt,name=posts_imported,json=postsImported,proto3" json:"posts_imported,omitempty"`
+	CommentsImported  int64 `protobuf:"varint,2,opt,name=comments_imported,json=commentsImported,proto3" json:"comments_imported,omitempty"`
+	LikesImported     int64 `protobuf:"varint,3,opt,name=likes_imported,json=likesImported,proto3" json:"likes_imported,omitempty"`
+	FollowersImported int64 `protobuf:"varint,4,opt,name=followers_imported,json=followersImported,proto3" json:"followers_imported,omitempty"`
+	FollowingImported int64 `protobuf:"varint,5,opt,name=following_imported,json=followingImported,proto3" json:"following_imported,omitempty"`
+	// skipped explains every archive entry that was not imported
+	Skipped []string `protobuf:"bytes,6,rep,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *ImportReport) Reset() {
+	*x = ImportReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReport) ProtoMessage() {}
+
+func (x *ImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReport.ProtoReflect.Descriptor instead.
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ImportReport) GetPostsImported() int64 {
+	if x != nil {
+		return x.PostsImported
+	}
+	return 0
+}
+
+func (x *ImportReport) GetCommentsImported() int64 {
+	if x != nil {
+		return x.CommentsImported
+	}
+	return 0
+}
+
+func (x *ImportReport) GetLikesImported() int64 {
+	if x != nil {
+		return x.LikesImported
+	}
+	return 0
+}
+
+func (x *ImportReport) GetFollowersImported() int64 {
+	if x != nil {
+		return x.FollowersImported
+	}
+	return 0
+}
+
+func (x *ImportReport) GetFollowingImported() int64 {
+	if x != nil {
+		return x.FollowingImported
+	}
+	return 0
+}
+
+func (x *ImportReport) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type ImportAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status ImportAccountResponse_ImportAccountStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ImportAccountResponse_ImportAccountStatus" json:"status,omitempty"`
+	// user_id is the id of the recreated user, 0 on a dry run
+	UserId int64         `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DryRun bool          `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Report *ImportReport `protobuf:"bytes,4,opt,name=report,proto3" json:"report,omitempty"`
+	// error describes why the archive is invalid
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ImportAccountResponse) Reset() {
+	*x = ImportAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAccountResponse) ProtoMessage() {}
+
+func (x *ImportAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAccountResponse.ProtoReflect.Descriptor instead.
+func (*ImportAccountResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImportAccountResponse) GetStatus() ImportAccountResponse_ImportAccountStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportAccountResponse_OK
+}
+
+func (x *ImportAccountResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportAccountResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportAccountResponse) GetReport() *ImportReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *ImportAccountResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ProfileVisibility struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bio      Visibility `protobuf:"varint,1,opt,name=bio,proto3,enum=user_and_post.Visibility" json:"bio,omitempty"`
+	Avatar   Visibility `protobuf:"varint,2,opt,name=avatar,proto3,enum=user_and_post.Visibility" json:"avatar,omitempty"`
+	Location Visibility `protobuf:"varint,3,opt,name=location,proto3,enum=user_and_post.Visibility" json:"location,omitempty"`
+	Website  Visibility `protobuf:"varint,4,opt,name=website,proto3,enum=user_and_post.Visibility" json:"website,omitempty"`
+	Pronouns Visibility `protobuf:"varint,5,opt,name=pronouns,proto3,enum=user_and_post.Visibility" json:"pronouns,omitempty"`
+}
+
+func (x *ProfileVisibility) Reset() {
+	*x = ProfileVisibility{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileVisibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileVisibility) ProtoMessage() {}
+
+func (x *ProfileVisibility) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileVisibility.ProtoReflect.Descriptor instead.
+func (*ProfileVisibility) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ProfileVisibility) GetBio() Visibility {
+	if x != nil {
+		return x.Bio
+	}
+	return Visibility_PUBLIC
+}
+
+func (x *ProfileVisibility) GetAvatar() Visibility {
+	if x != nil {
+		return x.Avatar
+	}
+	return Visibility_PUBLIC
+}
+
+func (x *ProfileVisibility) GetLocation() Visibility {
+	if x != nil {
+		return x.Location
+	}
+	return Visibility_PUBLIC
+}
+
+func (x *ProfileVisibility) GetWebsite() Visibility {
+	if x != nil {
+		return x.Website
+	}
+	return Visibility_PUBLIC
+}
+
+func (x *ProfileVisibility) GetPronouns() Visibility {
+	if x != nil {
+		return x.Pronouns
+	}
+	return Visibility_PUBLIC
+}
+
+type UserProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName       string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	FirstName      string `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName       string `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	DisplayName    string `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio            string `protobuf:"bytes,6,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarPath     string `protobuf:"bytes,7,opt,name=avatar_path,json=avatarPath,proto3" json:"avatar_path,omitempty"`
+	FollowerCount  int64  `protobuf:"varint,8,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	FollowingCount int64  `protobuf:"varint,9,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
+	PostCount      int64  `protobuf:"varint,10,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	// followed_by_caller is always false for anonymous callers
+	FollowedByCaller bool   `protobuf:"varint,11,opt,name=followed_by_caller,json=followedByCaller,proto3" json:"followed_by_caller,omitempty"`
+	Location         string `protobuf:"bytes,12,opt,name=location,proto3" json:"location,omitempty"`
+	Website          string `protobuf:"bytes,13,opt,name=website,proto3" json:"website,omitempty"`
+	Pronouns         string `protobuf:"bytes,14,opt,name=pronouns,proto3" json:"pronouns,omitempty"`
+	// visibility is only returned to the owner of the profile
+	Visibility *ProfileVisibility `protobuf:"bytes,15,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// private accounts approve each follower
+	Private bool `protobuf:"varint,16,opt,name=private,proto3" json:"private,omitempty"`
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UserProfile) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserProfile) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UserProfile) GetAvatarPath() string {
+	if x != nil {
+		return x.AvatarPath
+	}
+	return ""
+}
+
+func (x *UserProfile) GetFollowerCount() int64 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *UserProfile) GetFollowingCount() int64 {
+	if x != nil {
+		return x.FollowingCount
+	}
+	return 0
+}
+
+func (x *UserProfile) GetPostCount() int64 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *UserProfile) GetFollowedByCaller() bool {
+	if x != nil {
+		return x.FollowedByCaller
+	}
+	return false
+}
+
+func (x *UserProfile) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UserProfile) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *UserProfile) GetPronouns() string {
+	if x != nil {
+		return x.Pronouns
+	}
+	return ""
+}
+
+func (x *UserProfile) GetVisibility() *ProfileVisibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return nil
+}
+
+func (x *UserProfile) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserByUserNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+}
+
+func (x *GetUserByUserNameRequest) Reset() {
+	*x = GetUserByUserNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserByUserNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByUserNameRequest) ProtoMessage() {}
+
+func (x *GetUserByUserNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByUserNameRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByUserNameRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetUserByUserNameRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  GetUserResponse_GetUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.GetUserResponse_GetUserStatus" json:"status,omitempty"`
+	Profile *UserProfile                  `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	// redirected_from is the old user name the lookup was made with, when it
+	// resolved through the rename history
+	RedirectedFrom string `protobuf:"bytes,3,opt,name=redirected_from,json=redirectedFrom,proto3" json:"redirected_from,omitempty"`
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetUserResponse) GetStatus() GetUserResponse_GetUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetUserResponse_OK
+}
+
+func (x *GetUserResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetRedirectedFrom() string {
+	if x != nil {
+		return x.RedirectedFrom
+	}
+	return ""
+}
+
+type GetUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIds []int64 `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+}
+
+func (x *GetUsersRequest) Reset() {
+	*x = GetUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersRequest) ProtoMessage() {}
+
+func (x *GetUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetUsersRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetUsersResponse_GetUsersStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.GetUsersResponse_GetUsersStatus" json:"status,omitempty"`
+	// profiles follow the order of user_ids, unknown and suspended users are left out
+	Profiles []*UserProfile `protobuf:"bytes,2,rep,name=profiles,proto3" json:"profiles,omitempty"`
+}
+
+func (x *GetUsersResponse) Reset() {
+	*x = GetUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersResponse) ProtoMessage() {}
+
+func (x *GetUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetUsersResponse) GetStatus() GetUsersResponse_GetUsersStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetUsersResponse_OK
+}
+
+func (x *GetUsersResponse) GetProfiles() []*UserProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+// Moderation handler
+type CheckPermissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+}
+
+func (x *CheckPermissionRequest) Reset() {
+	*x = CheckPermissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionRequest) ProtoMessage() {}
+
+func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CheckPermissionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckPermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type CheckPermissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+}
+
+func (x *CheckPermissionResponse) Reset() {
+	*x = CheckPermissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionResponse) ProtoMessage() {}
+
+func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
+func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CheckPermissionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+type SuspendUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// suspended false lifts the suspension
+	Suspended bool   `protobuf:"varint,2,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SuspendUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SuspendUserRequest) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SuspendUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status SuspendUserResponse_SuspendUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.SuspendUserResponse_SuspendUserStatus" json:"status,omitempty"`
+}
+
+func (x *SuspendUserResponse) Reset() {
+	*x = SuspendUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuspendUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserResponse) ProtoMessage() {}
+
+func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserResponse.ProtoReflect.Descriptor instead.
+func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SuspendUserResponse) GetStatus() SuspendUserResponse_SuspendUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SuspendUserResponse_OK
+}
+
+type ForceDeletePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ForceDeletePostRequest) Reset() {
+	*x = ForceDeletePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceDeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDeletePostRequest) ProtoMessage() {}
+
+func (x *ForceDeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceDeletePostRequest.ProtoReflect.Descriptor instead.
+func (*ForceDeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ForceDeletePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ForceDeletePostRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ForceDeletePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status ForceDeletePostResponse_ForceDeletePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ForceDeletePostResponse_ForceDeletePostStatus" json:"status,omitempty"`
+}
+
+func (x *ForceDeletePostResponse) Reset() {
+	*x = ForceDeletePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceDeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDeletePostResponse) ProtoMessage() {}
+
+func (x *ForceDeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceDeletePostResponse.ProtoReflect.Descriptor instead.
+func (*ForceDeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ForceDeletePostResponse) GetStatus() ForceDeletePostResponse_ForceDeletePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ForceDeletePostResponse_OK
+}
+
+type ReportPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ReportPostRequest) Reset() {
+	*x = ReportPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPostRequest) ProtoMessage() {}
+
+func (x *ReportPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPostRequest.ProtoReflect.Descriptor instead.
+func (*ReportPostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReportPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReportPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ReportPostRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReportPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status ReportPostResponse_ReportPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ReportPostResponse_ReportPostStatus" json:"status,omitempty"`
+}
+
+func (x *ReportPostResponse) Reset() {
+	*x = ReportPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPostResponse) ProtoMessage() {}
+
+func (x *ReportPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPostResponse.ProtoReflect.Descriptor instead.
+func (*ReportPostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReportPostResponse) GetStatus() ReportPostResponse_ReportPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportPostResponse_OK
+}
+
+type ListReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncludeResolved bool `protobuf:"varint,1,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"`
+	// before_report_id pages backwards from the newest report, 0 starts at the newest
+	BeforeReportId int64 `protobuf:"varint,2,opt,name=before_report_id,json=beforeReportId,proto3" json:"before_report_id,omitempty"`
+	Limit          int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsRequest) ProtoMessage() {}
+
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListReportsRequest) GetIncludeResolved() bool {
+	if x != nil {
+		return x.IncludeResolved
+	}
+	return false
+}
+
+func (x *ListReportsRequest) GetBeforeReportId() int64 {
+	if x != nil {
+		return x.BeforeReportId
+	}
+	return 0
+}
+
+func (x *ListReportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReportInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportId     int64                `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	ReporterId   int64                `protobuf:"varint,2,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	PostId       int64                `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reason       string               `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedTime  *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	ResolvedTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=resolved_time,json=resolvedTime,proto3,oneof" json:"resolved_time,omitempty"`
+}
+
+func (x *ReportInfo) Reset() {
+	*x = ReportInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportInfo) ProtoMessage() {}
+
+func (x *ReportInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportInfo.ProtoReflect.Descriptor instead.
+func (*ReportInfo) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ReportInfo) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *ReportInfo) GetReporterId() int64 {
+	if x != nil {
+		return x.ReporterId
+	}
+	return 0
+}
+
+func (x *ReportInfo) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ReportInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReportInfo) GetCreatedTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *ReportInfo) GetResolvedTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ResolvedTime
+	}
+	return nil
+}
+
+type ListReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  ListReportsResponse_ListReportsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ListReportsResponse_ListReportsStatus" json:"status,omitempty"`
+	Reports []*ReportInfo                         `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsResponse) ProtoMessage() {}
+
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListReportsResponse) GetStatus() ListReportsResponse_ListReportsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListReportsResponse_OK
+}
+
+func (x *ListReportsResponse) GetReports() []*ReportInfo {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+// Follow handler
+type FollowUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowingUserId int64 `protobuf:"varint,2,opt,name=following_user_id,json=followingUserId,proto3" json:"following_user_id,omitempty"`
+}
+
+func (x *FollowUserRequest) Reset() {
+	*x = FollowUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserRequest) ProtoMessage() {}
+
+func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
+func (*FollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FollowUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FollowUserRequest) GetFollowingUserId() int64 {
+	if x != nil {
+		return x.FollowingUserId
+	}
+	return 0
+}
+
+type FollowUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status FollowUserResponse_FollowStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.FollowUserResponse_FollowStatus" json:"status,omitempty"`
+}
+
+func (x *FollowUserResponse) Reset() {
+	*x = FollowUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserResponse) ProtoMessage() {}
+
+func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
+func (*FollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *FollowUserResponse) GetStatus() FollowUserResponse_FollowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FollowUserResponse_OK
+}
+
+type UnfollowUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowingUserId int64 `protobuf:"varint,2,opt,name=following_user_id,json=followingUserId,proto3" json:"following_user_id,omitempty"`
+}
+
+func (x *UnfollowUserRequest) Reset() {
+	*x = UnfollowUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserRequest) ProtoMessage() {}
+
+func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UnfollowUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnfollowUserRequest) GetFollowingUserId() int64 {
+	if x != nil {
+		return x.FollowingUserId
+	}
+	return 0
+}
+
+type UnfollowUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnfollowUserResponse_UnfollowStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.UnfollowUserResponse_UnfollowStatus" json:"status,omitempty"`
+}
+
+func (x *UnfollowUserResponse) Reset() {
+	*x = UnfollowUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserResponse) ProtoMessage() {}
+
+func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UnfollowUserResponse) GetStatus() UnfollowUserResponse_UnfollowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnfollowUserResponse_OK
+}
+
+// Follow lists are sorted by follow time, newest first. cursor is the
+// next_cursor of the previous page, empty for the first page
+type GetFollowerListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// user_name_prefix only keeps users whose user name starts with it
+	UserNamePrefix string `protobuf:"bytes,4,opt,name=user_name_prefix,json=userNamePrefix,proto3" json:"user_name_prefix,omitempty"`
+}
+
+func (x *GetFollowerListRequest) Reset() {
+	*x = GetFollowerListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowerListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowerListRequest) ProtoMessage() {}
+
+func (x *GetFollowerListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowerListRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowerListRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetFollowerListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetFollowerListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetFollowerListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetFollowerListRequest) GetUserNamePrefix() string {
+	if x != nil {
+		return x.UserNamePrefix
+	}
+	return ""
+}
+
+type FollowInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName     string               `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	FollowedTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=followed_time,json=followedTime,proto3" json:"followed_time,omitempty"`
+}
+
+func (x *FollowInfo) Reset() {
+	*x = FollowInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowInfo) ProtoMessage() {}
+
+func (x *FollowInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowInfo.ProtoReflect.Descriptor instead.
+func (*FollowInfo) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *FollowInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FollowInfo) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *FollowInfo) GetFollowedTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.FollowedTime
+	}
+	return nil
+}
+
+type GetFollowerListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    GetFollowerListResponse_GetFollowerListStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.GetFollowerListResponse_GetFollowerListStatus" json:"status,omitempty"`
+	Followers []*FollowInfo                                 `protobuf:"bytes,2,rep,name=followers,proto3" json:"followers,omitempty"`
+	// next_cursor is empty on the last page
+	NextCursor string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetFollowerListResponse) Reset() {
+	*x = GetFollowerListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowerListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowerListResponse) ProtoMessage() {}
+
+func (x *GetFollowerListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowerListResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowerListResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetFollowerListResponse) GetStatus() GetFollowerListResponse_GetFollowerListStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetFollowerListResponse_OK
+}
+
+func (x *GetFollowerListResponse) GetFollowers() []*FollowInfo {
+	if x != nil {
+		return x.Followers
+	}
+	return nil
+}
+
+func (x *GetFollowerListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetFollowingListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit          int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor         string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	UserNamePrefix string `protobuf:"bytes,4,opt,name=user_name_prefix,json=userNamePrefix,proto3" json:"user_name_prefix,omitempty"`
+}
+
+func (x *GetFollowingListRequest) Reset() {
+	*x = GetFollowingListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingListRequest) ProtoMessage() {}
+
+func (x *GetFollowingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingListRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowingListRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetFollowingListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetFollowingListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetFollowingListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetFollowingListRequest) GetUserNamePrefix() string {
+	if x != nil {
+		return x.UserNamePrefix
+	}
+	return ""
+}
+
+type GetFollowingListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     GetFollowingListResponse_GetFollowingListStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.GetFollowingListResponse_GetFollowingListStatus" json:"status,omitempty"`
+	Following  []*FollowInfo                                   `protobuf:"bytes,2,rep,name=following,proto3" json:"following,omitempty"`
+	NextCursor string                                          `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetFollowingListResponse) Reset() {
+	*x = GetFollowingListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingListResponse) ProtoMessage() {}
+
+func (x *GetFollowingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingListResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowingListResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetFollowingListResponse) GetStatus() GetFollowingListResponse_GetFollowingListStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetFollowingListResponse_OK
+}
+
+func (x *GetFollowingListResponse) GetFollowing() []*FollowInfo {
+	if x != nil {
+		return x.Following
+	}
+	return nil
+}
+
+func (x *GetFollowingListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// ListFollowRequests lists the pending requests to follow user_id, newest first
+type ListFollowRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ListFollowRequestsRequest) Reset() {
+	*x = ListFollowRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsRequest) ProtoMessage() {}
+
+func (x *ListFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListFollowRequestsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListFollowRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     ListFollowRequestsResponse_ListFollowRequestsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ListFollowRequestsResponse_ListFollowRequestsStatus" json:"status,omitempty"`
+	Requesters []*RelatedUser                                      `protobuf:"bytes,2,rep,name=requesters,proto3" json:"requesters,omitempty"`
+}
+
+func (x *ListFollowRequestsResponse) Reset() {
+	*x = ListFollowRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsResponse) ProtoMessage() {}
+
+func (x *ListFollowRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListFollowRequestsResponse) GetStatus() ListFollowRequestsResponse_ListFollowRequestsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListFollowRequestsResponse_OK
+}
+
+func (x *ListFollowRequestsResponse) GetRequesters() []*RelatedUser {
+	if x != nil {
+		return x.Requesters
+	}
+	return nil
+}
+
+type ApproveFollowRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequesterUserId int64 `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3" json:"requester_user_id,omitempty"`
+}
+
+func (x *ApproveFollowRequestRequest) Reset() {
+	*x = ApproveFollowRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestRequest) ProtoMessage() {}
+
+func (x *ApproveFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ApproveFollowRequestRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApproveFollowRequestRequest) GetRequesterUserId() int64 {
+	if x != nil {
+		return x.RequesterUserId
+	}
+	return 0
+}
+
+type ApproveFollowRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status ApproveFollowRequestResponse_ApproveFollowRequestStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ApproveFollowRequestResponse_ApproveFollowRequestStatus" json:"status,omitempty"`
+}
+
+func (x *ApproveFollowRequestResponse) Reset() {
+	*x = ApproveFollowRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestResponse) ProtoMessage() {}
+
+func (x *ApproveFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ApproveFollowRequestResponse) GetStatus() ApproveFollowRequestResponse_ApproveFollowRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApproveFollowRequestResponse_OK
+}
+
+type RejectFollowRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequesterUserId int64 `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3" json:"requester_user_id,omitempty"`
+}
+
+func (x *RejectFollowRequestRequest) Reset() {
+	*x = RejectFollowRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestRequest) ProtoMessage() {}
+
+func (x *RejectFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RejectFollowRequestRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RejectFollowRequestRequest) GetRequesterUserId() int64 {
+	if x != nil {
+		return x.RequesterUserId
+	}
+	return 0
+}
+
+type RejectFollowRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RejectFollowRequestResponse_RejectFollowRequestStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.RejectFollowRequestResponse_RejectFollowRequestStatus" json:"status,omitempty"`
+}
+
+func (x *RejectFollowRequestResponse) Reset() {
+	*x = RejectFollowRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestResponse) ProtoMessage() {}
+
+func (x *RejectFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RejectFollowRequestResponse) GetStatus() RejectFollowRequestResponse_RejectFollowRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RejectFollowRequestResponse_OK
+}
+
+type CancelFollowRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId int64 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+}
+
+func (x *CancelFollowRequestRequest) Reset() {
+	*x = CancelFollowRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFollowRequestRequest) ProtoMessage() {}
+
+func (x *CancelFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CancelFollowRequestRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CancelFollowRequestRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type CancelFollowRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status CancelFollowRequestResponse_CancelFollowRequestStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.CancelFollowRequestResponse_CancelFollowRequestStatus" json:"status,omitempty"`
+}
+
+func (x *CancelFollowRequestResponse) Reset() {
+	*x = CancelFollowRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFollowRequestResponse) ProtoMessage() {}
+
+func (x *CancelFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CancelFollowRequestResponse) GetStatus() CancelFollowRequestResponse_CancelFollowRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CancelFollowRequestResponse_OK
+}
+
+// Relationship describes how user_id relates to target_user_id, seen from
+// user_id. New kinds of relationship are added as new fields
+type Relationship struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetUserId int64 `protobuf:"varint,1,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	// following is true when user_id follows the target
+	Following bool `protobuf:"varint,2,opt,name=following,proto3" json:"following,omitempty"`
+	// followed_by is true when the target follows user_id
+	FollowedBy bool `protobuf:"varint,3,opt,name=followed_by,json=followedBy,proto3" json:"followed_by,omitempty"`
+	// mutual is following and followed_by together
+	Mutual   bool `protobuf:"varint,4,opt,name=mutual,proto3" json:"mutual,omitempty"`
+	Blocking bool `protobuf:"varint,5,opt,name=blocking,proto3" json:"blocking,omitempty"`
+	Muting   bool `protobuf:"varint,6,opt,name=muting,proto3" json:"muting,omitempty"`
+	// follow_requested is a pending request of user_id to follow a private target
+	FollowRequested bool `protobuf:"varint,7,opt,name=follow_requested,json=followRequested,proto3" json:"follow_requested,omitempty"`
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Relationship) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+func (x *Relationship) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *Relationship) GetFollowedBy() bool {
+	if x != nil {
+		return x.FollowedBy
+	}
+	return false
+}
+
+func (x *Relationship) GetMutual() bool {
+	if x != nil {
+		return x.Mutual
+	}
+	return false
+}
+
+func (x *Relationship) GetBlocking() bool {
+	if x != nil {
+		return x.Blocking
+	}
+	return false
+}
+
+func (x *Relationship) GetMuting() bool {
+	if x != nil {
+		return x.Muting
+	}
+	return false
+}
+
+func (x *Relationship) GetFollowRequested() bool {
+	if x != nil {
+		return x.FollowRequested
+	}
+	return false
+}
+
+type GetRelationshipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId int64 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+}
+
+func (x *GetRelationshipRequest) Reset() {
+	*x = GetRelationshipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipRequest) ProtoMessage() {}
+
+func (x *GetRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetRelationshipRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetRelationshipRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type GetRelationshipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       GetRelationshipResponse_GetRelationshipStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.GetRelationshipResponse_GetRelationshipStatus" json:"status,omitempty"`
+	Relationship *Relationship                                 `protobuf:"bytes,2,opt,name=relationship,proto3" json:"relationship,omitempty"`
+}
+
+func (x *GetRelationshipResponse) Reset() {
+	*x = GetRelationshipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipResponse) ProtoMessage() {}
+
+func (x *GetRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*GetRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetRelationshipResponse) GetStatus() GetRelationshipResponse_GetRelationshipStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetRelationshipResponse_OK
+}
+
+func (x *GetRelationshipResponse) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type GetRelationshipsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserIds []int64 `protobuf:"varint,2,rep,packed,name=target_user_ids,json=targetUserIds,proto3" json:"target_user_ids,omitempty"`
+}
+
+func (x *GetRelationshipsRequest) Reset() {
+	*x = GetRelationshipsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsRequest) ProtoMessage() {}
+
+func (x *GetRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetRelationshipsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetRelationshipsRequest) GetTargetUserIds() []int64 {
+	if x != nil {
+		return x.TargetUserIds
+	}
+	return nil
+}
+
+type GetRelationshipsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetRelationshipsResponse_GetRelationshipsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.GetRelationshipsResponse_GetRelationshipsStatus" json:"status,omitempty"`
+	// relationships follow the order of target_user_ids without duplicates,
+	// unknown users get a relationship with every flag unset
+	Relationships []*Relationship `protobuf:"bytes,2,rep,name=relationships,proto3" json:"relationships,omitempty"`
+}
+
+func (x *GetRelationshipsResponse) Reset() {
+	*x = GetRelationshipsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsResponse) ProtoMessage() {}
+
+func (x *GetRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetRelationshipsResponse) GetStatus() GetRelationshipsResponse_GetRelationshipsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetRelationshipsResponse_OK
+}
+
+func (x *GetRelationshipsResponse) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+// Bulk follow handler
+type FollowEdge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FollowerId int64 `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FolloweeId int64 `protobuf:"varint,2,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+}
+
+func (x *FollowEdge) Reset() {
+	*x = FollowEdge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowEdge) ProtoMessage() {}
+
+func (x *FollowEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowEdge.ProtoReflect.Descriptor instead.
+func (*FollowEdge) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *FollowEdge) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+func (x *FollowEdge) GetFolloweeId() int64 {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return 0
+}
+
+// BulkFollowRequest is shared by BulkFollow and BulkUnfollow, all edges are
+// applied in one transaction
+type BulkFollowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Edges []*FollowEdge `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+}
+
+func (x *BulkFollowRequest) Reset() {
+	*x = BulkFollowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkFollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkFollowRequest) ProtoMessage() {}
+
+func (x *BulkFollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkFollowRequest.ProtoReflect.Descriptor instead.
+func (*BulkFollowRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *BulkFollowRequest) GetEdges() []*FollowEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type FollowEdgeResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Edge    *FollowEdge                        `protobuf:"bytes,1,opt,name=edge,proto3" json:"edge,omitempty"`
+	Outcome FollowEdgeResult_FollowEdgeOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=user_and_post.FollowEdgeResult_FollowEdgeOutcome" json:"outcome,omitempty"`
+}
+
+func (x *FollowEdgeResult) Reset() {
+	*x = FollowEdgeResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowEdgeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowEdgeResult) ProtoMessage() {}
+
+func (x *FollowEdgeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowEdgeResult.ProtoReflect.Descriptor instead.
+func (*FollowEdgeResult) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *FollowEdgeResult) GetEdge() *FollowEdge {
+	if x != nil {
+		return x.Edge
+	}
+	return nil
+}
+
+func (x *FollowEdgeResult) GetOutcome() FollowEdgeResult_FollowEdgeOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return FollowEdgeResult_APPLIED
+}
+
+type BulkFollowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status BulkFollowResponse_BulkFollowStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.BulkFollowResponse_BulkFollowStatus" json:"status,omitempty"`
+	// results follow the order of the request edges
+	Results []*FollowEdgeResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BulkFollowResponse) Reset() {
+	*x = BulkFollowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkFollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkFollowResponse) ProtoMessage() {}
+
+func (x *BulkFollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkFollowResponse.ProtoReflect.Descriptor instead.
+func (*BulkFollowResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *BulkFollowResponse) GetStatus() BulkFollowResponse_BulkFollowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BulkFollowResponse_OK
+}
+
+func (x *BulkFollowResponse) GetResults() []*FollowEdgeResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SuggestFollowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SuggestFollowsRequest) Reset() {
+	*x = SuggestFollowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestFollowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestFollowsRequest) ProtoMessage() {}
+
+func (x *SuggestFollowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestFollowsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestFollowsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SuggestFollowsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SuggestFollowsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FollowSuggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// friends_of_friends is how many users followed by user_id follow the suggestion
+	FriendsOfFriends int64 `protobuf:"varint,3,opt,name=friends_of_friends,json=friendsOfFriends,proto3" json:"friends_of_friends,omitempty"`
+	// mutual_followers is how many followers of user_id also follow the suggestion
+	MutualFollowers int64 `protobuf:"varint,4,opt,name=mutual_followers,json=mutualFollowers,proto3" json:"mutual_followers,omitempty"`
+}
+
+func (x *FollowSuggestion) Reset() {
+	*x = FollowSuggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowSuggestion) ProtoMessage() {}
+
+func (x *FollowSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowSuggestion.ProtoReflect.Descriptor instead.
+func (*FollowSuggestion) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *FollowSuggestion) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FollowSuggestion) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *FollowSuggestion) GetFriendsOfFriends() int64 {
+	if x != nil {
+		return x.FriendsOfFriends
+	}
+	return 0
+}
+
+func (x *FollowSuggestion) GetMutualFollowers() int64 {
+	if x != nil {
+		return x.MutualFollowers
+	}
+	return 0
+}
+
+type SuggestFollowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status SuggestFollowsResponse_SuggestFollowsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.SuggestFollowsResponse_SuggestFollowsStatus" json:"status,omitempty"`
+	// suggestions are sorted by relevance, best first
+	Suggestions []*FollowSuggestion `protobuf:"bytes,2,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+}
+
+func (x *SuggestFollowsResponse) Reset() {
+	*x = SuggestFollowsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestFollowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestFollowsResponse) ProtoMessage() {}
+
+func (x *SuggestFollowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestFollowsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestFollowsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SuggestFollowsResponse) GetStatus() SuggestFollowsResponse_SuggestFollowsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SuggestFollowsResponse_OK
+}
+
+func (x *SuggestFollowsResponse) GetSuggestions() []*FollowSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type UserInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName     string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserPassword string `protobuf:"bytes,3,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
+}
+
+func (x *UserInfo) Reset() {
+	*x = UserInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo) ProtoMessage() {}
+
+func (x *UserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
+func (*UserInfo) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UserInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserInfo) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UserInfo) GetUserPassword() string {
+	if x != nil {
+		return x.UserPassword
+	}
+	return ""
+}
+
+// Block and mute handler
+type RelatedUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName  string               `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	SinceTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=since_time,json=sinceTime,proto3" json:"since_time,omitempty"`
+}
+
+func (x *RelatedUser) Reset() {
+	*x = RelatedUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelatedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedUser) ProtoMessage() {}
+
+func (x *RelatedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedUser.ProtoReflect.Descriptor instead.
+func (*RelatedUser) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RelatedUser) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RelatedUser) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *RelatedUser) GetSinceTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.SinceTime
+	}
+	return nil
+}
+
+type BlockUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId int64 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *BlockUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BlockUserRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type BlockUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status BlockUserResponse_BlockUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.BlockUserResponse_BlockUserStatus" json:"status,omitempty"`
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *BlockUserResponse) GetStatus() BlockUserResponse_BlockUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BlockUserResponse_OK
+}
+
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId int64 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UnblockUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnblockUserRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type UnblockUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnblockUserResponse_UnblockUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.UnblockUserResponse_UnblockUserStatus" json:"status,omitempty"`
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResponse) ProtoMessage() {}
+
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UnblockUserResponse) GetStatus() UnblockUserResponse_UnblockUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnblockUserResponse_OK
+}
+
+type ListBlockedUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ListBlockedUsersRequest) Reset() {
+	*x = ListBlockedUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlockedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersRequest) ProtoMessage() {}
+
+func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListBlockedUsersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListBlockedUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status ListBlockedUsersResponse_ListBlockedUsersStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ListBlockedUsersResponse_ListBlockedUsersStatus" json:"status,omitempty"`
+	Users  []*RelatedUser                                  `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ListBlockedUsersResponse) Reset() {
+	*x = ListBlockedUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlockedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersResponse) ProtoMessage() {}
+
+func (x *ListBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListBlockedUsersResponse) GetStatus() ListBlockedUsersResponse_ListBlockedUsersStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListBlockedUsersResponse_OK
+}
+
+func (x *ListBlockedUsersResponse) GetUsers() []*RelatedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type MuteUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId int64 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+}
+
+func (x *MuteUserRequest) Reset() {
+	*x = MuteUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteUserRequest) ProtoMessage() {}
+
+func (x *MuteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteUserRequest.ProtoReflect.Descriptor instead.
+func (*MuteUserRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *MuteUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MuteUserRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type MuteUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status MuteUserResponse_MuteUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.MuteUserResponse_MuteUserStatus" json:"status,omitempty"`
+}
+
+func (x *MuteUserResponse) Reset() {
+	*x = MuteUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteUserResponse) ProtoMessage() {}
+
+func (x *MuteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteUserResponse.ProtoReflect.Descriptor instead.
+func (*MuteUserResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *MuteUserResponse) GetStatus() MuteUserResponse_MuteUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MuteUserResponse_OK
+}
+
+type UnmuteUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId int64 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+}
+
+func (x *UnmuteUserRequest) Reset() {
+	*x = UnmuteUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnmuteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteUserRequest) ProtoMessage() {}
+
+func (x *UnmuteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteUserRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteUserRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UnmuteUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnmuteUserRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type UnmuteUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnmuteUserResponse_UnmuteUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.UnmuteUserResponse_UnmuteUserStatus" json:"status,omitempty"`
+}
+
+func (x *UnmuteUserResponse) Reset() {
+	*x = UnmuteUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnmuteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteUserResponse) ProtoMessage() {}
+
+func (x *UnmuteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteUserResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteUserResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UnmuteUserResponse) GetStatus() UnmuteUserResponse_UnmuteUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnmuteUserResponse_OK
+}
+
+type ListMutedUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ListMutedUsersRequest) Reset() {
+	*x = ListMutedUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMutedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutedUsersRequest) ProtoMessage() {}
+
+func (x *ListMutedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListMutedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListMutedUsersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListMutedUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status ListMutedUsersResponse_ListMutedUsersStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ListMutedUsersResponse_ListMutedUsersStatus" json:"status,omitempty"`
+	Users  []*RelatedUser                              `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ListMutedUsersResponse) Reset() {
+	*x = ListMutedUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMutedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutedUsersResponse) ProtoMessage() {}
+
+func (x *ListMutedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListMutedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListMutedUsersResponse) GetStatus() ListMutedUsersResponse_ListMutedUsersStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListMutedUsersResponse_OK
+}
+
+func (x *ListMutedUsersResponse) GetUsers() []*RelatedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Close friends handler
+type AddCloseFriendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId int64 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+}
+
+func (x *AddCloseFriendRequest) Reset() {
+	*x = AddCloseFriendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCloseFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCloseFriendRequest) ProtoMessage() {}
+
+func (x *AddCloseFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCloseFriendRequest.ProtoReflect.Descriptor instead.
+func (*AddCloseFriendRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *AddCloseFriendRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddCloseFriendRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type AddCloseFriendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status AddCloseFriendResponse_AddCloseFriendStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.AddCloseFriendResponse_AddCloseFriendStatus" json:"status,omitempty"`
+}
+
+func (x *AddCloseFriendResponse) Reset() {
+	*x = AddCloseFriendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCloseFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCloseFriendResponse) ProtoMessage() {}
+
+func (x *AddCloseFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCloseFriendResponse.ProtoReflect.Descriptor instead.
+func (*AddCloseFriendResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *AddCloseFriendResponse) GetStatus() AddCloseFriendResponse_AddCloseFriendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AddCloseFriendResponse_OK
+}
+
+type RemoveCloseFriendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId int64 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+}
+
+func (x *RemoveCloseFriendRequest) Reset() {
+	*x = RemoveCloseFriendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCloseFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCloseFriendRequest) ProtoMessage() {}
+
+func (x *RemoveCloseFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCloseFriendRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCloseFriendRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *RemoveCloseFriendRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveCloseFriendRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type RemoveCloseFriendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RemoveCloseFriendResponse_RemoveCloseFriendStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.RemoveCloseFriendResponse_RemoveCloseFriendStatus" json:"status,omitempty"`
+}
+
+func (x *RemoveCloseFriendResponse) Reset() {
+	*x = RemoveCloseFriendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCloseFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCloseFriendResponse) ProtoMessage() {}
+
+func (x *RemoveCloseFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCloseFriendResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCloseFriendResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *RemoveCloseFriendResponse) GetStatus() RemoveCloseFriendResponse_RemoveCloseFriendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RemoveCloseFriendResponse_OK
+}
+
+type ListCloseFriendsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ListCloseFriendsRequest) Reset() {
+	*x = ListCloseFriendsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCloseFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCloseFriendsRequest) ProtoMessage() {}
+
+func (x *ListCloseFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCloseFriendsRequest.ProtoReflect.Descriptor instead.
+func (*ListCloseFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListCloseFriendsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListCloseFriendsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status ListCloseFriendsResponse_ListCloseFriendsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ListCloseFriendsResponse_ListCloseFriendsStatus" json:"status,omitempty"`
+	Users  []*RelatedUser                                  `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ListCloseFriendsResponse) Reset() {
+	*x = ListCloseFriendsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCloseFriendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCloseFriendsResponse) ProtoMessage() {}
+
+func (x *ListCloseFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCloseFriendsResponse.ProtoReflect.Descriptor instead.
+func (*ListCloseFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListCloseFriendsResponse) GetStatus() ListCloseFriendsResponse_ListCloseFriendsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListCloseFriendsResponse_OK
+}
+
+func (x *ListCloseFriendsResponse) GetUsers() []*RelatedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Post handler
+type CreatePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           int64         `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentText      string        `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	ContentImagePath string        `protobuf:"bytes,3,opt,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
+	Audience         Post_Audience `protobuf:"varint,5,opt,name=audience,proto3,enum=user_and_post.Post_Audience" json:"audience,omitempty"`
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CreatePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetContentImagePath() string {
+	if x != nil {
+		return x.ContentImagePath
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetAudience() Post_Audience {
+	if x != nil {
+		return x.Audience
+	}
+	return Post_PUBLIC
+}
+
+type CreatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status CreatePostResponse_CreatePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.CreatePostResponse_CreatePostStatus" json:"status,omitempty"`
+	PostId int64                               `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *CreatePostResponse) Reset() {
+	*x = CreatePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostResponse) ProtoMessage() {}
+
+func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CreatePostResponse) GetStatus() CreatePostResponse_CreatePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreatePostResponse_OK
+}
+
+func (x *CreatePostResponse) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type GetPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *GetPostRequest) Reset() {
+	*x = GetPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRequest) ProtoMessage() {}
+
+func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type Post struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId           int64                `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId           int64                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentText      string               `protobuf:"bytes,4,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	ContentImagePath string               `protobuf:"bytes,5,opt,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
+	CreatedTime      *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	Audience         Post_Audience        `protobuf:"varint,8,opt,name=audience,proto3,enum=user_and_post.Post_Audience" json:"audience,omitempty"`
+	// pinned_time is only set on posts pinned to the profile of their author
+	PinnedTime *timestamp.Timestamp `protobuf:"bytes,9,opt,name=pinned_time,json=pinnedTime,proto3" json:"pinned_time,omitempty"`
+	// edited_time is only set on posts edited since they were created
+	EditedTime *timestamp.Timestamp `protobuf:"bytes,10,opt,name=edited_time,json=editedTime,proto3" json:"edited_time,omitempty"`
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *Post) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Post) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Post) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *Post) GetContentImagePath() string {
+	if x != nil {
+		return x.ContentImagePath
+	}
+	return ""
+}
+
+func (x *Post) GetCreatedTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *Post) GetAudience() Post_Audience {
+	if x != nil {
+		return x.Audience
+	}
+	return Post_PUBLIC
+}
+
+func (x *Post) GetPinnedTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.PinnedTime
+	}
+	return nil
+}
+
+func (x *Post) GetEditedTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EditedTime
+	}
+	return nil
+}
+
+type GetPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetPostResponse_GetPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.GetPostResponse_GetPostStatus" json:"status,omitempty"`
+	Post   *Post                         `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *GetPostResponse) Reset() {
+	*x = GetPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostResponse) ProtoMessage() {}
+
+func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
+func (*GetPostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetPostResponse) GetStatus() GetPostResponse_GetPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetPostResponse_OK
+}
+
+func (x *GetPostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type DeletePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *DeletePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DeletePostResponse_DeletePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.DeletePostResponse_DeletePostStatus" json:"status,omitempty"`
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *DeletePostResponse) GetStatus() DeletePostResponse_DeletePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeletePostResponse_OK
+}
+
+type EditPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId           int64          `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ContentText      *string        `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3,oneof" json:"content_text,omitempty"`
+	ContentImagePath *string        `protobuf:"bytes,3,opt,name=content_image_path,json=contentImagePath,proto3,oneof" json:"content_image_path,omitempty"`
+	Audience         *Post_Audience `protobuf:"varint,5,opt,name=audience,proto3,enum=user_and_post.Post_Audience,oneof" json:"audience,omitempty"`
+}
+
+func (x *EditPostRequest) Reset() {
+	*x = EditPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostRequest) ProtoMessage() {}
+
+func (x *EditPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostRequest.ProtoReflect.Descriptor instead.
+func (*EditPostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *EditPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *EditPostRequest) GetContentText() string {
+	if x != nil && x.ContentText != nil {
+		return *x.ContentText
+	}
+	return ""
+}
+
+func (x *EditPostRequest) GetContentImagePath() string {
+	if x != nil && x.ContentImagePath != nil {
+		return *x.ContentImagePath
+	}
+	return ""
+}
+
+func (x *EditPostRequest) GetAudience() Post_Audience {
+	if x != nil && x.Audience != nil {
+		return *x.Audience
+	}
+	return Post_PUBLIC
+}
+
+type EditPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status EditPostResponse_EditPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.EditPostResponse_EditPostStatus" json:"status,omitempty"`
+}
+
+func (x *EditPostResponse) Reset() {
+	*x = EditPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostResponse) ProtoMessage() {}
+
+func (x *EditPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostResponse.ProtoReflect.Descriptor instead.
+func (*EditPostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *EditPostResponse) GetStatus() EditPostResponse_EditPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EditPostResponse_OK
+}
+
+// ListUserPostsRequest lists the posts of user_id the caller may read, newest
+// first. The first page also returns the pinned posts, they are left out of
+// the rest of the timeline
+type ListUserPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListUserPostsRequest) Reset() {
+	*x = ListUserPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPostsRequest) ProtoMessage() {}
+
+func (x *ListUserPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserPostsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListUserPostsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListUserPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUserPostsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListUserPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      ListUserPostsResponse_ListUserPostsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ListUserPostsResponse_ListUserPostsStatus" json:"status,omitempty"`
+	PinnedPosts []*Post                                   `protobuf:"bytes,2,rep,name=pinned_posts,json=pinnedPosts,proto3" json:"pinned_posts,omitempty"`
+	Posts       []*Post                                   `protobuf:"bytes,3,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextCursor  string                                    `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListUserPostsResponse) Reset() {
+	*x = ListUserPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserPostsResponse) ProtoMessage() {}
+
+func (x *ListUserPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserPostsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ListUserPostsResponse) GetStatus() ListUserPostsResponse_ListUserPostsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListUserPostsResponse_OK
+}
+
+func (x *ListUserPostsResponse) GetPinnedPosts() []*Post {
+	if x != nil {
+		return x.PinnedPosts
+	}
+	return nil
+}
+
+func (x *ListUserPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListUserPostsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type PinPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *PinPostRequest) Reset() {
+	*x = PinPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostRequest) ProtoMessage() {}
+
+func (x *PinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostRequest.ProtoReflect.Descriptor instead.
+func (*PinPostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *PinPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type PinPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PinPostResponse_PinPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.PinPostResponse_PinPostStatus" json:"status,omitempty"`
+}
+
+func (x *PinPostResponse) Reset() {
+	*x = PinPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostResponse) ProtoMessage() {}
+
+func (x *PinPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostResponse.ProtoReflect.Descriptor instead.
+func (*PinPostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *PinPostResponse) GetStatus() PinPostResponse_PinPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PinPostResponse_OK
+}
+
+type UnpinPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *UnpinPostRequest) Reset() {
+	*x = UnpinPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinPostRequest) ProtoMessage() {}
+
+func (x *UnpinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinPostRequest.ProtoReflect.Descriptor instead.
+func (*UnpinPostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *UnpinPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type UnpinPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnpinPostResponse_UnpinPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.UnpinPostResponse_UnpinPostStatus" json:"status,omitempty"`
+}
+
+func (x *UnpinPostResponse) Reset() {
+	*x = UnpinPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinPostResponse) ProtoMessage() {}
+
+func (x *UnpinPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinPostResponse.ProtoReflect.Descriptor instead.
+func (*UnpinPostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *UnpinPostResponse) GetStatus() UnpinPostResponse_UnpinPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnpinPostResponse_OK
+}
+
+// Post revision handler
+// PostRevision is the content a post had before one of its edits
+type PostRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RevisionId       int64         `protobuf:"varint,1,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	PostId           int64         `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	EditorUserId     int64         `protobuf:"varint,3,opt,name=editor_user_id,json=editorUserId,proto3" json:"editor_user_id,omitempty"`
+	ContentText      string        `protobuf:"bytes,4,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	ContentImagePath string        `protobuf:"bytes,5,opt,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
+	Audience         Post_Audience `protobuf:"varint,6,opt,name=audience,proto3,enum=user_and_post.Post_Audience" json:"audience,omitempty"`
+	// created_time is when the edit replaced this content
+	CreatedTime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+}
+
+func (x *PostRevision) Reset() {
+	*x = PostRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevision) ProtoMessage() {}
+
+func (x *PostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevision.ProtoReflect.Descriptor instead.
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *PostRevision) GetRevisionId() int64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+func (x *PostRevision) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *PostRevision) GetEditorUserId() int64 {
+	if x != nil {
+		return x.EditorUserId
+	}
+	return 0
+}
+
+func (x *PostRevision) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *PostRevision) GetContentImagePath() string {
+	if x != nil {
+		return x.ContentImagePath
+	}
+	return ""
+}
+
+func (x *PostRevision) GetAudience() Post_Audience {
+	if x != nil {
+		return x.Audience
+	}
+	return Post_PUBLIC
+}
+
+func (x *PostRevision) GetCreatedTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+// ListPostRevisionsRequest lists the revisions of a post, newest first
+type ListPostRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *ListPostRevisionsRequest) Reset() {
+	*x = ListPostRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPostRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostRevisionsRequest) ProtoMessage() {}
+
+func (x *ListPostRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListPostRevisionsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type ListPostRevisionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    ListPostRevisionsResponse_ListPostRevisionsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.ListPostRevisionsResponse_ListPostRevisionsStatus" json:"status,omitempty"`
+	Revisions []*PostRevision                                   `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *ListPostRevisionsResponse) Reset() {
+	*x = ListPostRevisionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPostRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostRevisionsResponse) ProtoMessage() {}
+
+func (x *ListPostRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ListPostRevisionsResponse) GetStatus() ListPostRevisionsResponse_ListPostRevisionsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListPostRevisionsResponse_OK
+}
+
+func (x *ListPostRevisionsResponse) GetRevisions() []*PostRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type GetPostRevisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId     int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	RevisionId int64 `protobuf:"varint,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+}
+
+func (x *GetPostRevisionRequest) Reset() {
+	*x = GetPostRevisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionRequest) ProtoMessage() {}
+
+func (x *GetPostRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetPostRevisionRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetPostRevisionRequest) GetRevisionId() int64 {
+	if x != nil {
+		return x.RevisionId
+	}
+	return 0
+}
+
+type GetPostRevisionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   GetPostRevisionResponse_GetPostRevisionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.GetPostRevisionResponse_GetPostRevisionStatus" json:"status,omitempty"`
+	Revision *PostRevision                                 `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *GetPostRevisionResponse) Reset() {
+	*x = GetPostRevisionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionResponse) ProtoMessage() {}
+
+func (x *GetPostRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetPostRevisionResponse) GetStatus() GetPostRevisionResponse_GetPostRevisionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetPostRevisionResponse_OK
+}
+
+func (x *GetPostRevisionResponse) GetRevision() *PostRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type CommentPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      int64  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId      int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentText string `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+}
+
+func (x *CommentPostRequest) Reset() {
+	*x = CommentPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentPostRequest) ProtoMessage() {}
+
+func (x *CommentPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentPostRequest.ProtoReflect.Descriptor instead.
+func (*CommentPostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *CommentPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *CommentPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CommentPostRequest) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+type CommentPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    CommentPostResponse_CommentPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.CommentPostResponse_CommentPostStatus" json:"status,omitempty"`
+	CommentId int64                                 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *CommentPostResponse) Reset() {
+	*x = CommentPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentPostResponse) ProtoMessage() {}
+
+func (x *CommentPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentPostResponse.ProtoReflect.Descriptor instead.
+func (*CommentPostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *CommentPostResponse) GetStatus() CommentPostResponse_CommentPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentPostResponse_OK
+}
+
+func (x *CommentPostResponse) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type LikePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *LikePostRequest) Reset() {
+	*x = LikePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostRequest) ProtoMessage() {}
+
+func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
+func (*LikePostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *LikePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LikePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type LikePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status LikePostResponse_LikePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=user_and_post.LikePostResponse_LikePostStatus" json:"status,omitempty"`
+}
+
+func (x *LikePostResponse) Reset() {
+	*x = LikePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostResponse) ProtoMessage() {}
+
+func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
+func (*LikePostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *LikePostResponse) GetStatus() LikePostResponse_LikePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LikePostResponse_OK
+}
+
+var File_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto protoreflect.FileDescriptor
+
+var file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDesc = []byte{
+	0x0a, 0x44, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xeb, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x03, 0x64, 0x6f, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x64, 0x6f, 0x62, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x22, 0x3c, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x9d, 0x02, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x31, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x12, 0x3c, 0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x22, 0x60, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x41, 0x4b, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x0f,
+	0x0a, 0x0b, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x54, 0x41, 0x4b, 0x45, 0x4e, 0x10, 0x03, 0x12,
+	0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44,
+	0x10, 0x04, 0x22, 0x80, 0x08, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x31, 0x0a, 0x03, 0x64, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x03, 0x64, 0x6f, 0x62, 0x88,
+	0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x04, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x61, 0x76, 0x61,
+	0x74, 0x61, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05,
+	0x52, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12,
+	0x1f, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x06, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x1d, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x07, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1f, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x08, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x45, 0x0a, 0x0e, 0x62, 0x69, 0x6f, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x48, 0x09, 0x52, 0x0d, 0x62, 0x69, 0x6f, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x0a, 0x52,
+	0x10, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x13, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x0b, 0x52, 0x12,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x12, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
+	0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x0c, 0x52, 0x11,
+	0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73,
+	0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x0d, 0x52, 0x12,
+	0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0e, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x64, 0x6f, 0x62, 0x42, 0x06, 0x0a, 0x04, 0x5f,
+	0x62, 0x69, 0x6f, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x62, 0x69,
+	0x6f, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x14, 0x0a, 0x12,
+	0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x77,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73, 0x5f, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x81, 0x02, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0b, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x4e, 0x0a, 0x0e, 0x45, 0x64, 0x69,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f,
+	0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x03, 0x22, 0x4d, 0x0a, 0x15, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd2, 0x02, 0x0a, 0x16, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x76, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52,
+	0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x41, 0x4b, 0x45, 0x4e, 0x10, 0x04, 0x12,
+	0x0c, 0x0a, 0x08, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x4f, 0x4f, 0x4e, 0x10, 0x05, 0x22, 0x78, 0x0a,
+	0x17, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73,
+	0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x22, 0x81, 0x03, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x16, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
+	0x12, 0x0a, 0x0e, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52,
+	0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c,
+	0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x54, 0x52, 0x59,
+	0x5f, 0x4c, 0x41, 0x54, 0x45, 0x52, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45, 0x43, 0x4f,
+	0x4e, 0x44, 0x5f, 0x46, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f,
+	0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x06, 0x22, 0x34, 0x0a, 0x19, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x8d, 0x02, 0x0a, 0x1a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x42, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x74, 0x70, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x74, 0x70, 0x61, 0x75,
+	0x74, 0x68, 0x55, 0x72, 0x69, 0x22, 0x5a, 0x0a, 0x18, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10,
+	0x03, 0x22, 0x49, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x8f, 0x02, 0x0a,
+	0x1b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x44, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x22, 0x6b, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e,
+	0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a,
+	0x0c, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x03, 0x12,
+	0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x04, 0x22, 0x37,
+	0x0a, 0x1c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xef, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x48, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x22, 0x45, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54,
+	0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f,
+	0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x02, 0x22, 0x6f, 0x0a, 0x19, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x22, 0xa4, 0x02, 0x0a, 0x1a, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x61,
+	0x0a, 0x18, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10,
+	0x03, 0x22, 0x3a, 0x0a, 0x1f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xed, 0x01,
+	0x0a, 0x20, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x66, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x4e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x61, 0x0a, 0x1e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x03, 0x22, 0x2a, 0x0a,
+	0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x13, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x34, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x01, 0x22, 0x33, 0x0a, 0x1b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xa4, 0x01,
+	0x0a, 0x1c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x46,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x24,
+	0x0a, 0x1a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x22, 0x4f, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x38, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0b, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x02, 0x22, 0xf5, 0x01,
+	0x0a, 0x18, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc5, 0x02, 0x0a, 0x19, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x17, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f,
+	0x54, 0x41, 0x4b, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45,
+	0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x46, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x22, 0x76, 0x0a,
+	0x13, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x53,
+	0x0a, 0x12, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01,
+	0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x4c, 0x49, 0x4e, 0x4b,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45,
+	0x4e, 0x10, 0x03, 0x22, 0x51, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0xaa, 0x01, 0x0a, 0x16, 0x55, 0x6e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x3a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45,
+	0x4e, 0x10, 0x02, 0x22, 0x30, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x0c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x3b, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xd8, 0x01, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42,
+	0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x22, 0x2f, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x38, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x70, 0x75, 0x72, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x40, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10,
+	0x02, 0x22, 0x33, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc4, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x17, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x22, 0xa3, 0x03,
+	0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x43, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x22, 0x4f, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x04, 0x22, 0x4c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x64, 0x22, 0xe4, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a,
+	0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x42, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e,
+	0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x51, 0x0a, 0x19, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x1a,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x56, 0x0a, 0x18,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12,
+	0x14, 0x0a, 0x10, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x10, 0x03, 0x22, 0x49, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0x81, 0x02, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x5f, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x69,
+	0x6b, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x22, 0xce, 0x02, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x66, 0x0a, 0x13,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46,
+	0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12,
+	0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x41, 0x4b, 0x45,
+	0x4e, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x54, 0x41, 0x4b,
+	0x45, 0x4e, 0x10, 0x04, 0x22, 0x96, 0x02, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x03, 0x62, 0x69,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x33, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x07, 0x77,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73, 0x22, 0xa0, 0x04,
+	0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x62, 0x69, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x79, 0x43, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6e,
+	0x6f, 0x75, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6e,
+	0x6f, 0x75, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0xe3, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x2b, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x2c, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x2c, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4f, 0x4f, 0x5f, 0x4d,
+	0x41, 0x4e, 0x59, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x53, 0x10, 0x01, 0x22, 0x51, 0x0a, 0x16, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x33,
+	0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x22, 0x63, 0x0a, 0x12, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa3, 0x01, 0x0a, 0x13, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x34, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3e,
+	0x0a, 0x11, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x02, 0x22, 0x49,
+	0x0a, 0x16, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x17, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x42, 0x0a, 0x15, 0x46,
+	0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
+	0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01,
+	0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x02, 0x22,
+	0x5d, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb5,
+	0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x53, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x50,
+	0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49,
+	0x44, 0x44, 0x45, 0x4e, 0x10, 0x03, 0x22, 0x7f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xc4, 0x01, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x2a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45,
+	0x4e, 0x10, 0x01, 0x22, 0x58, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe2, 0x01,
+	0x0a, 0x12, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x83, 0x01, 0x0a,
+	0x0c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x0b, 0x0a, 0x07, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x46,
+	0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x41,
+	0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x53, 0x45, 0x4c, 0x46,
+	0x10, 0x06, 0x22, 0x5a, 0x0a, 0x13, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb1,
+	0x01, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x4d, 0x0a, 0x0e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e,
+	0x10, 0x03, 0x22, 0x89, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x83,
+	0x01, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x92, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x54, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x3c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0x47, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x43, 0x55, 0x52, 0x53, 0x4f, 0x52, 0x10, 0x02, 0x22, 0x8a, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x10,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x96, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x55, 0x52, 0x53, 0x4f, 0x52, 0x10, 0x02, 0x22,
+	0x34, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe7, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x31, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x22,
+	0x62, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0xca, 0x01, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x46, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x4a, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f,
+	0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02,
+	0x22, 0x61, 0x0a, 0x1a, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x44, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x49, 0x0a, 0x19, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44,
+	0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x5b, 0x0a, 0x1a,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x1b, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x44, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x49, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x02, 0x22, 0xea, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x75, 0x74, 0x75,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22,
+	0x57, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf4, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0c, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x22, 0x42, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22,
+	0x5a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x41, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x73, 0x22, 0x43, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44,
+	0x45, 0x4e, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59,
+	0x5f, 0x55, 0x53, 0x45, 0x52, 0x53, 0x10, 0x02, 0x22, 0x4e, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x45, 0x64, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x11, 0x42, 0x75, 0x6c, 0x6b,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a,
+	0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x22, 0x87,
+	0x02, 0x0a, 0x10, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x45, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x65, 0x64, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x45, 0x64, 0x67, 0x65, 0x52, 0x04, 0x65, 0x64,
+	0x67, 0x65, 0x12, 0x4b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x45, 0x64, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x45, 0x64, 0x67, 0x65, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22,
+	0x77, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x45, 0x64, 0x67, 0x65, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41, 0x50, 0x50,
+	0x4c, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x05, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x42, 0x75, 0x6c,
+	0x6b, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x32, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x42, 0x75, 0x6c, 0x6b, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x45, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3d, 0x0a, 0x10, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10,
+	0x01, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x45, 0x44,
+	0x47, 0x45, 0x53, 0x10, 0x02, 0x22, 0x46, 0x0a, 0x15, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa1, 0x01,
+	0x0a, 0x10, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x66, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x73, 0x22, 0xde, 0x01, 0x0a, 0x16, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x41, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x2d, 0x0a, 0x14, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e,
+	0x10, 0x01, 0x22, 0x65, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x65,
+	0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x7e, 0x0a, 0x0b, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x10, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc4, 0x01, 0x0a,
+	0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x30, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x65, 0x0a, 0x0f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46,
+	0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c,
+	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45,
+	0x54, 0x10, 0x04, 0x22, 0x53, 0x0a, 0x12, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x13, 0x55, 0x6e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x34, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3b,
+	0x0a, 0x11, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46,
+	0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f,
+	0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x22, 0x32, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0xd5, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42,
+	0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x22, 0x50, 0x0a, 0x0f, 0x4d, 0x75, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbe, 0x01, 0x0a, 0x10, 0x4d, 0x75,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4d,
+	0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x62, 0x0a, 0x0e, 0x4d, 0x75, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45,
+	0x4e, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x4d,
+	0x55, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x10, 0x04, 0x22, 0x52, 0x0a, 0x11, 0x55, 0x6e,
+	0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x9a,
+	0x01, 0x0a, 0x12, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x38, 0x0a, 0x10, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x55, 0x54, 0x45, 0x44, 0x10, 0x02, 0x22, 0x30, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xcd, 0x01,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x2d,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x22, 0x56, 0x0a,
+	0x15, 0x41, 0x64, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x3a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x41, 0x64, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x7c, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42,
+	0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x4c, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x10,
+	0x03, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x41, 0x52,
+	0x47, 0x45, 0x54, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44,
+	0x10, 0x05, 0x22, 0x59, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbd, 0x01,
+	0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x46, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42,
+	0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x4f, 0x54, 0x5f, 0x43,
+	0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x32, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0xd5, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f,
+	0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x6c, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x3d,
+	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x02, 0x22, 0x29, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xd2, 0x03, 0x0a, 0x04, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a,
+	0x0b, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x64,
+	0x69, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x53, 0x10,
+	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x4d, 0x45, 0x10, 0x03, 0x4a, 0x04,
+	0x08, 0x06, 0x10, 0x07, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0xad, 0x01,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74,
+	0x22, 0x2b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53,
+	0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x2c, 0x0a,
+	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x32, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3d,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f,
+	0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x02, 0x22, 0x88, 0x02,
+	0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x02, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52,
+	0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x10, 0x45, 0x64, 0x69,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3b, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e,
+	0x10, 0x02, 0x22, 0x5d, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0xc0, 0x02, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a,
+	0x0c, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0b, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0x51, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x43, 0x55, 0x52, 0x53, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x49, 0x44, 0x44,
+	0x45, 0x4e, 0x10, 0x03, 0x22, 0x29, 0x0a, 0x0e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22,
+	0xa8, 0x01, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x50, 0x69, 0x6e,
+	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44,
+	0x44, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e,
+	0x59, 0x5f, 0x50, 0x49, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x22, 0x2b, 0x0a, 0x10, 0x55, 0x6e,
+	0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x55, 0x6e, 0x70, 0x69,
+	0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e,
+	0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4c, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x69, 0x6e,
+	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44,
+	0x44, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x49, 0x4e,
+	0x4e, 0x45, 0x44, 0x10, 0x03, 0x22, 0xb8, 0x02, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x33, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x44, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50,
+	0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x02, 0x22, 0x52,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x84, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10,
+	0x02, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x22, 0x69, 0x0a, 0x12, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x78, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x11, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f,
+	0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x03, 0x12, 0x0b, 0x0a,
+	0x07, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x04, 0x22, 0x43, 0x0a, 0x0f, 0x4c, 0x69,
+	0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22,
+	0xb8, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5c, 0x0a, 0x0e,
+	0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f,
+	0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x03, 0x12, 0x0b, 0x0a,
+	0x07, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x34, 0x0a, 0x0a, 0x56, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c,
+	0x49, 0x43, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52,
+	0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x02,
+	0x32, 0xa9, 0x2e, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x48, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1d,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x19, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x08, 0x45, 0x64,
+	0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x10, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x26,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5c, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x23, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x68, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x27, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x28, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6b, 0x0a, 0x12, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x13,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x29, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x15,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x7d, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56,
+	0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x2a,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x23, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x27, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x59, 0x0a, 0x0c, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x22, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0e,
+	0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x24,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55,
+	0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a,
+	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62,
+	0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x56, 0x0a, 0x0b, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x25, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0a, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x59, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x22, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x65, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x13, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x25, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x26,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5f, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x73, 0x12, 0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x53, 0x0a, 0x0a, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x12, 0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x42, 0x75, 0x6c, 0x6b, 0x55,
+	0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50,
+	0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x26, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4d, 0x0a, 0x08, 0x4d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4d, 0x75, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4d, 0x75, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x0a, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x6d,
+	0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55,
+	0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x64,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x27, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x65, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x73, 0x12, 0x26, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x08,
+	0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x07, 0x50, 0x69, 0x6e,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x69, 0x5a, 0x67,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x68, 0x61, 0x69, 0x6c,
+	0x65, 0x71, 0x75, 0x61, 0x6e, 0x67, 0x33, 0x33, 0x34, 0x2f, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c,
+	0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x3b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescOnce sync.Once
+	file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescData = file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDesc
+)
+
+func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescGZIP() []byte {
+	file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescOnce.Do(func() {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescData)
+	})
+	return file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDescData
+}
+
+var file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_enumTypes = make([]protoimpl.EnumInfo, 63)
+var file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes = make([]protoimpl.MessageInfo, 137)
+var file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_goTypes = []interface{}{
+	(Visibility)(0),                                                      // 0: user_and_post.Visibility
+	(UserResult_UserStatus)(0),                                           // 1: user_and_post.UserResult.UserStatus
+	(EditUserResponse_EditUserStatus)(0),                                 // 2: user_and_post.EditUserResponse.EditUserStatus
+	(ChangeUserNameResponse_ChangeUserNameStatus)(0),                     // 3: user_and_post.ChangeUserNameResponse.ChangeUserNameStatus
+	(AuthenticateUserResponse_AuthenticateUserStatus)(0),                 // 4: user_and_post.AuthenticateUserResponse.AuthenticateUserStatus
+	(EnrollSecondFactorResponse_EnrollSecondFactorStatus)(0),             // 5: user_and_post.EnrollSecondFactorResponse.EnrollSecondFactorStatus
+	(ConfirmSecondFactorResponse_ConfirmSecondFactorStatus)(0),           // 6: user_and_post.ConfirmSecondFactorResponse.ConfirmSecondFactorStatus
+	(GenerateRecoveryCodesResponse_GenerateRecoveryCodesStatus)(0),       // 7: user_and_post.GenerateRecoveryCodesResponse.GenerateRecoveryCodesStatus
+	(VerifySecondFactorResponse_VerifySecondFactorStatus)(0),             // 8: user_and_post.VerifySecondFactorResponse.VerifySecondFactorStatus
+	(RequestEmailVerificationResponse_RequestEmailVerificationStatus)(0), // 9: user_and_post.RequestEmailVerificationResponse.RequestEmailVerificationStatus
+	(VerifyEmailResponse_VerifyEmailStatus)(0),                           // 10: user_and_post.VerifyEmailResponse.VerifyEmailStatus
+	(RequestPasswordResetResponse_RequestPasswordResetStatus)(0),         // 11: user_and_post.RequestPasswordResetResponse.RequestPasswordResetStatus
+	(ResetPasswordResponse_ResetPasswordStatus)(0),                       // 12: user_and_post.ResetPasswordResponse.ResetPasswordStatus
+	(LoginWithIdentityResponse_LoginWithIdentityStatus)(0),               // 13: user_and_post.LoginWithIdentityResponse.LoginWithIdentityStatus
+	(LinkIdentityResponse_LinkIdentityStatus)(0),                         // 14: user_and_post.LinkIdentityResponse.LinkIdentityStatus
+	(UnlinkIdentityResponse_UnlinkIdentityStatus)(0),                     // 15: user_and_post.UnlinkIdentityResponse.UnlinkIdentityStatus
+	(ListIdentitiesResponse_ListIdentitiesStatus)(0),                     // 16: user_and_post.ListIdentitiesResponse.ListIdentitiesStatus
+	(DeleteAccountResponse_DeleteAccountStatus)(0),                       // 17: user_and_post.DeleteAccountResponse.DeleteAccountStatus
+	(RequestDataExportResponse_RequestDataExportStatus)(0),               // 18: user_and_post.RequestDataExportResponse.RequestDataExportStatus
+	(DataExportInfo_DataExportState)(0),                                  // 19: user_and_post.DataExportInfo.DataExportState
+	(GetDataExportResponse_GetDataExportStatus)(0),                       // 20: user_and_post.GetDataExportResponse.GetDataExportStatus
+	(DownloadDataExportResponse_DownloadDataExportStatus)(0),             // 21: user_and_post.DownloadDataExportResponse.DownloadDataExportStatus
+	(ImportAccountResponse_ImportAccountStatus)(0),                       // 22: user_and_post.ImportAccountResponse.ImportAccountStatus
+	(GetUserResponse_GetUserStatus)(0),                                   // 23: user_and_post.GetUserResponse.GetUserStatus
+	(GetUsersResponse_GetUsersStatus)(0),                                 // 24: user_and_post.GetUsersResponse.GetUsersStatus
+	(SuspendUserResponse_SuspendUserStatus)(0),                           // 25: user_and_post.SuspendUserResponse.SuspendUserStatus
+	(ForceDeletePostResponse_ForceDeletePostStatus)(0),                   // 26: user_and_post.ForceDeletePostResponse.ForceDeletePostStatus
+	(ReportPostResponse_ReportPostStatus)(0),                             // 27: user_and_post.ReportPostResponse.ReportPostStatus
+	(ListReportsResponse_ListReportsStatus)(0),                           // 28: user_and_post.ListReportsResponse.ListReportsStatus
+	(FollowUserResponse_FollowStatus)(0),                                 // 29: user_and_post.FollowUserResponse.FollowStatus
+	(UnfollowUserResponse_UnfollowStatus)(0),                             // 30: user_and_post.UnfollowUserResponse.UnfollowStatus
+	(GetFollowerListResponse_GetFollowerListStatus)(0),                   // 31: user_and_post.GetFollowerListResponse.GetFollowerListStatus
+	(GetFollowingListResponse_GetFollowingListStatus)(0),                 // 32: user_and_post.GetFollowingListResponse.GetFollowingListStatus
+	(ListFollowRequestsResponse_ListFollowRequestsStatus)(0),             // 33: user_and_post.ListFollowRequestsResponse.ListFollowRequestsStatus
+	(ApproveFollowRequestResponse_ApproveFollowRequestStatus)(0),         // 34: user_and_post.ApproveFollowRequestResponse.ApproveFollowRequestStatus
+	(RejectFollowRequestResponse_RejectFollowRequestStatus)(0),           // 35: user_and_post.RejectFollowRequestResponse.RejectFollowRequestStatus
+	(CancelFollowRequestResponse_CancelFollowRequestStatus)(0),           // 36: user_and_post.CancelFollowRequestResponse.CancelFollowRequestStatus
+	(GetRelationshipResponse_GetRelationshipStatus)(0),                   // 37: user_and_post.GetRelationshipResponse.GetRelationshipStatus
+	(GetRelationshipsResponse_GetRelationshipsStatus)(0),                 // 38: user_and_post.GetRelationshipsResponse.GetRelationshipsStatus
+	(FollowEdgeResult_FollowEdgeOutcome)(0),                              // 39: user_and_post.FollowEdgeResult.FollowEdgeOutcome
+	(BulkFollowResponse_BulkFollowStatus)(0),                             // 40: user_and_post.BulkFollowResponse.BulkFollowStatus
+	(SuggestFollowsResponse_SuggestFollowsStatus)(0),                     // 41: user_and_post.SuggestFollowsResponse.SuggestFollowsStatus
+	(BlockUserResponse_BlockUserStatus)(0),                               // 42: user_and_post.BlockUserResponse.BlockUserStatus
+	(UnblockUserResponse_UnblockUserStatus)(0),                           // 43: user_and_post.UnblockUserResponse.UnblockUserStatus
+	(ListBlockedUsersResponse_ListBlockedUsersStatus)(0),                 // 44: user_and_post.ListBlockedUsersResponse.ListBlockedUsersStatus
+	(MuteUserResponse_MuteUserStatus)(0),                                 // 45: user_and_post.MuteUserResponse.MuteUserStatus
+	(UnmuteUserResponse_UnmuteUserStatus)(0),                             // 46: user_and_post.UnmuteUserResponse.UnmuteUserStatus
+	(ListMutedUsersResponse_ListMutedUsersStatus)(0),                     // 47: user_and_post.ListMutedUsersResponse.ListMutedUsersStatus
+	(AddCloseFriendResponse_AddCloseFriendStatus)(0),                     // 48: user_and_post.AddCloseFriendResponse.AddCloseFriendStatus
+	(RemoveCloseFriendResponse_RemoveCloseFriendStatus)(0),               // 49: user_and_post.RemoveCloseFriendResponse.RemoveCloseFriendStatus
+	(ListCloseFriendsResponse_ListCloseFriendsStatus)(0),                 // 50: user_and_post.ListCloseFriendsResponse.ListCloseFriendsStatus
+	(CreatePostResponse_CreatePostStatus)(0),                             // 51: user_and_post.CreatePostResponse.CreatePostStatus
+	(Post_Audience)(0),                                                   // 52: user_and_post.Post.Audience
+	(GetPostResponse_GetPostStatus)(0),                                   // 53: user_and_post.GetPostResponse.GetPostStatus
+	(DeletePostResponse_DeletePostStatus)(0),                             // 54: user_and_post.DeletePostResponse.DeletePostStatus
+	(EditPostResponse_EditPostStatus)(0),                                 // 55: user_and_post.EditPostResponse.EditPostStatus
+	(ListUserPostsResponse_ListUserPostsStatus)(0),                       // 56: user_and_post.ListUserPostsResponse.ListUserPostsStatus
+	(PinPostResponse_PinPostStatus)(0),                                   // 57: user_and_post.PinPostResponse.PinPostStatus
+	(UnpinPostResponse_UnpinPostStatus)(0),                               // 58: user_and_post.UnpinPostResponse.UnpinPostStatus
+	(ListPostRevisionsResponse_ListPostRevisionsStatus)(0),               // 59: user_and_post.ListPostRevisionsResponse.ListPostRevisionsStatus
+	(GetPostRevisionResponse_GetPostRevisionStatus)(0),                   // 60: user_and_post.GetPostRevisionResponse.GetPostRevisionStatus
+	(CommentPostResponse_CommentPostStatus)(0),                           // 61: user_and_post.CommentPostResponse.CommentPostStatus
+	(LikePostResponse_LikePostStatus)(0),                                 // 62: user_and_post.LikePostResponse.LikePostStatus
+	(*UserDetailInfo)(nil),                                               // 63: user_and_post.UserDetailInfo
+	(*FieldError)(nil),                                                   // 64: user_and_post.FieldError
+	(*UserResult)(nil),                                                   // 65: user_and_post.UserResult
+	(*EditUserRequest)(nil),                                              // 66: user_and_post.EditUserRequest
+	(*EditUserResponse)(nil),                                             // 67: user_and_post.EditUserResponse
+	(*ChangeUserNameRequest)(nil),                                        // 68: user_and_post.ChangeUserNameRequest
+	(*ChangeUserNameResponse)(nil),                                       // 69: user_and_post.ChangeUserNameResponse
+	(*AuthenticateUserRequest)(nil),                                      // 70: user_and_post.AuthenticateUserRequest
+	(*AuthenticateUserResponse)(nil),                                     // 71: user_and_post.AuthenticateUserResponse
+	(*EnrollSecondFactorRequest)(nil),                                    // 72: user_and_post.EnrollSecondFactorRequest
+	(*EnrollSecondFactorResponse)(nil),                                   // 73: user_and_post.EnrollSecondFactorResponse
+	(*ConfirmSecondFactorRequest)(nil),                                   // 74: user_and_post.ConfirmSecondFactorRequest
+	(*ConfirmSecondFactorResponse)(nil),                                  // 75: user_and_post.ConfirmSecondFactorResponse
+	(*GenerateRecoveryCodesRequest)(nil),                                 // 76: user_and_post.GenerateRecoveryCodesRequest
+	(*GenerateRecoveryCodesResponse)(nil),                                // 77: user_and_post.GenerateRecoveryCodesResponse
+	(*VerifySecondFactorRequest)(nil),                                    // 78: user_and_post.VerifySecondFactorRequest
+	(*VerifySecondFactorResponse)(nil),                                   // 79: user_and_post.VerifySecondFactorResponse
+	(*RequestEmailVerificationRequest)(nil),                              // 80: user_and_post.RequestEmailVerificationRequest
+	(*RequestEmailVerificationResponse)(nil),                             // 81: user_and_post.RequestEmailVerificationResponse
+	(*VerifyEmailRequest)(nil),                                           // 82: user_and_post.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),                                          // 83: user_and_post.VerifyEmailResponse
+	(*RequestPasswordResetRequest)(nil),                                  // 84: user_and_post.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),                                 // 85: user_and_post.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),                                         // 86: user_and_post.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),                                        // 87: user_and_post.ResetPasswordResponse
+	(*LoginWithIdentityRequest)(nil),                                     // 88: user_and_post.LoginWithIdentityRequest
+	(*LoginWithIdentityResponse)(nil),                                    // 89: user_and_post.LoginWithIdentityResponse
+	(*LinkIdentityRequest)(nil),                                          // 90: user_and_post.LinkIdentityRequest
+	(*LinkIdentityResponse)(nil),                                         // 91: user_and_post.LinkIdentityResponse
+	(*UnlinkIdentityRequest)(nil),                                        // 92: user_and_post.UnlinkIdentityRequest
+	(*UnlinkIdentityResponse)(nil),                                       // 93: user_and_post.UnlinkIdentityResponse
+	(*ListIdentitiesRequest)(nil),                                        // 94: user_and_post.ListIdentitiesRequest
+	(*IdentityInfo)(nil),                                                 // 95: user_and_post.IdentityInfo
+	(*ListIdentitiesResponse)(nil),                                       // 96: user_and_post.ListIdentitiesResponse
+	(*DeleteAccountRequest)(nil),                                         // 97: user_and_post.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),                                        // 98: user_and_post.DeleteAccountResponse
+	(*RequestDataExportRequest)(nil),                                     // 99: user_and_post.RequestDataExportRequest
+	(*RequestDataExportResponse)(nil),                                    // 100: user_and_post.RequestDataExportResponse
+	(*DataExportInfo)(nil),                                               // 101: user_and_post.DataExportInfo
+	(*GetDataExportRequest)(nil),                                         // 102: user_and_post.GetDataExportRequest
+	(*GetDataExportResponse)(nil),                                        // 103: user_and_post.GetDataExportResponse
+	(*DownloadDataExportRequest)(nil),                                    // 104: user_and_post.DownloadDataExportRequest
+	(*DownloadDataExportResponse)(nil),                                   // 105: user_and_post.DownloadDataExportResponse
+	(*ImportAccountRequest)(nil),                                         // 106: user_and_post.ImportAccountRequest
+	(*ImportReport)(nil),                                                 // 107: user_and_post.ImportReport
+	(*ImportAccountResponse)(nil),                                        // 108: user_and_post.ImportAccountResponse
+	(*ProfileVisibility)(nil),                                            // 109: user_and_post.ProfileVisibility
+	(*UserProfile)(nil),                                                  // 110: user_and_post.UserProfile
+	(*GetUserRequest)(nil),                                               // 111: user_and_post.GetUserRequest
+	(*GetUserByUserNameRequest)(nil),                                     // 112: user_and_post.GetUserByUserNameRequest
+	(*GetUserResponse)(nil),                                              // 113: user_and_post.GetUserResponse
+	(*GetUsersRequest)(nil),                                              // 114: user_and_post.GetUsersRequest
+	(*GetUsersResponse)(nil),                                             // 115: user_and_post.GetUsersResponse
+	(*CheckPermissionRequest)(nil),                                       // 116: user_and_post.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),                                      // 117: user_and_post.CheckPermissionResponse
+	(*SuspendUserRequest)(nil),                                           // 118: user_and_post.SuspendUserRequest
+	(*SuspendUserResponse)(nil),                                          // 119: user_and_post.SuspendUserResponse
+	(*ForceDeletePostRequest)(nil),                                       // 120: user_and_post.ForceDeletePostRequest
+	(*ForceDeletePostResponse)(nil),                                      // 121: user_and_post.ForceDeletePostResponse
+	(*ReportPostRequest)(nil),                                            // 122: user_and_post.ReportPostRequest
+	(*ReportPostResponse)(nil),                                           // 123: user_and_post.ReportPostResponse
+	(*ListReportsRequest)(nil),                                           // 124: user_and_post.ListReportsRequest
+	(*ReportInfo)(nil),                                                   // 125: user_and_post.ReportInfo
+	(*ListReportsResponse)(nil),                                          // 126: user_and_post.ListReportsResponse
+	(*FollowUserRequest)(nil),                                            // 127: user_and_post.FollowUserRequest
+	(*FollowUserResponse)(nil),                                           // 128: user_and_post.FollowUserResponse
+	(*UnfollowUserRequest)(nil),                                          // 129: user_and_post.UnfollowUserRequest
+	(*UnfollowUserResponse)(nil),                                         // 130: user_and_post.UnfollowUserResponse
+	(*GetFollowerListRequest)(nil),                                       // 131: user_and_post.GetFollowerListRequest
+	(*FollowInfo)(nil),                                                   // 132: user_and_post.FollowInfo
+	(*GetFollowerListResponse)(nil),                                      // 133: user_and_post.GetFollowerListResponse
+	(*GetFollowingListRequest)(nil),                                      // 134: user_and_post.GetFollowingListRequest
+	(*GetFollowingListResponse)(nil),                                     // 135: user_and_post.GetFollowingListResponse
+	(*ListFollowRequestsRequest)(nil),                                    // 136: user_and_post.ListFollowRequestsRequest
+	(*ListFollowRequestsResponse)(nil),                                   // 137: user_and_post.ListFollowRequestsResponse
+	(*ApproveFollowRequestRequest)(nil),                                  // 138: user_and_post.ApproveFollowRequestRequest
+	(*ApproveFollowRequestResponse)(nil),                                 // 139: user_and_post.ApproveFollowRequestResponse
+	(*RejectFollowRequestRequest)(nil),                                   // 140: user_and_post.RejectFollowRequestRequest
+	(*RejectFollowRequestResponse)(nil),                                  // 141: user_and_post.RejectFollowRequestResponse
+	(*CancelFollowRequestRequest)(nil),                                   // 142: user_and_post.CancelFollowRequestRequest
+	(*CancelFollowRequestResponse)(nil),                                  // 143: user_and_post.CancelFollowRequestResponse
+	(*Relationship)(nil),                                                 // 144: user_and_post.Relationship
+	(*GetRelationshipRequest)(nil),                                       // 145: user_and_post.GetRelationshipRequest
+	(*GetRelationshipResponse)(nil),                                      // 146: user_and_post.GetRelationshipResponse
+	(*GetRelationshipsRequest)(nil),                                      // 147: user_and_post.GetRelationshipsRequest
+	(*GetRelationshipsResponse)(nil),                                     // 148: user_and_post.GetRelationshipsResponse
+	(*FollowEdge)(nil),                                                   // 149: user_and_post.FollowEdge
+	(*BulkFollowRequest)(nil),                                            // 150: user_and_post.BulkFollowRequest
+	(*FollowEdgeResult)(nil),                                             // 151: user_and_post.FollowEdgeResult
+	(*BulkFollowResponse)(nil),                                           // 152: user_and_post.BulkFollowResponse
+	(*SuggestFollowsRequest)(nil),                                        // 153: user_and_post.SuggestFollowsRequest
+	(*FollowSuggestion)(nil),                                             // 154: user_and_post.FollowSuggestion
+	(*SuggestFollowsResponse)(nil),                                       // 155: user_and_post.SuggestFollowsResponse
+	(*UserInfo)(nil),                                                     // 156: user_and_post.UserInfo
+	(*RelatedUser)(nil),                                                  // 157: user_and_post.RelatedUser
+	(*BlockUserRequest)(nil),                                             // 158: user_and_post.BlockUserRequest
+	(*BlockUserResponse)(nil),                                            // 159: user_and_post.BlockUserResponse
+	(*UnblockUserRequest)(nil),                                           // 160: user_and_post.UnblockUserRequest
+	(*UnblockUserResponse)(nil),                                          // 161: user_and_post.UnblockUserResponse
+	(*ListBlockedUsersRequest)(nil),                                      // 162: user_and_post.ListBlockedUsersRequest
+	(*ListBlockedUsersResponse)(nil),                                     // 163: user_and_post.ListBlockedUsersResponse
+	(*MuteUserRequest)(nil),                                              // 164: user_and_post.MuteUserRequest
+	(*MuteUserResponse)(nil),                                             // 165: user_and_post.MuteUserResponse
+	(*UnmuteUserRequest)(nil),                                            // 166: user_and_post.UnmuteUserRequest
+	(*UnmuteUserResponse)(nil),                                           // 167: user_and_post.UnmuteUserResponse
+	(*ListMutedUsersRequest)(nil),                                        // 168: user_and_post.ListMutedUsersRequest
+	(*ListMutedUsersResponse)(nil),                                       // 169: user_and_post.ListMutedUsersResponse
+	(*AddCloseFriendRequest)(nil),                                        // 170: user_and_post.AddCloseFriendRequest
+	(*AddCloseFriendResponse)(nil),                                       // 171: user_and_post.AddCloseFriendResponse
+	(*RemoveCloseFriendRequest)(nil),                                     // 172: user_and_post.RemoveCloseFriendRequest
+	(*RemoveCloseFriendResponse)(nil),                                    // 173: user_and_post.RemoveCloseFriendResponse
+	(*ListCloseFriendsRequest)(nil),                                      // 174: user_and_post.ListCloseFriendsRequest
+	(*ListCloseFriendsResponse)(nil),                                     // 175: user_and_post.ListCloseFriendsResponse
+	(*CreatePostRequest)(nil),                                            // 176: user_and_post.CreatePostRequest
+	(*CreatePostResponse)(nil),                                           // 177: user_and_post.CreatePostResponse
+	(*GetPostRequest)(nil),                                               // 178: user_and_post.GetPostRequest
+	(*Post)(nil),                                                         // 179: user_and_post.Post
+	(*GetPostResponse)(nil),                                              // 180: user_and_post.GetPostResponse
+	(*DeletePostRequest)(nil),                                            // 181: user_and_post.DeletePostRequest
+	(*DeletePostResponse)(nil),                                           // 182: user_and_post.DeletePostResponse
+	(*EditPostRequest)(nil),                                              // 183: user_and_post.EditPostRequest
+	(*EditPostResponse)(nil),                                             // 184: user_and_post.EditPostResponse
+	(*ListUserPostsRequest)(nil),                                         // 185: user_and_post.ListUserPostsRequest
+	(*ListUserPostsResponse)(nil),                                        // 186: user_and_post.ListUserPostsResponse
+	(*PinPostRequest)(nil),                                               // 187: user_and_post.PinPostRequest
+	(*PinPostResponse)(nil),                                              // 188: user_and_post.PinPostResponse
+	(*UnpinPostRequest)(nil),                                             // 189: user_and_post.UnpinPostRequest
+	(*UnpinPostResponse)(nil),                                            // 190: user_and_post.UnpinPostResponse
+	(*PostRevision)(nil),                                                 // 191: user_and_post.PostRevision
+	(*ListPostRevisionsRequest)(nil),                                     // 192: user_and_post.ListPostRevisionsRequest
+	(*ListPostRevisionsResponse)(nil),                                    // 193: user_and_post.ListPostRevisionsResponse
+	(*GetPostRevisionRequest)(nil),                                       // 194: user_and_post.GetPostRevisionRequest
+	(*GetPostRevisionResponse)(nil),                                      // 195: user_and_post.GetPostRevisionResponse
+	(*CommentPostRequest)(nil),                                           // 196: user_and_post.CommentPostRequest
+	(*CommentPostResponse)(nil),                                          // 197: user_and_post.CommentPostResponse
+	(*LikePostRequest)(nil),                                              // 198: user_and_post.LikePostRequest
+	(*LikePostResponse)(nil),                                             // 199: user_and_post.LikePostResponse
+	(*timestamp.Timestamp)(nil),                                          // 200: google.protobuf.Timestamp
+}
+var file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_depIdxs = []int32{
+	200, // 0: user_and_post.UserDetailInfo.dob:type_name -> google.protobuf.Timestamp
+	1,   // 1: user_and_post.UserResult.status:type_name -> user_and_post.UserResult.UserStatus
+	63,  // 2: user_and_post.UserResult.info:type_name -> user_and_post.UserDetailInfo
+	64,  // 3: user_and_post.UserResult.field_errors:type_name -> user_and_post.FieldError
+	200, // 4: user_and_post.EditUserRequest.dob:type_name -> google.protobuf.Timestamp
+	0,   // 5: user_and_post.EditUserRequest.bio_visibility:type_name -> user_and_post.Visibility
+	0,   // 6: user_and_post.EditUserRequest.avatar_visibility:type_name -> user_and_post.Visibility
+	0,   // 7: user_and_post.EditUserRequest.location_visibility:type_name -> user_and_post.Visibility
+	0,   // 8: user_and_post.EditUserRequest.website_visibility:type_name -> user_and_post.Visibility
+	0,   // 9: user_and_post.EditUserRequest.pronouns_visibility:type_name -> user_and_post.Visibility
+	2,   // 10: user_and_post.EditUserResponse.status:type_name -> user_and_post.EditUserResponse.EditUserStatus
+	64,  // 11: user_and_post.EditUserResponse.field_errors:type_name -> user_and_post.FieldError
+	3,   // 12: user_and_post.ChangeUserNameResponse.status:type_name -> user_and_post.ChangeUserNameResponse.ChangeUserNameStatus
+	64,  // 13: user_and_post.ChangeUserNameResponse.field_errors:type_name -> user_and_post.FieldError
+	4,   // 14: user_and_post.AuthenticateUserResponse.status:type_name -> user_and_post.AuthenticateUserResponse.AuthenticateUserStatus
+	5,   // 15: user_and_post.EnrollSecondFactorResponse.status:type_name -> user_and_post.EnrollSecondFactorResponse.EnrollSecondFactorStatus
+	6,   // 16: user_and_post.ConfirmSecondFactorResponse.status:type_name -> user_and_post.ConfirmSecondFactorResponse.ConfirmSecondFactorStatus
+	7,   // 17: user_and_post.GenerateRecoveryCodesResponse.status:type_name -> user_and_post.GenerateRecoveryCodesResponse.GenerateRecoveryCodesStatus
+	8,   // 18: user_and_post.VerifySecondFactorResponse.status:type_name -> user_and_post.VerifySecondFactorResponse.VerifySecondFactorStatus
+	9,   // 19: user_and_post.RequestEmailVerificationResponse.status:type_name -> user_and_post.RequestEmailVerificationResponse.RequestEmailVerificationStatus
+	10,  // 20: user_and_post.VerifyEmailResponse.status:type_name -> user_and_post.VerifyEmailResponse.VerifyEmailStatus
+	11,  // 21: user_and_post.RequestPasswordResetResponse.status:type_name -> user_and_post.RequestPasswordResetResponse.RequestPasswordResetStatus
+	12,  // 22: user_and_post.ResetPasswordResponse.status:type_name -> user_and_post.ResetPasswordResponse.ResetPasswordStatus
+	64,  // 23: user_and_post.ResetPasswordResponse.field_errors:type_name -> user_and_post.FieldError
+	13,  // 24: user_and_post.LoginWithIdentityResponse.status:type_name -> user_and_post.LoginWithIdentityResponse.LoginWithIdentityStatus
+	14,  // 25: user_and_post.LinkIdentityResponse.status:type_name -> user_and_post.LinkIdentityResponse.LinkIdentityStatus
+	15,  // 26: user_and_post.UnlinkIdentityResponse.status:type_name -> user_and_post.UnlinkIdentityResponse.UnlinkIdentityStatus
+	200, // 27: user_and_post.IdentityInfo.linked_time:type_name -> google.protobuf.Timestamp
+	16,  // 28: user_and_post.ListIdentitiesResponse.status:type_name -> user_and_post.ListIdentitiesResponse.ListIdentitiesStatus
+	95,  // 29: user_and_post.ListIdentitiesResponse.identities:type_name -> user_and_post.IdentityInfo
+	17,  // 30: user_and_post.DeleteAccountResponse.status:type_name -> user_and_post.DeleteAccountResponse.DeleteAccountStatus
+	200, // 31: user_and_post.DeleteAccountResponse.purge_time:type_name -> google.protobuf.Timestamp
+	18,  // 32: user_and_post.RequestDataExportResponse.status:type_name -> user_and_post.RequestDataExportResponse.RequestDataExportStatus
+	19,  // 33: user_and_post.DataExportInfo.state:type_name -> user_and_post.DataExportInfo.DataExportState
+	200, // 34: user_and_post.DataExportInfo.created_time:type_name -> google.protobuf.Timestamp
+	200, // 35: user_and_post.DataExportInfo.completed_time:type_name -> google.protobuf.Timestamp
+	200, // 36: user_and_post.DataExportInfo.expires_time:type_name -> google.protobuf.Timestamp
+	20,  // 37: user_and_post.GetDataExportResponse.status:type_name -> user_and_post.GetDataExportResponse.GetDataExportStatus
+	101, // 38: user_and_post.GetDataExportResponse.export:type_name -> user_and_post.DataExportInfo
+	21,  // 39: user_and_post.DownloadDataExportResponse.status:type_name -> user_and_post.DownloadDataExportResponse.DownloadDataExportStatus
+	22,  // 40: user_and_post.ImportAccountResponse.status:type_name -> user_and_post.ImportAccountResponse.ImportAccountStatus
+	107, // 41: user_and_post.ImportAccountResponse.report:type_name -> user_and_post.ImportReport
+	0,   // 42: user_and_post.ProfileVisibility.bio:type_name -> user_and_post.Visibility
+	0,   // 43: user_and_post.ProfileVisibility.avatar:type_name -> user_and_post.Visibility
+	0,   // 44: user_and_post.ProfileVisibility.location:type_name -> user_and_post.Visibility
+	0,   // 45: user_and_post.ProfileVisibility.website:type_name -> user_and_post.Visibility
+	0,   // 46: user_and_post.ProfileVisibility.pronouns:type_name -> user_and_post.Visibility
+	109, // 47: user_and_post.UserProfile.visibility:type_name -> user_and_post.ProfileVisibility
+	23,  // 48: user_and_post.GetUserResponse.status:type_name -> user_and_post.GetUserResponse.GetUserStatus
+	110, // 49: user_and_post.GetUserResponse.profile:type_name -> user_and_post.UserProfile
+	24,  // 50: user_and_post.GetUsersResponse.status:type_name -> user_and_post.GetUsersResponse.GetUsersStatus
+	110, // 51: user_and_post.GetUsersResponse.profiles:type_name -> user_and_post.UserProfile
+	25,  // 52: user_and_post.SuspendUserResponse.status:type_name -> user_and_post.SuspendUserResponse.SuspendUserStatus
+	26,  // 53: user_and_post.ForceDeletePostResponse.status:type_name -> user_and_post.ForceDeletePostResponse.ForceDeletePostStatus
+	27,  // 54: user_and_post.ReportPostResponse.status:type_name -> user_and_post.ReportPostResponse.ReportPostStatus
+	200, // 55: user_and_post.ReportInfo.created_time:type_name -> google.protobuf.Timestamp
+	200, // 56: user_and_post.ReportInfo.resolved_time:type_name -> google.protobuf.Timestamp
+	28,  // 57: user_and_post.ListReportsResponse.status:type_name -> user_and_post.ListReportsResponse.ListReportsStatus
+	125, // 58: user_and_post.ListReportsResponse.reports:type_name -> user_and_post.ReportInfo
+	29,  // 59: user_and_post.FollowUserResponse.status:type_name -> user_and_post.FollowUserResponse.FollowStatus
+	30,  // 60: user_and_post.UnfollowUserResponse.status:type_name -> user_and_post.UnfollowUserResponse.UnfollowStatus
+	200, // 61: user_and_post.FollowInfo.followed_time:type_name -> google.protobuf.Timestamp
+	31,  // 62: user_and_post.GetFollowerListResponse.status:type_name -> user_and_post.GetFollowerListResponse.GetFollowerListStatus
+	132, // 63: user_and_post.GetFollowerListResponse.followers:type_name -> user_and_post.FollowInfo
+	32,  // 64: user_and_post.GetFollowingListResponse.status:type_name -> user_and_post.GetFollowingListResponse.GetFollowingListStatus
+	132, // 65: user_and_post.GetFollowingListResponse.following:type_name -> user_and_post.FollowInfo
+	33,  // 66: user_and_post.ListFollowRequestsResponse.status:type_name -> user_and_post.ListFollowRequestsResponse.ListFollowRequestsStatus
+	157, // 67: user_and_post.ListFollowRequestsResponse.requesters:type_name -> user_and_post.RelatedUser
+	34,  // 68: user_and_post.ApproveFollowRequestResponse.status:type_name -> user_and_post.ApproveFollowRequestResponse.ApproveFollowRequestStatus
+	35,  // 69: user_and_post.RejectFollowRequestResponse.status:type_name -> user_and_post.RejectFollowRequestResponse.RejectFollowRequestStatus
+	36,  // 70: user_and_post.CancelFollowRequestResponse.status:type_name -> user_and_post.CancelFollowRequestResponse.CancelFollowRequestStatus
+	37,  // 71: user_and_post.GetRelationshipResponse.status:type_name -> user_and_post.GetRelationshipResponse.GetRelationshipStatus
+	144, // 72: user_and_post.GetRelationshipResponse.relationship:type_name -> user_and_post.Relationship
+	38,  // 73: user_and_post.GetRelationshipsResponse.status:type_name -> user_and_post.GetRelationshipsResponse.GetRelationshipsStatus
+	144, // 74: user_and_post.GetRelationshipsResponse.relationships:type_name -> user_and_post.Relationship
+	149, // 75: user_and_post.BulkFollowRequest.edges:type_name -> user_and_post.FollowEdge
+	149, // 76: user_and_post.FollowEdgeResult.edge:type_name -> user_and_post.FollowEdge
+	39,  // 77: user_and_post.FollowEdgeResult.outcome:type_name -> user_and_post.FollowEdgeResult.FollowEdgeOutcome
+	40,  // 78: user_and_post.BulkFollowResponse.status:type_name -> user_and_post.BulkFollowResponse.BulkFollowStatus
+	151, // 79: user_and_post.BulkFollowResponse.results:type_name -> user_and_post.FollowEdgeResult
+	41,  // 80: user_and_post.SuggestFollowsResponse.status:type_name -> user_and_post.SuggestFollowsResponse.SuggestFollowsStatus
+	154, // 81: user_and_post.SuggestFollowsResponse.suggestions:type_name -> user_and_post.FollowSuggestion
+	200, // 82: user_and_post.RelatedUser.since_time:type_name -> google.protobuf.Timestamp
+	42,  // 83: user_and_post.BlockUserResponse.status:type_name -> user_and_post.BlockUserResponse.BlockUserStatus
+	43,  // 84: user_and_post.UnblockUserResponse.status:type_name -> user_and_post.UnblockUserResponse.UnblockUserStatus
+	44,  // 85: user_and_post.ListBlockedUsersResponse.status:type_name -> user_and_post.ListBlockedUsersResponse.ListBlockedUsersStatus
+	157, // 86: user_and_post.ListBlockedUsersResponse.users:type_name -> user_and_post.RelatedUser
+	45,  // 87: user_and_post.MuteUserResponse.status:type_name -> user_and_post.MuteUserResponse.MuteUserStatus
+	46,  // 88: user_and_post.UnmuteUserResponse.status:type_name -> user_and_post.UnmuteUserResponse.UnmuteUserStatus
+	47,  // 89: user_and_post.ListMutedUsersResponse.status:type_name -> user_and_post.ListMutedUsersResponse.ListMutedUsersStatus
+	157, // 90: user_and_post.ListMutedUsersResponse.users:type_name -> user_and_post.RelatedUser
+	48,  // 91: user_and_post.AddCloseFriendResponse.status:type_name -> user_and_post.AddCloseFriendResponse.AddCloseFriendStatus
+	49,  // 92: user_and_post.RemoveCloseFriendResponse.status:type_name -> user_and_post.RemoveCloseFriendResponse.RemoveCloseFriendStatus
+	50,  // 93: user_and_post.ListCloseFriendsResponse.status:type_name -> user_and_post.ListCloseFriendsResponse.ListCloseFriendsStatus
+	157, // 94: user_and_post.ListCloseFriendsResponse.users:type_name -> user_and_post.RelatedUser
+	52,  // 95: user_and_post.CreatePostRequest.audience:type_name -> user_and_post.Post.Audience
+	51,  // 96: user_and_post.CreatePostResponse.status:type_name -> user_and_post.CreatePostResponse.CreatePostStatus
+	200, // 97: user_and_post.Post.created_time:type_name -> google.protobuf.Timestamp
+	52,  // 98: user_and_post.Post.audience:type_name -> user_and_post.Post.Audience
+	200, // 99: user_and_post.Post.pinned_time:type_name -> google.protobuf.Timestamp
+	200, // 100: user_and_post.Post.edited_time:type_name -> google.protobuf.Timestamp
+	53,  // 101: user_and_post.GetPostResponse.status:type_name -> user_and_post.GetPostResponse.GetPostStatus
+	179, // 102: user_and_post.GetPostResponse.post:type_name -> user_and_post.Post
+	54,  // 103: user_and_post.DeletePostResponse.status:type_name -> user_and_post.DeletePostResponse.DeletePostStatus
+	52,  // 104: user_and_post.EditPostRequest.audience:type_name -> user_and_post.Post.Audience
+	55,  // 105: user_and_post.EditPostResponse.status:type_name -> user_and_post.EditPostResponse.EditPostStatus
+	56,  // 106: user_and_post.ListUserPostsResponse.status:type_name -> user_and_post.ListUserPostsResponse.ListUserPostsStatus
+	179, // 107: user_and_post.ListUserPostsResponse.pinned_posts:type_name -> user_and_post.Post
+	179, // 108: user_and_post.ListUserPostsResponse.posts:type_name -> user_and_post.Post
+	57,  // 109: user_and_post.PinPostResponse.status:type_name -> user_and_post.PinPostResponse.PinPostStatus
+	58,  // 110: user_and_post.UnpinPostResponse.status:type_name -> user_and_post.UnpinPostResponse.UnpinPostStatus
+	52,  // 111: user_and_post.PostRevision.audience:type_name -> user_and_post.Post.Audience
+	200, // 112: user_and_post.PostRevision.created_time:type_name -> google.protobuf.Timestamp
+	59,  // 113: user_and_post.ListPostRevisionsResponse.status:type_name -> user_and_post.ListPostRevisionsResponse.ListPostRevisionsStatus
+	191, // 114: user_and_post.ListPostRevisionsResponse.revisions:type_name -> user_and_post.PostRevision
+	60,  // 115: user_and_post.GetPostRevisionResponse.status:type_name -> user_and_post.GetPostRevisionResponse.GetPostRevisionStatus
+	191, // 116: user_and_post.GetPostRevisionResponse.revision:type_name -> user_and_post.PostRevision
+	61,  // 117: user_and_post.CommentPostResponse.status:type_name -> user_and_post.CommentPostResponse.CommentPostStatus
+	62,  // 118: user_and_post.LikePostResponse.status:type_name -> user_and_post.LikePostResponse.LikePostStatus
+	63,  // 119: user_and_post.UserAndPost.CreateUser:input_type -> user_and_post.UserDetailInfo
+	66,  // 120: user_and_post.UserAndPost.EditUser:input_type -> user_and_post.EditUserRequest
+	68,  // 121: user_and_post.UserAndPost.ChangeUserName:input_type -> user_and_post.ChangeUserNameRequest
+	70,  // 122: user_and_post.UserAndPost.AuthenticateUser:input_type -> user_and_post.AuthenticateUserRequest
+	97,  // 123: user_and_post.UserAndPost.DeleteAccount:input_type -> user_and_post.DeleteAccountRequest
+	99,  // 124: user_and_post.UserAndPost.RequestDataExport:input_type -> user_and_post.RequestDataExportRequest
+	102, // 125: user_and_post.UserAndPost.GetDataExport:input_type -> user_and_post.GetDataExportRequest
+	104, // 126: user_and_post.UserAndPost.DownloadDataExport:input_type -> user_and_post.DownloadDataExportRequest
+	106, // 127: user_and_post.UserAndPost.ImportAccount:input_type -> user_and_post.ImportAccountRequest
+	111, // 128: user_and_post.UserAndPost.GetUser:input_type -> user_and_post.GetUserRequest
+	112, // 129: user_and_post.UserAndPost.GetUserByUserName:input_type -> user_and_post.GetUserByUserNameRequest
+	114, // 130: user_and_post.UserAndPost.GetUsers:input_type -> user_and_post.GetUsersRequest
+	72,  // 131: user_and_post.UserAndPost.EnrollSecondFactor:input_type -> user_and_post.EnrollSecondFactorRequest
+	74,  // 132: user_and_post.UserAndPost.ConfirmSecondFactor:input_type -> user_and_post.ConfirmSecondFactorRequest
+	76,  // 133: user_and_post.UserAndPost.GenerateRecoveryCodes:input_type -> user_and_post.GenerateRecoveryCodesRequest
+	78,  // 134: user_and_post.UserAndPost.VerifySecondFactor:input_type -> user_and_post.VerifySecondFactorRequest
+	80,  // 135: user_and_post.UserAndPost.RequestEmailVerification:input_type -> user_and_post.RequestEmailVerificationRequest
+	82,  // 136: user_and_post.UserAndPost.VerifyEmail:input_type -> user_and_post.VerifyEmailRequest
+	84,  // 137: user_and_post.UserAndPost.RequestPasswordReset:input_type -> user_and_post.RequestPasswordResetRequest
+	86,  // 138: user_and_post.UserAndPost.ResetPassword:input_type -> user_and_post.ResetPasswordRequest
+	88,  // 139: user_and_post.UserAndPost.LoginWithIdentity:input_type -> user_and_post.LoginWithIdentityRequest
+	90,  // 140: user_and_post.UserAndPost.LinkIdentity:input_type -> user_and_post.LinkIdentityRequest
+	92,  // 141: user_and_post.UserAndPost.UnlinkIdentity:input_type -> user_and_post.UnlinkIdentityRequest
+	94,  // 142: user_and_post.UserAndPost.ListIdentities:input_type -> user_and_post.ListIdentitiesRequest
+	116, // 143: user_and_post.UserAndPost.CheckPermission:input_type -> user_and_post.CheckPermissionRequest
+	118, // 144: user_and_post.UserAndPost.SuspendUser:input_type -> user_and_post.SuspendUserRequest
+	120, // 145: user_and_post.UserAndPost.ForceDeletePost:input_type -> user_and_post.ForceDeletePostRequest
+	122, // 146: user_and_post.UserAndPost.ReportPost:input_type -> user_and_post.ReportPostRequest
+	124, // 147: user_and_post.UserAndPost.ListReports:input_type -> user_and_post.ListReportsRequest
+	127, // 148: user_and_post.UserAndPost.FollowUser:input_type -> user_and_post.FollowUserRequest
+	129, // 149: user_and_post.UserAndPost.UnfollowUser:input_type -> user_and_post.UnfollowUserRequest
+	131, // 150: user_and_post.UserAndPost.GetFollowerList:input_type -> user_and_post.GetFollowerListRequest
+	134, // 151: user_and_post.UserAndPost.GetFollowingList:input_type -> user_and_post.GetFollowingListRequest
+	136, // 152: user_and_post.UserAndPost.ListFollowRequests:input_type -> user_and_post.ListFollowRequestsRequest
+	138, // 153: user_and_post.UserAndPost.ApproveFollowRequest:input_type -> user_and_post.ApproveFollowRequestRequest
+	140, // 154: user_and_post.UserAndPost.RejectFollowRequest:input_type -> user_and_post.RejectFollowRequestRequest
+	142, // 155: user_and_post.UserAndPost.CancelFollowRequest:input_type -> user_and_post.CancelFollowRequestRequest
+	145, // 156: user_and_post.UserAndPost.GetRelationship:input_type -> user_and_post.GetRelationshipRequest
+	147, // 157: user_and_post.UserAndPost.GetRelationships:input_type -> user_and_post.GetRelationshipsRequest
+	153, // 158: user_and_post.UserAndPost.SuggestFollows:input_type -> user_and_post.SuggestFollowsRequest
+	150, // 159: user_and_post.UserAndPost.BulkFollow:input_type -> user_and_post.BulkFollowRequest
+	150, // 160: user_and_post.UserAndPost.BulkUnfollow:input_type -> user_and_post.BulkFollowRequest
+	158, // 161: user_and_post.UserAndPost.BlockUser:input_type -> user_and_post.BlockUserRequest
+	160, // 162: user_and_post.UserAndPost.UnblockUser:input_type -> user_and_post.UnblockUserRequest
+	162, // 163: user_and_post.UserAndPost.ListBlockedUsers:input_type -> user_and_post.ListBlockedUsersRequest
+	164, // 164: user_and_post.UserAndPost.MuteUser:input_type -> user_and_post.MuteUserRequest
+	166, // 165: user_and_post.UserAndPost.UnmuteUser:input_type -> user_and_post.UnmuteUserRequest
+	168, // 166: user_and_post.UserAndPost.ListMutedUsers:input_type -> user_and_post.ListMutedUsersRequest
+	170, // 167: user_and_post.UserAndPost.AddCloseFriend:input_type -> user_and_post.AddCloseFriendRequest
+	172, // 168: user_and_post.UserAndPost.RemoveCloseFriend:input_type -> user_and_post.RemoveCloseFriendRequest
+	174, // 169: user_and_post.UserAndPost.ListCloseFriends:input_type -> user_and_post.ListCloseFriendsRequest
+	176, // 170: user_and_post.UserAndPost.CreatePost:input_type -> user_and_post.CreatePostRequest
+	178, // 171: user_and_post.UserAndPost.GetPost:input_type -> user_and_post.GetPostRequest
+	181, // 172: user_and_post.UserAndPost.DeletePost:input_type -> user_and_post.DeletePostRequest
+	183, // 173: user_and_post.UserAndPost.EditPost:input_type -> user_and_post.EditPostRequest
+	185, // 174: user_and_post.UserAndPost.ListUserPosts:input_type -> user_and_post.ListUserPostsRequest
+	187, // 175: user_and_post.UserAndPost.PinPost:input_type -> user_and_post.PinPostRequest
+	189, // 176: user_and_post.UserAndPost.UnpinPost:input_type -> user_and_post.UnpinPostRequest
+	192, // 177: user_and_post.UserAndPost.ListPostRevisions:input_type -> user_and_post.ListPostRevisionsRequest
+	194, // 178: user_and_post.UserAndPost.GetPostRevision:input_type -> user_and_post.GetPostRevisionRequest
+	198, // 179: user_and_post.UserAndPost.LikePost:input_type -> user_and_post.LikePostRequest
+	196, // 180: user_and_post.UserAndPost.CommentPost:input_type -> user_and_post.CommentPostRequest
+	65,  // 181: user_and_post.UserAndPost.CreateUser:output_type -> user_and_post.UserResult
+	67,  // 182: user_and_post.UserAndPost.EditUser:output_type -> user_and_post.EditUserResponse
+	69,  // 183: user_and_post.UserAndPost.ChangeUserName:output_type -> user_and_post.ChangeUserNameResponse
+	71,  // 184: user_and_post.UserAndPost.AuthenticateUser:output_type -> user_and_post.AuthenticateUserResponse
+	98,  // 185: user_and_post.UserAndPost.DeleteAccount:output_type -> user_and_post.DeleteAccountResponse
+	100, // 186: user_and_post.UserAndPost.RequestDataExport:output_type -> user_and_post.RequestDataExportResponse
+	103, // 187: user_and_post.UserAndPost.GetDataExport:output_type -> user_and_post.GetDataExportResponse
+	105, // 188: user_and_post.UserAndPost.DownloadDataExport:output_type -> user_and_post.DownloadDataExportResponse
+	108, // 189: user_and_post.UserAndPost.ImportAccount:output_type -> user_and_post.ImportAccountResponse
+	113, // 190: user_and_post.UserAndPost.GetUser:output_type -> user_and_post.GetUserResponse
+	113, // 191: user_and_post.UserAndPost.GetUserByUserName:output_type -> user_and_post.GetUserResponse
+	115, // 192: user_and_post.UserAndPost.GetUsers:output_type -> user_and_post.GetUsersResponse
+	73,  // 193: user_and_post.UserAndPost.EnrollSecondFactor:output_type -> user_and_post.EnrollSecondFactorResponse
+	75,  // 194: user_and_post.UserAndPost.ConfirmSecondFactor:output_type -> user_and_post.ConfirmSecondFactorResponse
+	77,  // 195: user_and_post.UserAndPost.GenerateRecoveryCodes:output_type -> user_and_post.GenerateRecoveryCodesResponse
+	79,  // 196: user_and_post.UserAndPost.VerifySecondFactor:output_type -> user_and_post.VerifySecondFactorResponse
+	81,  // 197: user_and_post.UserAndPost.RequestEmailVerification:output_type -> user_and_post.RequestEmailVerificationResponse
+	83,  // 198: user_and_post.UserAndPost.VerifyEmail:output_type -> user_and_post.VerifyEmailResponse
+	85,  // 199: user_and_post.UserAndPost.RequestPasswordReset:output_type -> user_and_post.RequestPasswordResetResponse
+	87,  // 200: user_and_post.UserAndPost.ResetPassword:output_type -> user_and_post.ResetPasswordResponse
+	89,  // 201: user_and_post.UserAndPost.LoginWithIdentity:output_type -> user_and_post.LoginWithIdentityResponse
+	91,  // 202: user_and_post.UserAndPost.LinkIdentity:output_type -> user_and_post.LinkIdentityResponse
+	93,  // 203: user_and_post.UserAndPost.UnlinkIdentity:output_type -> user_and_post.UnlinkIdentityResponse
+	96,  // 204: user_and_post.UserAndPost.ListIdentities:output_type -> user_and_post.ListIdentitiesResponse
+	117, // 205: user_and_post.UserAndPost.CheckPermission:output_type -> user_and_post.CheckPermissionResponse
+	119, // 206: user_and_post.UserAndPost.SuspendUser:output_type -> user_and_post.SuspendUserResponse
+	121, // 207: user_and_post.UserAndPost.ForceDeletePost:output_type -> user_and_post.ForceDeletePostResponse
+	123, // 208: user_and_post.UserAndPost.ReportPost:output_type -> user_and_post.ReportPostResponse
+	126, // 209: user_and_post.UserAndPost.ListReports:output_type -> user_and_post.ListReportsResponse
+	128, // 210: user_and_post.UserAndPost.FollowUser:output_type -> user_and_post.FollowUserResponse
+	130, // 211: user_and_post.UserAndPost.UnfollowUser:output_type -> user_and_post.UnfollowUserResponse
+	133, // 212: user_and_post.UserAndPost.GetFollowerList:output_type -> user_and_post.GetFollowerListResponse
+	135, // 213: user_and_post.UserAndPost.GetFollowingList:output_type -> user_and_post.GetFollowingListResponse
+	137, // 214: user_and_post.UserAndPost.ListFollowRequests:output_type -> user_and_post.ListFollowRequestsResponse
+	139, // 215: user_and_post.UserAndPost.ApproveFollowRequest:output_type -> user_and_post.ApproveFollowRequestResponse
+	141, // 216: user_and_post.UserAndPost.RejectFollowRequest:output_type -> user_and_post.RejectFollowRequestResponse
+	143, // 217: user_and_post.UserAndPost.CancelFollowRequest:output_type -> user_and_post.CancelFollowRequestResponse
+	146, // 218: user_and_post.UserAndPost.GetRelationship:output_type -> user_and_post.GetRelationshipResponse
+	148, // 219: user_and_post.UserAndPost.GetRelationships:output_type -> user_and_post.GetRelationshipsResponse
+	155, // 220: user_and_post.UserAndPost.SuggestFollows:output_type -> user_and_post.SuggestFollowsResponse
+	152, // 221: user_and_post.UserAndPost.BulkFollow:output_type -> user_and_post.BulkFollowResponse
+	152, // 222: user_and_post.UserAndPost.BulkUnfollow:output_type -> user_and_post.BulkFollowResponse
+	159, // 223: user_and_post.UserAndPost.BlockUser:output_type -> user_and_post.BlockUserResponse
+	161, // 224: user_and_post.UserAndPost.UnblockUser:output_type -> user_and_post.UnblockUserResponse
+	163, // 225: user_and_post.UserAndPost.ListBlockedUsers:output_type -> user_and_post.ListBlockedUsersResponse
+	165, // 226: user_and_post.UserAndPost.MuteUser:output_type -> user_and_post.MuteUserResponse
+	167, // 227: user_and_post.UserAndPost.UnmuteUser:output_type -> user_and_post.UnmuteUserResponse
+	169, // 228: user_and_post.UserAndPost.ListMutedUsers:output_type -> user_and_post.ListMutedUsersResponse
+	171, // 229: user_and_post.UserAndPost.AddCloseFriend:output_type -> user_and_post.AddCloseFriendResponse
+	173, // 230: user_and_post.UserAndPost.RemoveCloseFriend:output_type -> user_and_post.RemoveCloseFriendResponse
+	175, // 231: user_and_post.UserAndPost.ListCloseFriends:output_type -> user_and_post.ListCloseFriendsResponse
+	177, // 232: user_and_post.UserAndPost.CreatePost:output_type -> user_and_post.CreatePostResponse
+	180, // 233: user_and_post.UserAndPost.GetPost:output_type -> user_and_post.GetPostResponse
+	182, // 234: user_and_post.UserAndPost.DeletePost:output_type -> user_and_post.DeletePostResponse
+	184, // 235: user_and_post.UserAndPost.EditPost:output_type -> user_and_post.EditPostResponse
+	186, // 236: user_and_post.UserAndPost.ListUserPosts:output_type -> user_and_post.ListUserPostsResponse
+	188, // 237: user_and_post.UserAndPost.PinPost:output_type -> user_and_post.PinPostResponse
+	190, // 238: user_and_post.UserAndPost.UnpinPost:output_type -> user_and_post.UnpinPostResponse
+	193, // 239: user_and_post.UserAndPost.ListPostRevisions:output_type -> user_and_post.ListPostRevisionsResponse
+	195, // 240: user_and_post.UserAndPost.GetPostRevision:output_type -> user_and_post.GetPostRevisionResponse
+	199, // 241: user_and_post.UserAndPost.LikePost:output_type -> user_and_post.LikePostResponse
+	197, // 242: user_and_post.UserAndPost.CommentPost:output_type -> user_and_post.CommentPostResponse
+	181, // [181:243] is the sub-list for method output_type
+	119, // [119:181] is the sub-list for method input_type
+	119, // [119:119] is the sub-list for extension type_name
+	119, // [119:119] is the sub-list for extension extendee
+	0,   // [0:119] is the sub-list for field type_name
+}
+
+func init() { file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_init() }
+func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_init() {
+	if File_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDetailInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeUserNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeUserNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticateUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollSecondFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollSecondFactorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmSecondFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmSecondFactorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateRecoveryCodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateRecoveryCodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifySecondFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifySecondFactorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestEmailVerificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestEmailVerificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyEmailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyEmailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPasswordResetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPasswordResetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPasswordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginWithIdentityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginWithIdentityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkIdentityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkIdentityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlinkIdentityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlinkIdentityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIdentitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdentityInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIdentitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestDataExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestDataExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataExportInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadDataExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadDataExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileVisibility); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserByUserNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPermissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPermissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuspendUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuspendUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceDeletePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceDeletePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReportsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowerListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowerListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowingListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowingListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveFollowRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveFollowRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectFollowRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectFollowRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelFollowRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelFollowRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Relationship); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelationshipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelationshipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelationshipsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelationshipsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowEdge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkFollowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowEdgeResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkFollowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestFollowsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2551,8 +14791,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserResult); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowSuggestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2563,8 +14803,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditUserRequest); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestFollowsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2575,8 +14815,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditUserResponse); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2587,8 +14827,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthenticateUserRequest); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelatedUser); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2599,8 +14839,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthenticateUserResponse); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2611,8 +14851,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FollowUserRequest); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2623,8 +14863,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FollowUserResponse); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2635,8 +14875,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfollowUserRequest); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2647,8 +14887,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfollowUserResponse); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlockedUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2659,8 +14899,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFollowerListRequest); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlockedUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2671,8 +14911,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFollowerListResponse); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuteUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2683,8 +14923,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfo); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuteUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2695,7 +14935,127 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnmuteUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnmuteUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMutedUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMutedUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCloseFriendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCloseFriendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCloseFriendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCloseFriendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCloseFriendsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCloseFriendsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePostRequest); i {
 			case 0:
 				return &v.state
@@ -2707,7 +15067,7 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePostResponse); i {
 			case 0:
 				return &v.state
@@ -2719,7 +15079,7 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPostRequest); i {
 			case 0:
 				return &v.state
@@ -2731,7 +15091,7 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Post); i {
 			case 0:
 				return &v.state
@@ -2743,7 +15103,7 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPostResponse); i {
 			case 0:
 				return &v.state
@@ -2755,7 +15115,7 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeletePostRequest); i {
 			case 0:
 				return &v.state
@@ -2767,7 +15127,7 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeletePostResponse); i {
 			case 0:
 				return &v.state
@@ -2779,7 +15139,7 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EditPostRequest); i {
 			case 0:
 				return &v.state
@@ -2791,7 +15151,7 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EditPostResponse); i {
 			case 0:
 				return &v.state
@@ -2803,8 +15163,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentPostRequest); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserPostsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2815,8 +15175,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentPostResponse); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserPostsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2827,8 +15187,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikePostRequest); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2839,8 +15199,8 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikePostResponse); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2851,8 +15211,128 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 				return nil
 			}
 		}
-		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFollowerListResponse_FollowerInfo); i {
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPostRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPostRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostRevisionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostRevisionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikePostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2864,15 +15344,16 @@ func file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_i
 			}
 		}
 	}
-	file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[2].OneofWrappers = []interface{}{}
-	file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[62].OneofWrappers = []interface{}{}
+	file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_msgTypes[120].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_interfaces_proto_protobuf_user_and_post_user_and_post_proto_rawDesc,
-			NumEnums:      11,
-			NumMessages:   27,
+			NumEnums:      63,
+			NumMessages:   137,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
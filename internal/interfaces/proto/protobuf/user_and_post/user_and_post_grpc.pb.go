@@ -19,18 +19,68 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserAndPost_CreateUser_FullMethodName       = "/user_and_post.UserAndPost/CreateUser"
-	UserAndPost_EditUser_FullMethodName         = "/user_and_post.UserAndPost/EditUser"
-	UserAndPost_AuthenticateUser_FullMethodName = "/user_and_post.UserAndPost/AuthenticateUser"
-	UserAndPost_FollowUser_FullMethodName       = "/user_and_post.UserAndPost/FollowUser"
-	UserAndPost_UnfollowUser_FullMethodName     = "/user_and_post.UserAndPost/UnfollowUser"
-	UserAndPost_GetFollowerList_FullMethodName  = "/user_and_post.UserAndPost/GetFollowerList"
-	UserAndPost_CreatePost_FullMethodName       = "/user_and_post.UserAndPost/CreatePost"
-	UserAndPost_GetPost_FullMethodName          = "/user_and_post.UserAndPost/GetPost"
-	UserAndPost_DeletePost_FullMethodName       = "/user_and_post.UserAndPost/DeletePost"
-	UserAndPost_EditPost_FullMethodName         = "/user_and_post.UserAndPost/EditPost"
-	UserAndPost_LikePost_FullMethodName         = "/user_and_post.UserAndPost/LikePost"
-	UserAndPost_CommentPost_FullMethodName      = "/user_and_post.UserAndPost/CommentPost"
+	UserAndPost_CreateUser_FullMethodName               = "/user_and_post.UserAndPost/CreateUser"
+	UserAndPost_EditUser_FullMethodName                 = "/user_and_post.UserAndPost/EditUser"
+	UserAndPost_ChangeUserName_FullMethodName           = "/user_and_post.UserAndPost/ChangeUserName"
+	UserAndPost_AuthenticateUser_FullMethodName         = "/user_and_post.UserAndPost/AuthenticateUser"
+	UserAndPost_DeleteAccount_FullMethodName            = "/user_and_post.UserAndPost/DeleteAccount"
+	UserAndPost_RequestDataExport_FullMethodName        = "/user_and_post.UserAndPost/RequestDataExport"
+	UserAndPost_GetDataExport_FullMethodName            = "/user_and_post.UserAndPost/GetDataExport"
+	UserAndPost_DownloadDataExport_FullMethodName       = "/user_and_post.UserAndPost/DownloadDataExport"
+	UserAndPost_ImportAccount_FullMethodName            = "/user_and_post.UserAndPost/ImportAccount"
+	UserAndPost_GetUser_FullMethodName                  = "/user_and_post.UserAndPost/GetUser"
+	UserAndPost_GetUserByUserName_FullMethodName        = "/user_and_post.UserAndPost/GetUserByUserName"
+	UserAndPost_GetUsers_FullMethodName                 = "/user_and_post.UserAndPost/GetUsers"
+	UserAndPost_EnrollSecondFactor_FullMethodName       = "/user_and_post.UserAndPost/EnrollSecondFactor"
+	UserAndPost_ConfirmSecondFactor_FullMethodName      = "/user_and_post.UserAndPost/ConfirmSecondFactor"
+	UserAndPost_GenerateRecoveryCodes_FullMethodName    = "/user_and_post.UserAndPost/GenerateRecoveryCodes"
+	UserAndPost_VerifySecondFactor_FullMethodName       = "/user_and_post.UserAndPost/VerifySecondFactor"
+	UserAndPost_RequestEmailVerification_FullMethodName = "/user_and_post.UserAndPost/RequestEmailVerification"
+	UserAndPost_VerifyEmail_FullMethodName              = "/user_and_post.UserAndPost/VerifyEmail"
+	UserAndPost_RequestPasswordReset_FullMethodName     = "/user_and_post.UserAndPost/RequestPasswordReset"
+	UserAndPost_ResetPassword_FullMethodName            = "/user_and_post.UserAndPost/ResetPassword"
+	UserAndPost_LoginWithIdentity_FullMethodName        = "/user_and_post.UserAndPost/LoginWithIdentity"
+	UserAndPost_LinkIdentity_FullMethodName             = "/user_and_post.UserAndPost/LinkIdentity"
+	UserAndPost_UnlinkIdentity_FullMethodName           = "/user_and_post.UserAndPost/UnlinkIdentity"
+	UserAndPost_ListIdentities_FullMethodName           = "/user_and_post.UserAndPost/ListIdentities"
+	UserAndPost_CheckPermission_FullMethodName          = "/user_and_post.UserAndPost/CheckPermission"
+	UserAndPost_SuspendUser_FullMethodName              = "/user_and_post.UserAndPost/SuspendUser"
+	UserAndPost_ForceDeletePost_FullMethodName          = "/user_and_post.UserAndPost/ForceDeletePost"
+	UserAndPost_ReportPost_FullMethodName               = "/user_and_post.UserAndPost/ReportPost"
+	UserAndPost_ListReports_FullMethodName              = "/user_and_post.UserAndPost/ListReports"
+	UserAndPost_FollowUser_FullMethodName               = "/user_and_post.UserAndPost/FollowUser"
+	UserAndPost_UnfollowUser_FullMethodName             = "/user_and_post.UserAndPost/UnfollowUser"
+	UserAndPost_GetFollowerList_FullMethodName          = "/user_and_post.UserAndPost/GetFollowerList"
+	UserAndPost_GetFollowingList_FullMethodName         = "/user_and_post.UserAndPost/GetFollowingList"
+	UserAndPost_ListFollowRequests_FullMethodName       = "/user_and_post.UserAndPost/ListFollowRequests"
+	UserAndPost_ApproveFollowRequest_FullMethodName     = "/user_and_post.UserAndPost/ApproveFollowRequest"
+	UserAndPost_RejectFollowRequest_FullMethodName      = "/user_and_post.UserAndPost/RejectFollowRequest"
+	UserAndPost_CancelFollowRequest_FullMethodName      = "/user_and_post.UserAndPost/CancelFollowRequest"
+	UserAndPost_GetRelationship_FullMethodName          = "/user_and_post.UserAndPost/GetRelationship"
+	UserAndPost_GetRelationships_FullMethodName         = "/user_and_post.UserAndPost/GetRelationships"
+	UserAndPost_SuggestFollows_FullMethodName           = "/user_and_post.UserAndPost/SuggestFollows"
+	UserAndPost_BulkFollow_FullMethodName               = "/user_and_post.UserAndPost/BulkFollow"
+	UserAndPost_BulkUnfollow_FullMethodName             = "/user_and_post.UserAndPost/BulkUnfollow"
+	UserAndPost_BlockUser_FullMethodName                = "/user_and_post.UserAndPost/BlockUser"
+	UserAndPost_UnblockUser_FullMethodName              = "/user_and_post.UserAndPost/UnblockUser"
+	UserAndPost_ListBlockedUsers_FullMethodName         = "/user_and_post.UserAndPost/ListBlockedUsers"
+	UserAndPost_MuteUser_FullMethodName                 = "/user_and_post.UserAndPost/MuteUser"
+	UserAndPost_UnmuteUser_FullMethodName               = "/user_and_post.UserAndPost/UnmuteUser"
+	UserAndPost_ListMutedUsers_FullMethodName           = "/user_and_post.UserAndPost/ListMutedUsers"
+	UserAndPost_AddCloseFriend_FullMethodName           = "/user_and_post.UserAndPost/AddCloseFriend"
+	UserAndPost_RemoveCloseFriend_FullMethodName        = "/user_and_post.UserAndPost/RemoveCloseFriend"
+	UserAndPost_ListCloseFriends_FullMethodName         = "/user_and_post.UserAndPost/ListCloseFriends"
+	UserAndPost_CreatePost_FullMethodName               = "/user_and_post.UserAndPost/CreatePost"
+	UserAndPost_GetPost_FullMethodName                  = "/user_and_post.UserAndPost/GetPost"
+	UserAndPost_DeletePost_FullMethodName               = "/user_and_post.UserAndPost/DeletePost"
+	UserAndPost_EditPost_FullMethodName                 = "/user_and_post.UserAndPost/EditPost"
+	UserAndPost_ListUserPosts_FullMethodName            = "/user_and_post.UserAndPost/ListUserPosts"
+	UserAndPost_PinPost_FullMethodName                  = "/user_and_post.UserAndPost/PinPost"
+	UserAndPost_UnpinPost_FullMethodName                = "/user_and_post.UserAndPost/UnpinPost"
+	UserAndPost_ListPostRevisions_FullMethodName        = "/user_and_post.UserAndPost/ListPostRevisions"
+	UserAndPost_GetPostRevision_FullMethodName          = "/user_and_post.UserAndPost/GetPostRevision"
+	UserAndPost_LikePost_FullMethodName                 = "/user_and_post.UserAndPost/LikePost"
+	UserAndPost_CommentPost_FullMethodName              = "/user_and_post.UserAndPost/CommentPost"
 )
 
 // UserAndPostClient is the client API for UserAndPost service.
@@ -40,16 +90,81 @@ type UserAndPostClient interface {
 	// Users handler
 	CreateUser(ctx context.Context, in *UserDetailInfo, opts ...grpc.CallOption) (*UserResult, error)
 	EditUser(ctx context.Context, in *EditUserRequest, opts ...grpc.CallOption) (*EditUserResponse, error)
+	ChangeUserName(ctx context.Context, in *ChangeUserNameRequest, opts ...grpc.CallOption) (*ChangeUserNameResponse, error)
 	AuthenticateUser(ctx context.Context, in *AuthenticateUserRequest, opts ...grpc.CallOption) (*AuthenticateUserResponse, error)
+	// Account deletion handler
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	// Data export handler
+	RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error)
+	GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error)
+	DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (UserAndPost_DownloadDataExportClient, error)
+	// Account import handler, the caller needs the users:import permission
+	ImportAccount(ctx context.Context, in *ImportAccountRequest, opts ...grpc.CallOption) (*ImportAccountResponse, error)
+	// Profile handler
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	GetUserByUserName(ctx context.Context, in *GetUserByUserNameRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error)
+	// Two-factor authentication handler
+	EnrollSecondFactor(ctx context.Context, in *EnrollSecondFactorRequest, opts ...grpc.CallOption) (*EnrollSecondFactorResponse, error)
+	ConfirmSecondFactor(ctx context.Context, in *ConfirmSecondFactorRequest, opts ...grpc.CallOption) (*ConfirmSecondFactorResponse, error)
+	GenerateRecoveryCodes(ctx context.Context, in *GenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*GenerateRecoveryCodesResponse, error)
+	VerifySecondFactor(ctx context.Context, in *VerifySecondFactorRequest, opts ...grpc.CallOption) (*VerifySecondFactorResponse, error)
+	// Email verification and password reset handler
+	RequestEmailVerification(ctx context.Context, in *RequestEmailVerificationRequest, opts ...grpc.CallOption) (*RequestEmailVerificationResponse, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	// External identity handler
+	LoginWithIdentity(ctx context.Context, in *LoginWithIdentityRequest, opts ...grpc.CallOption) (*LoginWithIdentityResponse, error)
+	LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*LinkIdentityResponse, error)
+	UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*UnlinkIdentityResponse, error)
+	ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesResponse, error)
+	// Moderation handler, the caller needs the matching permission
+	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error)
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*SuspendUserResponse, error)
+	ForceDeletePost(ctx context.Context, in *ForceDeletePostRequest, opts ...grpc.CallOption) (*ForceDeletePostResponse, error)
+	ReportPost(ctx context.Context, in *ReportPostRequest, opts ...grpc.CallOption) (*ReportPostResponse, error)
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
 	// Follow handler
 	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error)
 	UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error)
 	GetFollowerList(ctx context.Context, in *GetFollowerListRequest, opts ...grpc.CallOption) (*GetFollowerListResponse, error)
+	GetFollowingList(ctx context.Context, in *GetFollowingListRequest, opts ...grpc.CallOption) (*GetFollowingListResponse, error)
+	ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ListFollowRequestsResponse, error)
+	ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ApproveFollowRequestResponse, error)
+	RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error)
+	CancelFollowRequest(ctx context.Context, in *CancelFollowRequestRequest, opts ...grpc.CallOption) (*CancelFollowRequestResponse, error)
+	GetRelationship(ctx context.Context, in *GetRelationshipRequest, opts ...grpc.CallOption) (*GetRelationshipResponse, error)
+	GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error)
+	SuggestFollows(ctx context.Context, in *SuggestFollowsRequest, opts ...grpc.CallOption) (*SuggestFollowsResponse, error)
+	// Bulk follow handler, edges whose follower is not the caller need the
+	// follows:bulk permission
+	BulkFollow(ctx context.Context, in *BulkFollowRequest, opts ...grpc.CallOption) (*BulkFollowResponse, error)
+	BulkUnfollow(ctx context.Context, in *BulkFollowRequest, opts ...grpc.CallOption) (*BulkFollowResponse, error)
+	// Block and mute handler
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+	ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error)
+	MuteUser(ctx context.Context, in *MuteUserRequest, opts ...grpc.CallOption) (*MuteUserResponse, error)
+	UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...grpc.CallOption) (*UnmuteUserResponse, error)
+	ListMutedUsers(ctx context.Context, in *ListMutedUsersRequest, opts ...grpc.CallOption) (*ListMutedUsersResponse, error)
+	// Close friends handler, close friends see posts shared with the
+	// CLOSE_FRIENDS audience
+	AddCloseFriend(ctx context.Context, in *AddCloseFriendRequest, opts ...grpc.CallOption) (*AddCloseFriendResponse, error)
+	RemoveCloseFriend(ctx context.Context, in *RemoveCloseFriendRequest, opts ...grpc.CallOption) (*RemoveCloseFriendResponse, error)
+	ListCloseFriends(ctx context.Context, in *ListCloseFriendsRequest, opts ...grpc.CallOption) (*ListCloseFriendsResponse, error)
 	// Post handler
 	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error)
 	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error)
 	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
 	EditPost(ctx context.Context, in *EditPostRequest, opts ...grpc.CallOption) (*EditPostResponse, error)
+	ListUserPosts(ctx context.Context, in *ListUserPostsRequest, opts ...grpc.CallOption) (*ListUserPostsResponse, error)
+	PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*PinPostResponse, error)
+	UnpinPost(ctx context.Context, in *UnpinPostRequest, opts ...grpc.CallOption) (*UnpinPostResponse, error)
+	// Post revision handler, revisions are shown to the author and to callers
+	// with the posts:view_revisions permission
+	ListPostRevisions(ctx context.Context, in *ListPostRevisionsRequest, opts ...grpc.CallOption) (*ListPostRevisionsResponse, error)
+	GetPostRevision(ctx context.Context, in *GetPostRevisionRequest, opts ...grpc.CallOption) (*GetPostRevisionResponse, error)
 	// React to post
 	LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error)
 	CommentPost(ctx context.Context, in *CommentPostRequest, opts ...grpc.CallOption) (*CommentPostResponse, error)
@@ -81,6 +196,15 @@ func (c *userAndPostClient) EditUser(ctx context.Context, in *EditUserRequest, o
 	return out, nil
 }
 
+func (c *userAndPostClient) ChangeUserName(ctx context.Context, in *ChangeUserNameRequest, opts ...grpc.CallOption) (*ChangeUserNameResponse, error) {
+	out := new(ChangeUserNameResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ChangeUserName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userAndPostClient) AuthenticateUser(ctx context.Context, in *AuthenticateUserRequest, opts ...grpc.CallOption) (*AuthenticateUserResponse, error) {
 	out := new(AuthenticateUserResponse)
 	err := c.cc.Invoke(ctx, UserAndPost_AuthenticateUser_FullMethodName, in, out, opts...)
@@ -90,6 +214,254 @@ func (c *userAndPostClient) AuthenticateUser(ctx context.Context, in *Authentica
 	return out, nil
 }
 
+func (c *userAndPostClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_DeleteAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error) {
+	out := new(RequestDataExportResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_RequestDataExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error) {
+	out := new(GetDataExportResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_GetDataExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (UserAndPost_DownloadDataExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UserAndPost_ServiceDesc.Streams[0], UserAndPost_DownloadDataExport_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userAndPostDownloadDataExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserAndPost_DownloadDataExportClient interface {
+	Recv() (*DownloadDataExportResponse, error)
+	grpc.ClientStream
+}
+
+type userAndPostDownloadDataExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *userAndPostDownloadDataExportClient) Recv() (*DownloadDataExportResponse, error) {
+	m := new(DownloadDataExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userAndPostClient) ImportAccount(ctx context.Context, in *ImportAccountRequest, opts ...grpc.CallOption) (*ImportAccountResponse, error) {
+	out := new(ImportAccountResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ImportAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_GetUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) GetUserByUserName(ctx context.Context, in *GetUserByUserNameRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_GetUserByUserName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error) {
+	out := new(GetUsersResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_GetUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) EnrollSecondFactor(ctx context.Context, in *EnrollSecondFactorRequest, opts ...grpc.CallOption) (*EnrollSecondFactorResponse, error) {
+	out := new(EnrollSecondFactorResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_EnrollSecondFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) ConfirmSecondFactor(ctx context.Context, in *ConfirmSecondFactorRequest, opts ...grpc.CallOption) (*ConfirmSecondFactorResponse, error) {
+	out := new(ConfirmSecondFactorResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ConfirmSecondFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) GenerateRecoveryCodes(ctx context.Context, in *GenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*GenerateRecoveryCodesResponse, error) {
+	out := new(GenerateRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_GenerateRecoveryCodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) VerifySecondFactor(ctx context.Context, in *VerifySecondFactorRequest, opts ...grpc.CallOption) (*VerifySecondFactorResponse, error) {
+	out := new(VerifySecondFactorResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_VerifySecondFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) RequestEmailVerification(ctx context.Context, in *RequestEmailVerificationRequest, opts ...grpc.CallOption) (*RequestEmailVerificationResponse, error) {
+	out := new(RequestEmailVerificationResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_RequestEmailVerification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_VerifyEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_RequestPasswordReset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ResetPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) LoginWithIdentity(ctx context.Context, in *LoginWithIdentityRequest, opts ...grpc.CallOption) (*LoginWithIdentityResponse, error) {
+	out := new(LoginWithIdentityResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_LoginWithIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*LinkIdentityResponse, error) {
+	out := new(LinkIdentityResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_LinkIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*UnlinkIdentityResponse, error) {
+	out := new(UnlinkIdentityResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_UnlinkIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesResponse, error) {
+	out := new(ListIdentitiesResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ListIdentities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error) {
+	out := new(CheckPermissionResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_CheckPermission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*SuspendUserResponse, error) {
+	out := new(SuspendUserResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_SuspendUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) ForceDeletePost(ctx context.Context, in *ForceDeletePostRequest, opts ...grpc.CallOption) (*ForceDeletePostResponse, error) {
+	out := new(ForceDeletePostResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ForceDeletePost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) ReportPost(ctx context.Context, in *ReportPostRequest, opts ...grpc.CallOption) (*ReportPostResponse, error) {
+	out := new(ReportPostResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ReportPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ListReports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userAndPostClient) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error) {
 	out := new(FollowUserResponse)
 	err := c.cc.Invoke(ctx, UserAndPost_FollowUser_FullMethodName, in, out, opts...)
@@ -117,107 +489,523 @@ func (c *userAndPostClient) GetFollowerList(ctx context.Context, in *GetFollower
 	return out, nil
 }
 
-func (c *userAndPostClient) CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error) {
-	out := new(CreatePostResponse)
-	err := c.cc.Invoke(ctx, UserAndPost_CreatePost_FullMethodName, in, out, opts...)
+func (c *userAndPostClient) GetFollowingList(ctx context.Context, in *GetFollowingListRequest, opts ...grpc.CallOption) (*GetFollowingListResponse, error) {
+	out := new(GetFollowingListResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_GetFollowingList_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userAndPostClient) GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error) {
-	out := new(GetPostResponse)
-	err := c.cc.Invoke(ctx, UserAndPost_GetPost_FullMethodName, in, out, opts...)
+func (c *userAndPostClient) ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ListFollowRequestsResponse, error) {
+	out := new(ListFollowRequestsResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ListFollowRequests_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userAndPostClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error) {
-	out := new(DeletePostResponse)
-	err := c.cc.Invoke(ctx, UserAndPost_DeletePost_FullMethodName, in, out, opts...)
+func (c *userAndPostClient) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ApproveFollowRequestResponse, error) {
+	out := new(ApproveFollowRequestResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ApproveFollowRequest_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userAndPostClient) EditPost(ctx context.Context, in *EditPostRequest, opts ...grpc.CallOption) (*EditPostResponse, error) {
-	out := new(EditPostResponse)
-	err := c.cc.Invoke(ctx, UserAndPost_EditPost_FullMethodName, in, out, opts...)
+func (c *userAndPostClient) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error) {
+	out := new(RejectFollowRequestResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_RejectFollowRequest_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userAndPostClient) LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error) {
-	out := new(LikePostResponse)
-	err := c.cc.Invoke(ctx, UserAndPost_LikePost_FullMethodName, in, out, opts...)
+func (c *userAndPostClient) CancelFollowRequest(ctx context.Context, in *CancelFollowRequestRequest, opts ...grpc.CallOption) (*CancelFollowRequestResponse, error) {
+	out := new(CancelFollowRequestResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_CancelFollowRequest_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userAndPostClient) CommentPost(ctx context.Context, in *CommentPostRequest, opts ...grpc.CallOption) (*CommentPostResponse, error) {
-	out := new(CommentPostResponse)
-	err := c.cc.Invoke(ctx, UserAndPost_CommentPost_FullMethodName, in, out, opts...)
+func (c *userAndPostClient) GetRelationship(ctx context.Context, in *GetRelationshipRequest, opts ...grpc.CallOption) (*GetRelationshipResponse, error) {
+	out := new(GetRelationshipResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_GetRelationship_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// UserAndPostServer is the server API for UserAndPost service.
-// All implementations must embed UnimplementedUserAndPostServer
-// for forward compatibility
-type UserAndPostServer interface {
-	// Users handler
-	CreateUser(context.Context, *UserDetailInfo) (*UserResult, error)
-	EditUser(context.Context, *EditUserRequest) (*EditUserResponse, error)
-	AuthenticateUser(context.Context, *AuthenticateUserRequest) (*AuthenticateUserResponse, error)
-	// Follow handler
-	FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error)
-	UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error)
-	GetFollowerList(context.Context, *GetFollowerListRequest) (*GetFollowerListResponse, error)
-	// Post handler
-	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
-	GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
-	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
-	EditPost(context.Context, *EditPostRequest) (*EditPostResponse, error)
-	// React to post
-	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
-	CommentPost(context.Context, *CommentPostRequest) (*CommentPostResponse, error)
-	mustEmbedUnimplementedUserAndPostServer()
+func (c *userAndPostClient) GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error) {
+	out := new(GetRelationshipsResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_GetRelationships_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedUserAndPostServer must be embedded to have forward compatible implementations.
-type UnimplementedUserAndPostServer struct {
+func (c *userAndPostClient) SuggestFollows(ctx context.Context, in *SuggestFollowsRequest, opts ...grpc.CallOption) (*SuggestFollowsResponse, error) {
+	out := new(SuggestFollowsResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_SuggestFollows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedUserAndPostServer) CreateUser(context.Context, *UserDetailInfo) (*UserResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
-}
-func (UnimplementedUserAndPostServer) EditUser(context.Context, *EditUserRequest) (*EditUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditUser not implemented")
-}
-func (UnimplementedUserAndPostServer) AuthenticateUser(context.Context, *AuthenticateUserRequest) (*AuthenticateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateUser not implemented")
+func (c *userAndPostClient) BulkFollow(ctx context.Context, in *BulkFollowRequest, opts ...grpc.CallOption) (*BulkFollowResponse, error) {
+	out := new(BulkFollowResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_BulkFollow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserAndPostServer) FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+
+func (c *userAndPostClient) BulkUnfollow(ctx context.Context, in *BulkFollowRequest, opts ...grpc.CallOption) (*BulkFollowResponse, error) {
+	out := new(BulkFollowResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_BulkUnfollow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserAndPostServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+
+func (c *userAndPostClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_BlockUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserAndPostServer) GetFollowerList(context.Context, *GetFollowerListRequest) (*GetFollowerListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFollowerList not implemented")
+
+func (c *userAndPostClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_UnblockUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserAndPostServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+
+func (c *userAndPostClient) ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error) {
+	out := new(ListBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ListBlockedUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) MuteUser(ctx context.Context, in *MuteUserRequest, opts ...grpc.CallOption) (*MuteUserResponse, error) {
+	out := new(MuteUserResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_MuteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...grpc.CallOption) (*UnmuteUserResponse, error) {
+	out := new(UnmuteUserResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_UnmuteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) ListMutedUsers(ctx context.Context, in *ListMutedUsersRequest, opts ...grpc.CallOption) (*ListMutedUsersResponse, error) {
+	out := new(ListMutedUsersResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ListMutedUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) AddCloseFriend(ctx context.Context, in *AddCloseFriendRequest, opts ...grpc.CallOption) (*AddCloseFriendResponse, error) {
+	out := new(AddCloseFriendResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_AddCloseFriend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) RemoveCloseFriend(ctx context.Context, in *RemoveCloseFriendRequest, opts ...grpc.CallOption) (*RemoveCloseFriendResponse, error) {
+	out := new(RemoveCloseFriendResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_RemoveCloseFriend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) ListCloseFriends(ctx context.Context, in *ListCloseFriendsRequest, opts ...grpc.CallOption) (*ListCloseFriendsResponse, error) {
+	out := new(ListCloseFriendsResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ListCloseFriends_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error) {
+	out := new(CreatePostResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_CreatePost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error) {
+	out := new(GetPostResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_GetPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error) {
+	out := new(DeletePostResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_DeletePost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) EditPost(ctx context.Context, in *EditPostRequest, opts ...grpc.CallOption) (*EditPostResponse, error) {
+	out := new(EditPostResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_EditPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) ListUserPosts(ctx context.Context, in *ListUserPostsRequest, opts ...grpc.CallOption) (*ListUserPostsResponse, error) {
+	out := new(ListUserPostsResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ListUserPosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*PinPostResponse, error) {
+	out := new(PinPostResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_PinPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) UnpinPost(ctx context.Context, in *UnpinPostRequest, opts ...grpc.CallOption) (*UnpinPostResponse, error) {
+	out := new(UnpinPostResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_UnpinPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) ListPostRevisions(ctx context.Context, in *ListPostRevisionsRequest, opts ...grpc.CallOption) (*ListPostRevisionsResponse, error) {
+	out := new(ListPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_ListPostRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) GetPostRevision(ctx context.Context, in *GetPostRevisionRequest, opts ...grpc.CallOption) (*GetPostRevisionResponse, error) {
+	out := new(GetPostRevisionResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_GetPostRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error) {
+	out := new(LikePostResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_LikePost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userAndPostClient) CommentPost(ctx context.Context, in *CommentPostRequest, opts ...grpc.CallOption) (*CommentPostResponse, error) {
+	out := new(CommentPostResponse)
+	err := c.cc.Invoke(ctx, UserAndPost_CommentPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserAndPostServer is the server API for UserAndPost service.
+// All implementations must embed UnimplementedUserAndPostServer
+// for forward compatibility
+type UserAndPostServer interface {
+	// Users handler
+	CreateUser(context.Context, *UserDetailInfo) (*UserResult, error)
+	EditUser(context.Context, *EditUserRequest) (*EditUserResponse, error)
+	ChangeUserName(context.Context, *ChangeUserNameRequest) (*ChangeUserNameResponse, error)
+	AuthenticateUser(context.Context, *AuthenticateUserRequest) (*AuthenticateUserResponse, error)
+	// Account deletion handler
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	// Data export handler
+	RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error)
+	GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error)
+	DownloadDataExport(*DownloadDataExportRequest, UserAndPost_DownloadDataExportServer) error
+	// Account import handler, the caller needs the users:import permission
+	ImportAccount(context.Context, *ImportAccountRequest) (*ImportAccountResponse, error)
+	// Profile handler
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	GetUserByUserName(context.Context, *GetUserByUserNameRequest) (*GetUserResponse, error)
+	GetUsers(context.Context, *GetUsersRequest) (*GetUsersResponse, error)
+	// Two-factor authentication handler
+	EnrollSecondFactor(context.Context, *EnrollSecondFactorRequest) (*EnrollSecondFactorResponse, error)
+	ConfirmSecondFactor(context.Context, *ConfirmSecondFactorRequest) (*ConfirmSecondFactorResponse, error)
+	GenerateRecoveryCodes(context.Context, *GenerateRecoveryCodesRequest) (*GenerateRecoveryCodesResponse, error)
+	VerifySecondFactor(context.Context, *VerifySecondFactorRequest) (*VerifySecondFactorResponse, error)
+	// Email verification and password reset handler
+	RequestEmailVerification(context.Context, *RequestEmailVerificationRequest) (*RequestEmailVerificationResponse, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	// External identity handler
+	LoginWithIdentity(context.Context, *LoginWithIdentityRequest) (*LoginWithIdentityResponse, error)
+	LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkIdentityResponse, error)
+	UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*UnlinkIdentityResponse, error)
+	ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesResponse, error)
+	// Moderation handler, the caller needs the matching permission
+	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error)
+	SuspendUser(context.Context, *SuspendUserRequest) (*SuspendUserResponse, error)
+	ForceDeletePost(context.Context, *ForceDeletePostRequest) (*ForceDeletePostResponse, error)
+	ReportPost(context.Context, *ReportPostRequest) (*ReportPostResponse, error)
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
+	// Follow handler
+	FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error)
+	UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error)
+	GetFollowerList(context.Context, *GetFollowerListRequest) (*GetFollowerListResponse, error)
+	GetFollowingList(context.Context, *GetFollowingListRequest) (*GetFollowingListResponse, error)
+	ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error)
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ApproveFollowRequestResponse, error)
+	RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestResponse, error)
+	CancelFollowRequest(context.Context, *CancelFollowRequestRequest) (*CancelFollowRequestResponse, error)
+	GetRelationship(context.Context, *GetRelationshipRequest) (*GetRelationshipResponse, error)
+	GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error)
+	SuggestFollows(context.Context, *SuggestFollowsRequest) (*SuggestFollowsResponse, error)
+	// Bulk follow handler, edges whose follower is not the caller need the
+	// follows:bulk permission
+	BulkFollow(context.Context, *BulkFollowRequest) (*BulkFollowResponse, error)
+	BulkUnfollow(context.Context, *BulkFollowRequest) (*BulkFollowResponse, error)
+	// Block and mute handler
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error)
+	MuteUser(context.Context, *MuteUserRequest) (*MuteUserResponse, error)
+	UnmuteUser(context.Context, *UnmuteUserRequest) (*UnmuteUserResponse, error)
+	ListMutedUsers(context.Context, *ListMutedUsersRequest) (*ListMutedUsersResponse, error)
+	// Close friends handler, close friends see posts shared with the
+	// CLOSE_FRIENDS audience
+	AddCloseFriend(context.Context, *AddCloseFriendRequest) (*AddCloseFriendResponse, error)
+	RemoveCloseFriend(context.Context, *RemoveCloseFriendRequest) (*RemoveCloseFriendResponse, error)
+	ListCloseFriends(context.Context, *ListCloseFriendsRequest) (*ListCloseFriendsResponse, error)
+	// Post handler
+	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
+	GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
+	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	EditPost(context.Context, *EditPostRequest) (*EditPostResponse, error)
+	ListUserPosts(context.Context, *ListUserPostsRequest) (*ListUserPostsResponse, error)
+	PinPost(context.Context, *PinPostRequest) (*PinPostResponse, error)
+	UnpinPost(context.Context, *UnpinPostRequest) (*UnpinPostResponse, error)
+	// Post revision handler, revisions are shown to the author and to callers
+	// with the posts:view_revisions permission
+	ListPostRevisions(context.Context, *ListPostRevisionsRequest) (*ListPostRevisionsResponse, error)
+	GetPostRevision(context.Context, *GetPostRevisionRequest) (*GetPostRevisionResponse, error)
+	// React to post
+	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
+	CommentPost(context.Context, *CommentPostRequest) (*CommentPostResponse, error)
+	mustEmbedUnimplementedUserAndPostServer()
+}
+
+// UnimplementedUserAndPostServer must be embedded to have forward compatible implementations.
+type UnimplementedUserAndPostServer struct {
+}
+
+func (UnimplementedUserAndPostServer) CreateUser(context.Context, *UserDetailInfo) (*UserResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUserAndPostServer) EditUser(context.Context, *EditUserRequest) (*EditUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditUser not implemented")
+}
+func (UnimplementedUserAndPostServer) ChangeUserName(context.Context, *ChangeUserNameRequest) (*ChangeUserNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserName not implemented")
+}
+func (UnimplementedUserAndPostServer) AuthenticateUser(context.Context, *AuthenticateUserRequest) (*AuthenticateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateUser not implemented")
+}
+func (UnimplementedUserAndPostServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedUserAndPostServer) RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (UnimplementedUserAndPostServer) GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExport not implemented")
+}
+func (UnimplementedUserAndPostServer) DownloadDataExport(*DownloadDataExportRequest, UserAndPost_DownloadDataExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadDataExport not implemented")
+}
+func (UnimplementedUserAndPostServer) ImportAccount(context.Context, *ImportAccountRequest) (*ImportAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAccount not implemented")
+}
+func (UnimplementedUserAndPostServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUserAndPostServer) GetUserByUserName(context.Context, *GetUserByUserNameRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUserName not implemented")
+}
+func (UnimplementedUserAndPostServer) GetUsers(context.Context, *GetUsersRequest) (*GetUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+}
+func (UnimplementedUserAndPostServer) EnrollSecondFactor(context.Context, *EnrollSecondFactorRequest) (*EnrollSecondFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollSecondFactor not implemented")
+}
+func (UnimplementedUserAndPostServer) ConfirmSecondFactor(context.Context, *ConfirmSecondFactorRequest) (*ConfirmSecondFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSecondFactor not implemented")
+}
+func (UnimplementedUserAndPostServer) GenerateRecoveryCodes(context.Context, *GenerateRecoveryCodesRequest) (*GenerateRecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRecoveryCodes not implemented")
+}
+func (UnimplementedUserAndPostServer) VerifySecondFactor(context.Context, *VerifySecondFactorRequest) (*VerifySecondFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySecondFactor not implemented")
+}
+func (UnimplementedUserAndPostServer) RequestEmailVerification(context.Context, *RequestEmailVerificationRequest) (*RequestEmailVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailVerification not implemented")
+}
+func (UnimplementedUserAndPostServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedUserAndPostServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedUserAndPostServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedUserAndPostServer) LoginWithIdentity(context.Context, *LoginWithIdentityRequest) (*LoginWithIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithIdentity not implemented")
+}
+func (UnimplementedUserAndPostServer) LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkIdentity not implemented")
+}
+func (UnimplementedUserAndPostServer) UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*UnlinkIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkIdentity not implemented")
+}
+func (UnimplementedUserAndPostServer) ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIdentities not implemented")
+}
+func (UnimplementedUserAndPostServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+}
+func (UnimplementedUserAndPostServer) SuspendUser(context.Context, *SuspendUserRequest) (*SuspendUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedUserAndPostServer) ForceDeletePost(context.Context, *ForceDeletePostRequest) (*ForceDeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceDeletePost not implemented")
+}
+func (UnimplementedUserAndPostServer) ReportPost(context.Context, *ReportPostRequest) (*ReportPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPost not implemented")
+}
+func (UnimplementedUserAndPostServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedUserAndPostServer) FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (UnimplementedUserAndPostServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (UnimplementedUserAndPostServer) GetFollowerList(context.Context, *GetFollowerListRequest) (*GetFollowerListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowerList not implemented")
+}
+func (UnimplementedUserAndPostServer) GetFollowingList(context.Context, *GetFollowingListRequest) (*GetFollowingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowingList not implemented")
+}
+func (UnimplementedUserAndPostServer) ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowRequests not implemented")
+}
+func (UnimplementedUserAndPostServer) ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ApproveFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFollowRequest not implemented")
+}
+func (UnimplementedUserAndPostServer) RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
+func (UnimplementedUserAndPostServer) CancelFollowRequest(context.Context, *CancelFollowRequestRequest) (*CancelFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFollowRequest not implemented")
+}
+func (UnimplementedUserAndPostServer) GetRelationship(context.Context, *GetRelationshipRequest) (*GetRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationship not implemented")
+}
+func (UnimplementedUserAndPostServer) GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationships not implemented")
+}
+func (UnimplementedUserAndPostServer) SuggestFollows(context.Context, *SuggestFollowsRequest) (*SuggestFollowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestFollows not implemented")
+}
+func (UnimplementedUserAndPostServer) BulkFollow(context.Context, *BulkFollowRequest) (*BulkFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkFollow not implemented")
+}
+func (UnimplementedUserAndPostServer) BulkUnfollow(context.Context, *BulkFollowRequest) (*BulkFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUnfollow not implemented")
+}
+func (UnimplementedUserAndPostServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedUserAndPostServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedUserAndPostServer) ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedUsers not implemented")
+}
+func (UnimplementedUserAndPostServer) MuteUser(context.Context, *MuteUserRequest) (*MuteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (UnimplementedUserAndPostServer) UnmuteUser(context.Context, *UnmuteUserRequest) (*UnmuteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
+}
+func (UnimplementedUserAndPostServer) ListMutedUsers(context.Context, *ListMutedUsersRequest) (*ListMutedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMutedUsers not implemented")
+}
+func (UnimplementedUserAndPostServer) AddCloseFriend(context.Context, *AddCloseFriendRequest) (*AddCloseFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCloseFriend not implemented")
+}
+func (UnimplementedUserAndPostServer) RemoveCloseFriend(context.Context, *RemoveCloseFriendRequest) (*RemoveCloseFriendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCloseFriend not implemented")
+}
+func (UnimplementedUserAndPostServer) ListCloseFriends(context.Context, *ListCloseFriendsRequest) (*ListCloseFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCloseFriends not implemented")
+}
+func (UnimplementedUserAndPostServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
 }
 func (UnimplementedUserAndPostServer) GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
@@ -228,129 +1016,957 @@ func (UnimplementedUserAndPostServer) DeletePost(context.Context, *DeletePostReq
 func (UnimplementedUserAndPostServer) EditPost(context.Context, *EditPostRequest) (*EditPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditPost not implemented")
 }
-func (UnimplementedUserAndPostServer) LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+func (UnimplementedUserAndPostServer) ListUserPosts(context.Context, *ListUserPostsRequest) (*ListUserPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserPosts not implemented")
+}
+func (UnimplementedUserAndPostServer) PinPost(context.Context, *PinPostRequest) (*PinPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinPost not implemented")
+}
+func (UnimplementedUserAndPostServer) UnpinPost(context.Context, *UnpinPostRequest) (*UnpinPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinPost not implemented")
+}
+func (UnimplementedUserAndPostServer) ListPostRevisions(context.Context, *ListPostRevisionsRequest) (*ListPostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostRevisions not implemented")
+}
+func (UnimplementedUserAndPostServer) GetPostRevision(context.Context, *GetPostRevisionRequest) (*GetPostRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostRevision not implemented")
+}
+func (UnimplementedUserAndPostServer) LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+}
+func (UnimplementedUserAndPostServer) CommentPost(context.Context, *CommentPostRequest) (*CommentPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentPost not implemented")
+}
+func (UnimplementedUserAndPostServer) mustEmbedUnimplementedUserAndPostServer() {}
+
+// UnsafeUserAndPostServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserAndPostServer will
+// result in compilation errors.
+type UnsafeUserAndPostServer interface {
+	mustEmbedUnimplementedUserAndPostServer()
+}
+
+func RegisterUserAndPostServer(s grpc.ServiceRegistrar, srv UserAndPostServer) {
+	s.RegisterService(&UserAndPost_ServiceDesc, srv)
+}
+
+func _UserAndPost_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDetailInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).CreateUser(ctx, req.(*UserDetailInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_EditUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).EditUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_EditUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).EditUser(ctx, req.(*EditUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_ChangeUserName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ChangeUserName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ChangeUserName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ChangeUserName(ctx, req.(*ChangeUserNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_AuthenticateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).AuthenticateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_AuthenticateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).AuthenticateUser(ctx, req.(*AuthenticateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_RequestDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).RequestDataExport(ctx, req.(*RequestDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).GetDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_GetDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).GetDataExport(ctx, req.(*GetDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_DownloadDataExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadDataExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserAndPostServer).DownloadDataExport(m, &userAndPostDownloadDataExportServer{stream})
+}
+
+type UserAndPost_DownloadDataExportServer interface {
+	Send(*DownloadDataExportResponse) error
+	grpc.ServerStream
+}
+
+type userAndPostDownloadDataExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *userAndPostDownloadDataExportServer) Send(m *DownloadDataExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UserAndPost_ImportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ImportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ImportAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ImportAccount(ctx, req.(*ImportAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_GetUserByUserName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByUserNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).GetUserByUserName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_GetUserByUserName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).GetUserByUserName(ctx, req.(*GetUserByUserNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).GetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_GetUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).GetUsers(ctx, req.(*GetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_EnrollSecondFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollSecondFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).EnrollSecondFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_EnrollSecondFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).EnrollSecondFactor(ctx, req.(*EnrollSecondFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_ConfirmSecondFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmSecondFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ConfirmSecondFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ConfirmSecondFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ConfirmSecondFactor(ctx, req.(*ConfirmSecondFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_GenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).GenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_GenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).GenerateRecoveryCodes(ctx, req.(*GenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_VerifySecondFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySecondFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).VerifySecondFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_VerifySecondFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).VerifySecondFactor(ctx, req.(*VerifySecondFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_RequestEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).RequestEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_RequestEmailVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).RequestEmailVerification(ctx, req.(*RequestEmailVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_LoginWithIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).LoginWithIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_LoginWithIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).LoginWithIdentity(ctx, req.(*LoginWithIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_LinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).LinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_LinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).LinkIdentity(ctx, req.(*LinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_UnlinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).UnlinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_UnlinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).UnlinkIdentity(ctx, req.(*UnlinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_ListIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ListIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ListIdentities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ListIdentities(ctx, req.(*ListIdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).CheckPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_CheckPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).CheckPermission(ctx, req.(*CheckPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).SuspendUser(ctx, req.(*SuspendUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_ForceDeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceDeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ForceDeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ForceDeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ForceDeletePost(ctx, req.(*ForceDeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_ReportPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ReportPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ReportPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ReportPost(ctx, req.(*ReportPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ListReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ListReports(ctx, req.(*ListReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_FollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_UnfollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_GetFollowerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowerListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).GetFollowerList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_GetFollowerList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).GetFollowerList(ctx, req.(*GetFollowerListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_GetFollowingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).GetFollowingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_GetFollowingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).GetFollowingList(ctx, req.(*GetFollowingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_ListFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ListFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ListFollowRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ListFollowRequests(ctx, req.(*ListFollowRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_ApproveFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ApproveFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ApproveFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ApproveFollowRequest(ctx, req.(*ApproveFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).RejectFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_RejectFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).RejectFollowRequest(ctx, req.(*RejectFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_CancelFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).CancelFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_CancelFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).CancelFollowRequest(ctx, req.(*CancelFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_GetRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).GetRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_GetRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).GetRelationship(ctx, req.(*GetRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_GetRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).GetRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_GetRelationships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).GetRelationships(ctx, req.(*GetRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_SuggestFollows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).SuggestFollows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_SuggestFollows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).SuggestFollows(ctx, req.(*SuggestFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_BulkFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).BulkFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_BulkFollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).BulkFollow(ctx, req.(*BulkFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_BulkUnfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).BulkUnfollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_BulkUnfollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).BulkUnfollow(ctx, req.(*BulkFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserAndPostServer) CommentPost(context.Context, *CommentPostRequest) (*CommentPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommentPost not implemented")
+
+func _UserAndPost_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserAndPostServer) mustEmbedUnimplementedUserAndPostServer() {}
 
-// UnsafeUserAndPostServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UserAndPostServer will
-// result in compilation errors.
-type UnsafeUserAndPostServer interface {
-	mustEmbedUnimplementedUserAndPostServer()
+func _UserAndPost_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUserAndPostServer(s grpc.ServiceRegistrar, srv UserAndPostServer) {
-	s.RegisterService(&UserAndPost_ServiceDesc, srv)
+func _UserAndPost_ListBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ListBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ListBlockedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ListBlockedUsers(ctx, req.(*ListBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UserAndPost_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserDetailInfo)
+func _UserAndPost_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserAndPostServer).CreateUser(ctx, in)
+		return srv.(UserAndPostServer).MuteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserAndPost_CreateUser_FullMethodName,
+		FullMethod: UserAndPost_MuteUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserAndPostServer).CreateUser(ctx, req.(*UserDetailInfo))
+		return srv.(UserAndPostServer).MuteUser(ctx, req.(*MuteUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserAndPost_EditUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditUserRequest)
+func _UserAndPost_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserAndPostServer).EditUser(ctx, in)
+		return srv.(UserAndPostServer).UnmuteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserAndPost_EditUser_FullMethodName,
+		FullMethod: UserAndPost_UnmuteUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserAndPostServer).EditUser(ctx, req.(*EditUserRequest))
+		return srv.(UserAndPostServer).UnmuteUser(ctx, req.(*UnmuteUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserAndPost_AuthenticateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthenticateUserRequest)
+func _UserAndPost_ListMutedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMutedUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserAndPostServer).AuthenticateUser(ctx, in)
+		return srv.(UserAndPostServer).ListMutedUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserAndPost_AuthenticateUser_FullMethodName,
+		FullMethod: UserAndPost_ListMutedUsers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserAndPostServer).AuthenticateUser(ctx, req.(*AuthenticateUserRequest))
+		return srv.(UserAndPostServer).ListMutedUsers(ctx, req.(*ListMutedUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserAndPost_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowUserRequest)
+func _UserAndPost_AddCloseFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCloseFriendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserAndPostServer).FollowUser(ctx, in)
+		return srv.(UserAndPostServer).AddCloseFriend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserAndPost_FollowUser_FullMethodName,
+		FullMethod: UserAndPost_AddCloseFriend_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserAndPostServer).FollowUser(ctx, req.(*FollowUserRequest))
+		return srv.(UserAndPostServer).AddCloseFriend(ctx, req.(*AddCloseFriendRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserAndPost_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnfollowUserRequest)
+func _UserAndPost_RemoveCloseFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCloseFriendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserAndPostServer).UnfollowUser(ctx, in)
+		return srv.(UserAndPostServer).RemoveCloseFriend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserAndPost_UnfollowUser_FullMethodName,
+		FullMethod: UserAndPost_RemoveCloseFriend_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserAndPostServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+		return srv.(UserAndPostServer).RemoveCloseFriend(ctx, req.(*RemoveCloseFriendRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserAndPost_GetFollowerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowerListRequest)
+func _UserAndPost_ListCloseFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCloseFriendsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserAndPostServer).GetFollowerList(ctx, in)
+		return srv.(UserAndPostServer).ListCloseFriends(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserAndPost_GetFollowerList_FullMethodName,
+		FullMethod: UserAndPost_ListCloseFriends_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserAndPostServer).GetFollowerList(ctx, req.(*GetFollowerListRequest))
+		return srv.(UserAndPostServer).ListCloseFriends(ctx, req.(*ListCloseFriendsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -427,6 +2043,96 @@ func _UserAndPost_EditPost_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserAndPost_ListUserPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ListUserPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ListUserPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ListUserPosts(ctx, req.(*ListUserPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_PinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).PinPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_PinPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).PinPost(ctx, req.(*PinPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_UnpinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).UnpinPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_UnpinPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).UnpinPost(ctx, req.(*UnpinPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_ListPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).ListPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_ListPostRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).ListPostRevisions(ctx, req.(*ListPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserAndPost_GetPostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserAndPostServer).GetPostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserAndPost_GetPostRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserAndPostServer).GetPostRevision(ctx, req.(*GetPostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserAndPost_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LikePostRequest)
 	if err := dec(in); err != nil {
@@ -478,10 +2184,110 @@ var UserAndPost_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditUser",
 			Handler:    _UserAndPost_EditUser_Handler,
 		},
+		{
+			MethodName: "ChangeUserName",
+			Handler:    _UserAndPost_ChangeUserName_Handler,
+		},
 		{
 			MethodName: "AuthenticateUser",
 			Handler:    _UserAndPost_AuthenticateUser_Handler,
 		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _UserAndPost_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _UserAndPost_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _UserAndPost_GetDataExport_Handler,
+		},
+		{
+			MethodName: "ImportAccount",
+			Handler:    _UserAndPost_ImportAccount_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _UserAndPost_GetUser_Handler,
+		},
+		{
+			MethodName: "GetUserByUserName",
+			Handler:    _UserAndPost_GetUserByUserName_Handler,
+		},
+		{
+			MethodName: "GetUsers",
+			Handler:    _UserAndPost_GetUsers_Handler,
+		},
+		{
+			MethodName: "EnrollSecondFactor",
+			Handler:    _UserAndPost_EnrollSecondFactor_Handler,
+		},
+		{
+			MethodName: "ConfirmSecondFactor",
+			Handler:    _UserAndPost_ConfirmSecondFactor_Handler,
+		},
+		{
+			MethodName: "GenerateRecoveryCodes",
+			Handler:    _UserAndPost_GenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "VerifySecondFactor",
+			Handler:    _UserAndPost_VerifySecondFactor_Handler,
+		},
+		{
+			MethodName: "RequestEmailVerification",
+			Handler:    _UserAndPost_RequestEmailVerification_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _UserAndPost_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _UserAndPost_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _UserAndPost_ResetPassword_Handler,
+		},
+		{
+			MethodName: "LoginWithIdentity",
+			Handler:    _UserAndPost_LoginWithIdentity_Handler,
+		},
+		{
+			MethodName: "LinkIdentity",
+			Handler:    _UserAndPost_LinkIdentity_Handler,
+		},
+		{
+			MethodName: "UnlinkIdentity",
+			Handler:    _UserAndPost_UnlinkIdentity_Handler,
+		},
+		{
+			MethodName: "ListIdentities",
+			Handler:    _UserAndPost_ListIdentities_Handler,
+		},
+		{
+			MethodName: "CheckPermission",
+			Handler:    _UserAndPost_CheckPermission_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _UserAndPost_SuspendUser_Handler,
+		},
+		{
+			MethodName: "ForceDeletePost",
+			Handler:    _UserAndPost_ForceDeletePost_Handler,
+		},
+		{
+			MethodName: "ReportPost",
+			Handler:    _UserAndPost_ReportPost_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _UserAndPost_ListReports_Handler,
+		},
 		{
 			MethodName: "FollowUser",
 			Handler:    _UserAndPost_FollowUser_Handler,
@@ -494,6 +2300,82 @@ var UserAndPost_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFollowerList",
 			Handler:    _UserAndPost_GetFollowerList_Handler,
 		},
+		{
+			MethodName: "GetFollowingList",
+			Handler:    _UserAndPost_GetFollowingList_Handler,
+		},
+		{
+			MethodName: "ListFollowRequests",
+			Handler:    _UserAndPost_ListFollowRequests_Handler,
+		},
+		{
+			MethodName: "ApproveFollowRequest",
+			Handler:    _UserAndPost_ApproveFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _UserAndPost_RejectFollowRequest_Handler,
+		},
+		{
+			MethodName: "CancelFollowRequest",
+			Handler:    _UserAndPost_CancelFollowRequest_Handler,
+		},
+		{
+			MethodName: "GetRelationship",
+			Handler:    _UserAndPost_GetRelationship_Handler,
+		},
+		{
+			MethodName: "GetRelationships",
+			Handler:    _UserAndPost_GetRelationships_Handler,
+		},
+		{
+			MethodName: "SuggestFollows",
+			Handler:    _UserAndPost_SuggestFollows_Handler,
+		},
+		{
+			MethodName: "BulkFollow",
+			Handler:    _UserAndPost_BulkFollow_Handler,
+		},
+		{
+			MethodName: "BulkUnfollow",
+			Handler:    _UserAndPost_BulkUnfollow_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _UserAndPost_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _UserAndPost_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlockedUsers",
+			Handler:    _UserAndPost_ListBlockedUsers_Handler,
+		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _UserAndPost_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _UserAndPost_UnmuteUser_Handler,
+		},
+		{
+			MethodName: "ListMutedUsers",
+			Handler:    _UserAndPost_ListMutedUsers_Handler,
+		},
+		{
+			MethodName: "AddCloseFriend",
+			Handler:    _UserAndPost_AddCloseFriend_Handler,
+		},
+		{
+			MethodName: "RemoveCloseFriend",
+			Handler:    _UserAndPost_RemoveCloseFriend_Handler,
+		},
+		{
+			MethodName: "ListCloseFriends",
+			Handler:    _UserAndPost_ListCloseFriends_Handler,
+		},
 		{
 			MethodName: "CreatePost",
 			Handler:    _UserAndPost_CreatePost_Handler,
@@ -510,6 +2392,26 @@ var UserAndPost_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditPost",
 			Handler:    _UserAndPost_EditPost_Handler,
 		},
+		{
+			MethodName: "ListUserPosts",
+			Handler:    _UserAndPost_ListUserPosts_Handler,
+		},
+		{
+			MethodName: "PinPost",
+			Handler:    _UserAndPost_PinPost_Handler,
+		},
+		{
+			MethodName: "UnpinPost",
+			Handler:    _UserAndPost_UnpinPost_Handler,
+		},
+		{
+			MethodName: "ListPostRevisions",
+			Handler:    _UserAndPost_ListPostRevisions_Handler,
+		},
+		{
+			MethodName: "GetPostRevision",
+			Handler:    _UserAndPost_GetPostRevision_Handler,
+		},
 		{
 			MethodName: "LikePost",
 			Handler:    _UserAndPost_LikePost_Handler,
@@ -519,6 +2421,12 @@ var UserAndPost_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _UserAndPost_CommentPost_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadDataExport",
+			Handler:       _UserAndPost_DownloadDataExport_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "internal/interfaces/proto/protobuf/user_and_post/user_and_post.proto",
 }
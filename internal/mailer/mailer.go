@@ -0,0 +1,36 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/khailequang334/social_network/configs"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers transactional mail such as email verification and password reset links
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewMailer picks the implementation configured by type: smtp, file or memory.
+// The type has no default, memory drops every message and must be chosen explicitly
+func NewMailer(conf configs.MailerConfig) (Mailer, error) {
+	switch conf.Type {
+	case "smtp":
+		return NewSMTPMailer(conf.From, conf.SMTP), nil
+	case "file":
+		return NewFileMailer(conf.From, conf.Dir)
+	case "memory":
+		return NewMemoryMailer(), nil
+	case "":
+		return nil, fmt.Errorf("mailer type is not set, use smtp, file or memory")
+	default:
+		return nil, fmt.Errorf("unsupported mailer type %q", conf.Type)
+	}
+}
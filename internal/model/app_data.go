@@ -12,43 +12,286 @@ type CreateUserRequest struct {
 }
 
 type EditUserRequest struct {
-	FirstName *string `json:"first_name"`
-	LastName  *string `json:"last_name"`
-	Dob       *string `json:"dob"`
-	Password  *string `json:"password"`
+	FirstName  *string `json:"first_name"`
+	LastName   *string `json:"last_name"`
+	Dob        *string `json:"dob"`
+	Password   *string `json:"password"`
+	Bio        *string `json:"bio"`
+	AvatarPath *string `json:"avatar_path"`
+	Location   *string `json:"location"`
+	Website    *string `json:"website"`
+	Pronouns   *string `json:"pronouns"`
+	// visibility values are "public", "followers" or "private"
+	BioVisibility      *string `json:"bio_visibility"`
+	AvatarVisibility   *string `json:"avatar_visibility"`
+	LocationVisibility *string `json:"location_visibility"`
+	WebsiteVisibility  *string `json:"website_visibility"`
+	PronounsVisibility *string `json:"pronouns_visibility"`
+	// Private accounts approve each follower
+	Private *bool `json:"private"`
+}
+
+type ChangeUserNameRequest struct {
+	UserName string `json:"user_name"`
 }
 
 type LoginRequest struct {
 	UserName string `json:"user_name"`
 	Password string `json:"password"`
+	// IssueTokens returns a JWT access/refresh token pair instead of setting a session cookie
+	IssueTokens bool `json:"issue_tokens"`
+}
+
+type ConfirmSecondFactorRequest struct {
+	Code string `json:"code"`
+}
+
+type VerifySecondFactorRequest struct {
+	ChallengeId string `json:"challenge_id"`
+	// Code is a TOTP code or one of the recovery codes
+	Code        string `json:"code"`
+	IssueTokens bool   `json:"issue_tokens"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
 }
 
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// Audience is "public", "followers", "close_friends" or "only_me", posts are
+// public when it is left out
 type CreatePostRequest struct {
-	UserId           int64  `json:"user_id"`
-	ContentText      string `json:"content_text"`
-	ContentImagePath string `json:"content_image_path"`
-	Visible          bool   `json:"visible"`
+	ContentText      string  `json:"content_text"`
+	ContentImagePath string  `json:"content_image_path"`
+	Audience         *string `json:"audience"`
 }
 
 type EditPostRequest struct {
 	ContentText      *string `json:"content_text"`
 	ContentImagePath *string `json:"content_image_path"`
-	Visible          *bool   `json:"visible"`
+	Audience         *string `json:"audience"`
 }
 
 type CreatePostCommentRequest struct {
 	ContentText string `json:"content_text"`
 }
 
+// ModerationRequest carries the reason recorded with a moderation action
+type ModerationRequest struct {
+	Reason string `json:"reason"`
+}
+
+type ReportPostRequest struct {
+	Reason string `json:"reason"`
+}
+
 type MessageResponse struct {
 	Message string `json:"message"`
 }
 
+// ValidationErrorResponse is rendered with 422, Errors maps each rejected field to the reason
+type ValidationErrorResponse struct {
+	Message string            `json:"message"`
+	Errors  map[string]string `json:"errors"`
+}
+
+type DeleteAccountResponse struct {
+	Message   string    `json:"message"`
+	PurgeTime time.Time `json:"purge_time"`
+}
+
+type RequestDataExportResponse struct {
+	Message  string `json:"message"`
+	ExportId int64  `json:"export_id"`
+}
+
+// DataExportResponse State is "pending", "running", "ready", "failed" or "expired"
+type DataExportResponse struct {
+	ExportId      int64      `json:"export_id"`
+	State         string     `json:"state"`
+	CreatedTime   time.Time  `json:"created_time"`
+	CompletedTime *time.Time `json:"completed_time,omitempty"`
+	ExpiresTime   *time.Time `json:"expires_time,omitempty"`
+	SizeBytes     int64      `json:"size_bytes"`
+}
+
+type SecondFactorChallengeResponse struct {
+	Message     string `json:"message"`
+	ChallengeId string `json:"challenge_id"`
+}
+
+type EnrollSecondFactorResponse struct {
+	Secret     string `json:"secret"`
+	OtpauthUri string `json:"otpauth_uri"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+type IdentityResponse struct {
+	IdentityId int64     `json:"identity_id"`
+	Issuer     string    `json:"issuer"`
+	Subject    string    `json:"subject"`
+	Email      string    `json:"email"`
+	LinkedTime time.Time `json:"linked_time"`
+}
+
+type ListIdentitiesResponse struct {
+	Identities []IdentityResponse `json:"identities"`
+}
+
+type FollowResponse struct {
+	UserId       int64     `json:"user_id"`
+	UserName     string    `json:"user_name"`
+	FollowedTime time.Time `json:"followed_time"`
+}
+
+type ListFollowsResponse struct {
+	Users []FollowResponse `json:"users"`
+	// NextCursor is passed back as the cursor query parameter for the next page,
+	// it is left out on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type FollowSuggestionResponse struct {
+	UserId           int64  `json:"user_id"`
+	UserName         string `json:"user_name"`
+	FriendsOfFriends int64  `json:"friends_of_friends"`
+	MutualFollowers  int64  `json:"mutual_followers"`
+}
+
+type ListFollowSuggestionsResponse struct {
+	Suggestions []FollowSuggestionResponse `json:"suggestions"`
+}
+
+type RelationshipResponse struct {
+	UserId          int64 `json:"user_id"`
+	Following       bool  `json:"following"`
+	FollowedBy      bool  `json:"followed_by"`
+	Mutual          bool  `json:"mutual"`
+	Blocking        bool  `json:"blocking"`
+	Muting          bool  `json:"muting"`
+	FollowRequested bool  `json:"follow_requested"`
+}
+
+type ListRelationshipsResponse struct {
+	Relationships []RelationshipResponse `json:"relationships"`
+}
+
+type RelatedUserResponse struct {
+	UserId    int64     `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	SinceTime time.Time `json:"since_time"`
+}
+
+type ListRelatedUsersResponse struct {
+	Users []RelatedUserResponse `json:"users"`
+}
+
+type UserProfileResponse struct {
+	UserId           int64  `json:"user_id"`
+	UserName         string `json:"user_name"`
+	FirstName        string `json:"first_name"`
+	LastName         string `json:"last_name"`
+	DisplayName      string `json:"display_name"`
+	Bio              string `json:"bio"`
+	AvatarPath       string `json:"avatar_path"`
+	Location         string `json:"location"`
+	Website          string `json:"website"`
+	Pronouns         string `json:"pronouns"`
+	FollowerCount    int64  `json:"follower_count"`
+	FollowingCount   int64  `json:"following_count"`
+	PostCount        int64  `json:"post_count"`
+	FollowedByCaller bool   `json:"followed_by_caller"`
+	Private          bool   `json:"private"`
+	// Visibility is only set on the current user's own profile
+	Visibility *ProfileVisibilityResponse `json:"visibility,omitempty"`
+	// RedirectedFrom is the old user name a lookup resolved through
+	RedirectedFrom string `json:"redirected_from,omitempty"`
+}
+
+type ProfileVisibilityResponse struct {
+	Bio      string `json:"bio"`
+	Avatar   string `json:"avatar"`
+	Location string `json:"location"`
+	Website  string `json:"website"`
+	Pronouns string `json:"pronouns"`
+}
+
+type ListUserProfilesResponse struct {
+	Users []UserProfileResponse `json:"users"`
+}
+
+type ReportResponse struct {
+	ReportId     int64      `json:"report_id"`
+	ReporterId   int64      `json:"reporter_id"`
+	PostId       int64      `json:"post_id"`
+	Reason       string     `json:"reason"`
+	CreatedTime  time.Time  `json:"created_time"`
+	ResolvedTime *time.Time `json:"resolved_time,omitempty"`
+}
+
+type ListReportsResponse struct {
+	Reports []ReportResponse `json:"reports"`
+}
+
 type PostDetailResponse struct {
 	PostID           int64     `json:"post_id"`
 	UserID           int64     `json:"user_id"`
 	ContentText      string    `json:"content_text"`
 	ContentImagePath string    `json:"content_image_path"`
-	Visible          bool      `json:"visible"`
+	Audience         string    `json:"audience"`
 	CreatedTime      time.Time `json:"created_time"`
+	// PinnedTime is only set on pinned posts
+	PinnedTime *time.Time `json:"pinned_time,omitempty"`
+	// EditedTime is only set on edited posts
+	EditedTime *time.Time `json:"edited_time,omitempty"`
+}
+
+// PostRevisionResponse is the content a post had before one of its edits
+type PostRevisionResponse struct {
+	RevisionID       int64     `json:"revision_id"`
+	PostID           int64     `json:"post_id"`
+	EditorID         int64     `json:"editor_id"`
+	ContentText      string    `json:"content_text"`
+	ContentImagePath string    `json:"content_image_path"`
+	Audience         string    `json:"audience"`
+	CreatedTime      time.Time `json:"created_time"`
+}
+
+type ListPostRevisionsResponse struct {
+	Revisions []PostRevisionResponse `json:"revisions"`
+}
+
+type ListPostsResponse struct {
+	// PinnedPosts are only returned with the first page
+	PinnedPosts []PostDetailResponse `json:"pinned_posts,omitempty"`
+	Posts       []PostDetailResponse `json:"posts"`
+	// NextCursor is passed back as the cursor query parameter for the next page,
+	// it is left out on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
 }
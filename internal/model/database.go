@@ -7,19 +7,49 @@ import (
 )
 
 type User struct {
-	ID             uint      `gorm:"primary"`
-	HashedPassword string    `gorm:"size:50;not null"`
-	Salt           string    `gorm:"size:20;not null"`
-	FirstName      string    `gorm:"size:50;not null"`
-	LastName       string    `gorm:"size:50;not null"`
-	DateOfBirth    time.Time `gorm:"not null"`
-	Email          string    `gorm:"size:50;not null"`
-	UserName       string    `gorm:"size:50;not null;index:idx_username"`
-	Following      []*User   `gorm:"many2many:following;foreignKey:id;joinForeignKey:user_id;References:id;joinReferences:friend_id"`
-	Follower       []*User   `gorm:"many2many:following;foreignKey:id;joinForeignKey:friend_id;References:id;joinReferences:user_id"`
-	Posts          []*Post   `gorm:"foreignKey:UserID"`
+	ID              uint       `gorm:"primary"`
+	HashedPassword  string     `gorm:"size:256;not null"`
+	Salt            string     `gorm:"size:20;not null;default:''"` // legacy hashes only, empty once rehashed
+	FirstName       string     `gorm:"size:50;not null"`
+	LastName        string     `gorm:"size:50;not null"`
+	DateOfBirth     *time.Time `gorm:"default:null"` // optional for accounts provisioned from an external identity
+	Email           string     `gorm:"size:50;not null;uniqueIndex:idx_email"`
+	UserName        string     `gorm:"size:50;not null;uniqueIndex:idx_username"`
+	Bio             string     `gorm:"size:255;not null;default:''"`
+	AvatarPath      string     `gorm:"size:255;not null;default:''"`
+	Location        string     `gorm:"size:100;not null;default:''"`
+	Website         string     `gorm:"size:255;not null;default:''"`
+	Pronouns        string     `gorm:"size:30;not null;default:''"`
+	Following       []*User    `gorm:"many2many:following;foreignKey:id;joinForeignKey:user_id;References:id;joinReferences:friend_id"`
+	Follower        []*User    `gorm:"many2many:following;foreignKey:id;joinForeignKey:friend_id;References:id;joinReferences:user_id"`
+	Posts           []*Post    `gorm:"foreignKey:UserID"`
+	EmailVerifiedAt *time.Time
+	// SuspendedAt is set by a moderator, suspended users can not log in
+	SuspendedAt *time.Time
+	// DeactivatedAt is set when the user deletes the account, logging in again
+	// before the grace period ends restores it
+	DeactivatedAt *time.Time
+	// PurgedAt is set once the content is deleted and the row anonymized
+	PurgedAt *time.Time
+	// who may see each optional profile field, the owner always sees everything
+	BioVisibility      Visibility `gorm:"not null;default:0"`
+	AvatarVisibility   Visibility `gorm:"not null;default:0"`
+	LocationVisibility Visibility `gorm:"not null;default:0"`
+	WebsiteVisibility  Visibility `gorm:"not null;default:0"`
+	PronounsVisibility Visibility `gorm:"not null;default:0"`
+	// Private accounts approve each follower, their posts are only shown to followers
+	Private bool `gorm:"not null;default:false"`
 }
 
+// Visibility uses the same values as the user_and_post.Visibility proto enum
+type Visibility int8
+
+const (
+	VisibilityPublic Visibility = iota
+	VisibilityFollowers
+	VisibilityPrivate
+)
+
 func (User) TableName() string {
 	return "user"
 }
@@ -29,15 +59,41 @@ type Post struct {
 	ContentText      string     `gorm:"size:500"`
 	ContentImagePath string     `gorm:"size:255"`
 	UserID           uint       `gorm:"not null"`
-	Visible          bool       `gorm:"not null"`
+	Audience         Audience   `gorm:"not null;default:0"`
 	Comments         []*Comment `gorm:"foreignKey:PostID"`
 	LikedUsers       []*User    `gorm:"many2many:like;foreignKey:id;joinForeignKey:post_id;References:id;joinReferences:user_id"`
+	// PinnedAt is set on posts pinned to the profile of their author
+	PinnedAt *time.Time
+	// EditedAt is set by the latest edit, the content before it is a PostRevision
+	EditedAt *time.Time
 }
 
 func (Post) TableName() string {
 	return "post"
 }
 
+// Audience uses the same values as the user_and_post.Post_Audience proto enum
+type Audience int8
+
+const (
+	AudiencePublic Audience = iota
+	AudienceFollowers
+	AudienceCloseFriends
+	AudienceOnlyMe
+)
+
+// PostsReadableBy limits a post query to the posts whose audience includes the
+// viewer, 0 is an anonymous viewer. Blocks and private accounts are not
+// checked here, every post listing applies it on top of those checks
+func PostsReadableBy(viewerId int64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("post.user_id = ? OR post.audience = ?"+
+			" OR (post.audience = ? AND post.user_id IN (SELECT friend_id FROM following WHERE user_id = ?))"+
+			" OR (post.audience = ? AND post.user_id IN (SELECT user_id FROM close_friend WHERE friend_id = ?))",
+			viewerId, AudiencePublic, AudienceFollowers, viewerId, AudienceCloseFriends, viewerId)
+	}
+}
+
 type Comment struct {
 	gorm.Model
 	Content string `gorm:"size:255;not null"`
@@ -58,3 +114,200 @@ type Like struct {
 func (Like) TableName() string {
 	return "like"
 }
+
+type TwoFactor struct {
+	gorm.Model
+	UserID      uint   `gorm:"not null;uniqueIndex"`
+	Secret      string `gorm:"size:64;not null"`
+	ConfirmedAt *time.Time
+	// LastUsedStep is the last accepted TOTP time step, older steps are replays
+	LastUsedStep int64 `gorm:"not null;default:0"`
+}
+
+func (TwoFactor) TableName() string {
+	return "two_factor"
+}
+
+type RecoveryCode struct {
+	gorm.Model
+	UserID   uint   `gorm:"not null;index"`
+	CodeHash string `gorm:"size:64;not null"`
+	UsedAt   *time.Time
+}
+
+func (RecoveryCode) TableName() string {
+	return "recovery_code"
+}
+
+const (
+	UserTokenPurposeVerifyEmail   = "verify_email"
+	UserTokenPurposeResetPassword = "reset_password"
+)
+
+// UserToken is a single-use, expiring token sent to the user by mail, only its hash is stored
+type UserToken struct {
+	gorm.Model
+	UserID    uint      `gorm:"not null;index"`
+	Purpose   string    `gorm:"size:20;not null"`
+	TokenHash string    `gorm:"size:64;not null;uniqueIndex"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+}
+
+func (UserToken) TableName() string {
+	return "user_token"
+}
+
+// IdentityLink maps an account at an external OpenID Connect provider to a local user
+type IdentityLink struct {
+	gorm.Model
+	UserID  uint   `gorm:"not null;index"`
+	Issuer  string `gorm:"size:255;not null;uniqueIndex:idx_identity_link_issuer_subject"`
+	Subject string `gorm:"size:255;not null;uniqueIndex:idx_identity_link_issuer_subject"`
+	Email   string `gorm:"size:50"`
+}
+
+func (IdentityLink) TableName() string {
+	return "identity_link"
+}
+
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+type Role struct {
+	ID          uint          `gorm:"primaryKey"`
+	Name        string        `gorm:"size:20;not null;uniqueIndex"`
+	Permissions []*Permission `gorm:"many2many:role_permission"`
+}
+
+func (Role) TableName() string {
+	return "role"
+}
+
+type Permission struct {
+	ID   uint   `gorm:"primaryKey"`
+	Name string `gorm:"size:50;not null;uniqueIndex"`
+}
+
+func (Permission) TableName() string {
+	return "permission"
+}
+
+type UserRole struct {
+	UserID uint `gorm:"primaryKey"`
+	RoleID uint `gorm:"primaryKey"`
+}
+
+func (UserRole) TableName() string {
+	return "user_role"
+}
+
+// Report is a post flagged by a user for moderators to review
+type Report struct {
+	gorm.Model
+	ReporterID uint   `gorm:"not null;uniqueIndex:idx_report_reporter_post"`
+	PostID     uint   `gorm:"not null;uniqueIndex:idx_report_reporter_post"`
+	Reason     string `gorm:"size:255;not null"`
+	ResolvedAt *time.Time
+}
+
+func (Report) TableName() string {
+	return "report"
+}
+
+const (
+	DataExportStatePending = "pending"
+	DataExportStateRunning = "running"
+	DataExportStateReady   = "ready"
+	DataExportStateFailed  = "failed"
+)
+
+// DataExport tracks one takeout archive of a user, the archive itself is a file
+type DataExport struct {
+	gorm.Model
+	UserID      uint   `gorm:"not null;index"`
+	State       string `gorm:"size:20;not null"`
+	FilePath    string `gorm:"size:255;not null;default:''"`
+	SizeBytes   int64  `gorm:"not null;default:0"`
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+}
+
+func (DataExport) TableName() string {
+	return "data_export"
+}
+
+// UserNameHistory keeps a user name given up by a rename, lookups by it
+// resolve to the user and nobody else can take it until ReleasedAt
+type UserNameHistory struct {
+	gorm.Model
+	UserID     uint      `gorm:"not null;index"`
+	UserName   string    `gorm:"size:50;not null;index"`
+	ReleasedAt time.Time `gorm:"not null"`
+}
+
+func (UserNameHistory) TableName() string {
+	return "user_name_history"
+}
+
+// Block hides the two users from each other and stops them interacting, in both directions
+type Block struct {
+	BlockerID uint `gorm:"primaryKey"`
+	BlockedID uint `gorm:"primaryKey;index"`
+	CreatedAt time.Time
+}
+
+func (Block) TableName() string {
+	return "user_block"
+}
+
+// Mute only hides the posts of the muted user from the muter's newsfeed
+type Mute struct {
+	MuterID   uint `gorm:"primaryKey"`
+	MutedID   uint `gorm:"primaryKey;index"`
+	CreatedAt time.Time
+}
+
+func (Mute) TableName() string {
+	return "user_mute"
+}
+
+// FollowRequest is a pending follow of a private account
+type FollowRequest struct {
+	RequesterID uint `gorm:"primaryKey"`
+	TargetID    uint `gorm:"primaryKey;index"`
+	CreatedAt   time.Time
+}
+
+func (FollowRequest) TableName() string {
+	return "follow_request"
+}
+
+// CloseFriend lets FriendID read the posts UserID shares with close friends
+type CloseFriend struct {
+	UserID    uint `gorm:"primaryKey"`
+	FriendID  uint `gorm:"primaryKey;index"`
+	CreatedAt time.Time
+}
+
+func (CloseFriend) TableName() string {
+	return "close_friend"
+}
+
+// PostRevision keeps the content a post had before one of its edits
+type PostRevision struct {
+	ID               uint     `gorm:"primaryKey"`
+	PostID           uint     `gorm:"not null;index"`
+	EditorID         uint     `gorm:"not null"`
+	ContentText      string   `gorm:"size:500"`
+	ContentImagePath string   `gorm:"size:255"`
+	Audience         Audience `gorm:"not null"`
+	CreatedAt        time.Time
+}
+
+func (PostRevision) TableName() string {
+	return "post_revision"
+}
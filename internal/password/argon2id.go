@@ -0,0 +1,156 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/khailequang334/social_network/configs"
+	"golang.org/x/crypto/argon2"
+)
+
+const argon2idId = "argon2id"
+
+// Bounds of the parameters read from a stored hash, a corrupt or hostile hash
+// must not panic argon2 or make it allocate without limit. The configured
+// parameters are held to the same bounds so every new hash can be verified
+const (
+	maxArgon2idMemory      = 1024 * 1024 // KiB
+	maxArgon2idIterations  = 64
+	maxArgon2idParallelism = 64
+	minArgon2idSaltLength  = 8
+	minArgon2idKeyLength   = 16
+	maxArgon2idKeyLength   = 1024
+)
+
+type Argon2idHasher struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	saltLength  uint32
+	keyLength   uint32
+}
+
+// NewArgon2idHasher falls back to the OWASP recommended parameters for unset values
+func NewArgon2idHasher(conf configs.Argon2idConfig) *Argon2idHasher {
+	h := &Argon2idHasher{
+		memory:      conf.Memory,
+		iterations:  conf.Iterations,
+		parallelism: conf.Parallelism,
+		saltLength:  conf.SaltLength,
+		keyLength:   conf.KeyLength,
+	}
+	if h.memory == 0 {
+		h.memory = 19 * 1024
+	}
+	if h.iterations == 0 {
+		h.iterations = 2
+	}
+	if h.parallelism == 0 {
+		h.parallelism = 1
+	}
+	if h.saltLength == 0 {
+		h.saltLength = 16
+	}
+	if h.keyLength == 0 {
+		h.keyLength = 32
+	}
+	return h
+}
+
+type argon2idParams struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	salt        []byte
+	key         []byte
+}
+
+func (h *Argon2idHasher) MaxPasswordBytes() int {
+	return 0
+}
+
+// Hash returns $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>
+func (h *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, h.iterations, h.memory, h.parallelism, h.keyLength)
+
+	return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idId, argon2.Version, h.memory, h.iterations, h.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func (h *Argon2idHasher) NeedsRehash(encoded string) bool {
+	params, err := decodeArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+	return params.memory != h.memory || params.iterations != h.iterations || params.parallelism != h.parallelism ||
+		uint32(len(params.salt)) != h.saltLength || uint32(len(params.key)) != h.keyLength
+}
+
+func decodeArgon2id(encoded string) (*argon2idParams, error) {
+	// "", "argon2id", "v=19", "m=..,t=..,p=..", salt, key
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != argon2idId {
+		return nil, ErrUnknownHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, fmt.Errorf("invalid argon2id version: %w", err)
+	}
+	if version != argon2.Version {
+		return nil, fmt.Errorf("unsupported argon2id version %d", version)
+	}
+
+	params := &argon2idParams{}
+	_, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism)
+	if err != nil {
+		return nil, fmt.Errorf("invalid argon2id parameters: %w", err)
+	}
+	if params.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, fmt.Errorf("invalid argon2id salt: %w", err)
+	}
+	if params.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return nil, fmt.Errorf("invalid argon2id key: %w", err)
+	}
+	err = checkArgon2idParams(params.memory, params.iterations, params.parallelism, len(params.salt), len(params.key))
+	if err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+func checkArgon2idParams(memory uint32, iterations uint32, parallelism uint8, saltLength int, keyLength int) error {
+	if parallelism < 1 || parallelism > maxArgon2idParallelism {
+		return fmt.Errorf("argon2id parallelism %d out of range", parallelism)
+	}
+	if iterations < 1 || iterations > maxArgon2idIterations {
+		return fmt.Errorf("argon2id iterations %d out of range", iterations)
+	}
+	if memory < 8*uint32(parallelism) || memory > maxArgon2idMemory {
+		return fmt.Errorf("argon2id memory %d KiB out of range", memory)
+	}
+	if saltLength < minArgon2idSaltLength {
+		return fmt.Errorf("argon2id salt length %d is too short", saltLength)
+	}
+	if keyLength < minArgon2idKeyLength || keyLength > maxArgon2idKeyLength {
+		return fmt.Errorf("argon2id key length %d out of range", keyLength)
+	}
+	return nil
+}
+
+func verifyArgon2id(password string, encoded string) (bool, error) {
+	params, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false, err
+	}
+	key := argon2.IDKey([]byte(password), params.salt, params.iterations, params.memory, params.parallelism, uint32(len(params.key)))
+	return subtle.ConstantTimeCompare(key, params.key) == 1, nil
+}
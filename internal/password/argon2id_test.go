@@ -0,0 +1,43 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/khailequang334/social_network/configs"
+)
+
+func TestVerifyArgon2idParameterBounds(t *testing.T) {
+	encoded, err := NewArgon2idHasher(configs.Argon2idConfig{Memory: 64, Iterations: 1}).Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := Verify("secret", encoded)
+	if err != nil || !ok {
+		t.Fatalf("Verify of a valid hash returned %v, %v", ok, err)
+	}
+
+	salt := "c29tZXNhbHRzb21lc2FsdA"
+	key := "a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"
+	hostile := map[string]string{
+		"zero parallelism": "$argon2id$v=19$m=64,t=1,p=0$" + salt + "$" + key,
+		"zero iterations":  "$argon2id$v=19$m=64,t=0,p=1$" + salt + "$" + key,
+		"huge memory":      "$argon2id$v=19$m=4294967295,t=1,p=1$" + salt + "$" + key,
+		"empty salt":       "$argon2id$v=19$m=64,t=1,p=1$$" + key,
+		"empty key":        "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$",
+	}
+	for name, encoded := range hostile {
+		if ok, err := Verify("secret", encoded); err == nil || ok {
+			t.Errorf("Verify of a hash with %s returned %v, %v, want an error", name, ok, err)
+		}
+	}
+}
+
+func TestNewHasherRejectsOutOfRangeArgon2id(t *testing.T) {
+	_, err := NewHasher(configs.PasswordHashingConfig{
+		Algorithm: "argon2id",
+		Argon2id:  configs.Argon2idConfig{KeyLength: 4},
+	})
+	if err == nil {
+		t.Error("NewHasher accepted an argon2id key length of 4")
+	}
+}
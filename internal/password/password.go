@@ -0,0 +1,61 @@
+package password
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/khailequang334/social_network/configs"
+)
+
+// ErrUnknownHash is returned by Verify for hashes no hasher can read
+var ErrUnknownHash = errors.New("unknown password hash format")
+
+// Hasher produces PHC formatted hashes, the algorithm and its parameters are
+// stored in the hash itself so the policy can change without breaking
+// existing passwords
+type Hasher interface {
+	Hash(password string) (string, error)
+	// NeedsRehash reports whether the hash was produced with another algorithm
+	// or with parameters other than the current ones
+	NeedsRehash(encoded string) bool
+	// MaxPasswordBytes is the longest password the algorithm reads in full,
+	// 0 when there is no such limit
+	MaxPasswordBytes() int
+}
+
+// NewHasher picks the hasher configured by algorithm: argon2id or bcrypt
+func NewHasher(conf configs.PasswordHashingConfig) (Hasher, error) {
+	switch conf.Algorithm {
+	case "argon2id", "":
+		h := NewArgon2idHasher(conf.Argon2id)
+		err := checkArgon2idParams(h.memory, h.iterations, h.parallelism, int(h.saltLength), int(h.keyLength))
+		if err != nil {
+			return nil, err
+		}
+		return h, nil
+	case "bcrypt":
+		return NewBcryptHasher(conf.Bcrypt.Cost), nil
+	default:
+		return nil, fmt.Errorf("unsupported password hashing algorithm %q", conf.Algorithm)
+	}
+}
+
+// Verify checks the password against a hash of any supported algorithm,
+// whatever the current policy is
+func Verify(password string, encoded string) (bool, error) {
+	switch {
+	case strings.HasPrefix(encoded, "$"+argon2idId+"$"):
+		return verifyArgon2id(password, encoded)
+	case isBcryptHash(encoded):
+		return verifyBcrypt(password, encoded)
+	default:
+		return false, ErrUnknownHash
+	}
+}
+
+// VerifyLegacy checks hashes created before PHC hashes were introduced, they
+// are bcrypt hashes of the password with a separately stored salt appended
+func VerifyLegacy(password string, salt string, encoded string) (bool, error) {
+	return verifyBcrypt(password+salt, encoded)
+}
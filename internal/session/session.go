@@ -0,0 +1,147 @@
+package session
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+var ErrInvalidSession = errors.New("invalid session")
+
+// Store keeps opaque session tokens in Redis. A token handed to the client is
+// "<id>.<signature>", the id is random and the signature is an HMAC of the id,
+// so forged or tampered tokens are rejected before touching Redis.
+type Store struct {
+	Redis  *redis.Client
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewStore(rd *redis.Client, secret string, ttl time.Duration) (*Store, error) {
+	if secret == "" {
+		return nil, errors.New("session secret must not be empty")
+	}
+	if ttl <= 0 {
+		return nil, errors.New("session ttl must be positive")
+	}
+	return &Store{
+		Redis:  rd,
+		secret: []byte(secret),
+		ttl:    ttl,
+	}, nil
+}
+
+func (s *Store) TTL() time.Duration {
+	return s.ttl
+}
+
+func sessionKey(id string) string {
+	return "session:" + id
+}
+
+func userSessionsKey(userId int64) string {
+	return "user_sessions:" + strconv.FormatInt(userId, 10)
+}
+
+func (s *Store) sign(id string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(id))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// parse verifies the token signature and returns the session id
+func (s *Store) parse(token string) (string, error) {
+	id, signature, found := strings.Cut(token, ".")
+	if !found || id == "" {
+		return "", ErrInvalidSession
+	}
+	if !hmac.Equal([]byte(signature), []byte(s.sign(id))) {
+		return "", ErrInvalidSession
+	}
+	return id, nil
+}
+
+// Create starts a new session for the user and returns the signed token
+func (s *Store) Create(ctx context.Context, userId int64) (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	id := base64.RawURLEncoding.EncodeToString(raw)
+
+	pipe := s.Redis.TxPipeline()
+	pipe.Set(ctx, sessionKey(id), userId, s.ttl)
+	pipe.SAdd(ctx, userSessionsKey(userId), id)
+	pipe.Expire(ctx, userSessionsKey(userId), s.ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", err
+	}
+	return id + "." + s.sign(id), nil
+}
+
+// Get returns the user id owning the session and slides its expiry forward
+func (s *Store) Get(ctx context.Context, token string) (int64, error) {
+	id, err := s.parse(token)
+	if err != nil {
+		return 0, err
+	}
+
+	userId, err := s.Redis.Get(ctx, sessionKey(id)).Int64()
+	if err == redis.Nil {
+		return 0, ErrInvalidSession
+	} else if err != nil {
+		return 0, err
+	}
+
+	pipe := s.Redis.Pipeline()
+	pipe.Expire(ctx, sessionKey(id), s.ttl)
+	pipe.Expire(ctx, userSessionsKey(userId), s.ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return userId, nil
+}
+
+// Delete ends a single session
+func (s *Store) Delete(ctx context.Context, token string) error {
+	id, err := s.parse(token)
+	if err != nil {
+		return err
+	}
+
+	userId, err := s.Redis.Get(ctx, sessionKey(id)).Int64()
+	if err == redis.Nil {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	pipe := s.Redis.TxPipeline()
+	pipe.Del(ctx, sessionKey(id))
+	pipe.SRem(ctx, userSessionsKey(userId), id)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// DeleteAll ends every session of the user, e.g. "log out all devices"
+func (s *Store) DeleteAll(ctx context.Context, userId int64) error {
+	ids, err := s.Redis.SMembers(ctx, userSessionsKey(userId)).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, sessionKey(id))
+	}
+	keys = append(keys, userSessionsKey(userId))
+	return s.Redis.Del(ctx, keys...).Err()
+}
@@ -0,0 +1,204 @@
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-redis/redis/v8"
+	"github.com/khailequang334/social_network/configs"
+	"golang.org/x/oauth2"
+)
+
+var (
+	ErrUnknownProvider = errors.New("unknown oidc provider")
+	ErrInvalidState    = errors.New("invalid or expired oidc state")
+)
+
+// Claims are the ID token claims used to find or provision the local account
+type Claims struct {
+	Issuer            string `json:"iss"`
+	Subject           string `json:"sub"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+	Name              string `json:"name"`
+	GivenName         string `json:"given_name"`
+	FamilyName        string `json:"family_name"`
+}
+
+// LoginState is kept server side between the redirect to the provider and the callback
+type LoginState struct {
+	Provider string `json:"provider"`
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+	// LinkUserId is set when an authenticated user links a new identity
+	LinkUserId  int64 `json:"link_user_id,omitempty"`
+	IssueTokens bool  `json:"issue_tokens,omitempty"`
+}
+
+// Provider discovers its issuer lazily so the web server starts even when a
+// provider is unreachable, a failed discovery is retried on the next login
+type Provider struct {
+	conf configs.OIDCProviderConfig
+
+	mu       sync.Mutex
+	oauth2   *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+func (p *Provider) init(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.verifier != nil {
+		return nil
+	}
+
+	// the provider is cached for later logins, so it must not be tied to the
+	// request that happened to trigger the discovery. Values such as an
+	// oidc.ClientContext http client are kept
+	provider, err := oidc.NewProvider(context.WithoutCancel(ctx), p.conf.IssuerURL)
+	if err != nil {
+		return fmt.Errorf("discover oidc provider %s error: %w", p.conf.Name, err)
+	}
+	scopes := p.conf.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{oidc.ScopeOpenID, "email", "profile"}
+	}
+	p.oauth2 = &oauth2.Config{
+		ClientID:     p.conf.ClientID,
+		ClientSecret: p.conf.ClientSecret,
+		RedirectURL:  p.conf.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       scopes,
+	}
+	p.verifier = provider.Verifier(&oidc.Config{ClientID: p.conf.ClientID})
+	return nil
+}
+
+// Registry runs the authorization code flow with PKCE against the configured providers
+type Registry struct {
+	Redis     *redis.Client
+	stateTTL  time.Duration
+	providers map[string]*Provider
+}
+
+func NewRegistry(rd *redis.Client, conf configs.OIDCConfig) *Registry {
+	providers := make(map[string]*Provider, len(conf.Providers))
+	for _, providerConf := range conf.Providers {
+		providers[providerConf.Name] = &Provider{conf: providerConf}
+	}
+	stateTTL := conf.StateTTL
+	if stateTTL == 0 {
+		stateTTL = 10 * time.Minute
+	}
+	return &Registry{Redis: rd, stateTTL: stateTTL, providers: providers}
+}
+
+func (r *Registry) StateTTL() time.Duration {
+	return r.stateTTL
+}
+
+func stateKey(state string) string {
+	return "oidc_state:" + state
+}
+
+func randomString() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// Begin returns the provider authorization url and the state the callback must echo back.
+// ctx may carry an http client set with oidc.ClientContext, e.g. to reach a test issuer
+func (r *Registry) Begin(ctx context.Context, providerName string, linkUserId int64, issueTokens bool) (authURL string, state string, err error) {
+	provider, ok := r.providers[providerName]
+	if !ok {
+		return "", "", ErrUnknownProvider
+	}
+	if err := provider.init(ctx); err != nil {
+		return "", "", err
+	}
+
+	if state, err = randomString(); err != nil {
+		return "", "", err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return "", "", err
+	}
+	loginState := LoginState{
+		Provider:    providerName,
+		Verifier:    oauth2.GenerateVerifier(),
+		Nonce:       nonce,
+		LinkUserId:  linkUserId,
+		IssueTokens: issueTokens,
+	}
+	value, err := json.Marshal(loginState)
+	if err != nil {
+		return "", "", err
+	}
+	if err := r.Redis.Set(ctx, stateKey(state), value, r.stateTTL).Err(); err != nil {
+		return "", "", err
+	}
+
+	authURL = provider.oauth2.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(loginState.Verifier))
+	return authURL, state, nil
+}
+
+// Complete redeems the state once, exchanges the code and verifies the ID token
+func (r *Registry) Complete(ctx context.Context, providerName string, state string, code string) (*LoginState, *Claims, error) {
+	value, err := r.Redis.GetDel(ctx, stateKey(state)).Bytes()
+	if err == redis.Nil {
+		return nil, nil, ErrInvalidState
+	} else if err != nil {
+		return nil, nil, err
+	}
+	var loginState LoginState
+	if err := json.Unmarshal(value, &loginState); err != nil {
+		return nil, nil, err
+	}
+	if loginState.Provider != providerName {
+		return nil, nil, ErrInvalidState
+	}
+
+	provider, ok := r.providers[providerName]
+	if !ok {
+		return nil, nil, ErrUnknownProvider
+	}
+	if err := provider.init(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	oauth2Token, err := provider.oauth2.Exchange(ctx, code, oauth2.VerifierOption(loginState.Verifier))
+	if err != nil {
+		return nil, nil, fmt.Errorf("exchange code error: %w", err)
+	}
+	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
+	if !ok {
+		return nil, nil, errors.New("token response without id_token")
+	}
+	idToken, err := provider.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, nil, fmt.Errorf("verify id token error: %w", err)
+	}
+	if idToken.Nonce != loginState.Nonce {
+		return nil, nil, errors.New("id token nonce mismatch")
+	}
+
+	var claims Claims
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, nil, err
+	}
+	claims.Issuer = idToken.Issuer
+	claims.Subject = idToken.Subject
+	return &loginState, &claims, nil
+}
@@ -0,0 +1,402 @@
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-redis/redis/v8"
+	"github.com/khailequang334/social_network/configs"
+)
+
+const (
+	testClientID     = "social-network"
+	testClientSecret = "secret"
+	testRedirectURL  = "http://localhost/oidc/test/callback"
+)
+
+// mockIssuer is a minimal OIDC provider serving discovery, JWKS and the token endpoint.
+// authorize stands in for the user signing in at the provider and hands out a code
+type mockIssuer struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu        sync.Mutex
+	codes     map[string]issuedCode
+	keyGets   int
+	subject   string
+	email     string
+	badSigner bool
+}
+
+type issuedCode struct {
+	challenge string
+	nonce     string
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := &mockIssuer{t: t, key: key, codes: make(map[string]issuedCode), subject: "subject-1", email: "alice@example.com"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", issuer.discovery)
+	mux.HandleFunc("/keys", issuer.keys)
+	mux.HandleFunc("/token", issuer.token)
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func (m *mockIssuer) discovery(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"issuer":                                m.server.URL,
+		"authorization_endpoint":                m.server.URL + "/authorize",
+		"token_endpoint":                        m.server.URL + "/token",
+		"jwks_uri":                              m.server.URL + "/keys",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (m *mockIssuer) keys(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	m.keyGets++
+	m.mu.Unlock()
+	json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &m.key.PublicKey, KeyID: "test", Algorithm: string(jose.RS256), Use: "sig"},
+	}})
+}
+
+// authorize checks the authorization request like the provider would and returns a code for it
+func (m *mockIssuer) authorize(authURL string) string {
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	query := parsed.Query()
+	if query.Get("client_id") != testClientID || query.Get("redirect_uri") != testRedirectURL {
+		m.t.Fatalf("unexpected client in auth url %s", authURL)
+	}
+	if query.Get("response_type") != "code" || query.Get("code_challenge_method") != "S256" {
+		m.t.Fatalf("auth url %s does not ask for a code with PKCE", authURL)
+	}
+
+	code := "code-" + query.Get("state")
+	m.mu.Lock()
+	m.codes[code] = issuedCode{challenge: query.Get("code_challenge"), nonce: query.Get("nonce")}
+	m.mu.Unlock()
+	return code
+}
+
+func (m *mockIssuer) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != testClientID || clientSecret != testClientSecret {
+		writeTokenError(w, "invalid_client")
+		return
+	}
+
+	m.mu.Lock()
+	issued, ok := m.codes[r.PostForm.Get("code")]
+	delete(m.codes, r.PostForm.Get("code"))
+	m.mu.Unlock()
+	if !ok {
+		writeTokenError(w, "invalid_grant")
+		return
+	}
+	digest := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(digest[:]) != issued.challenge {
+		writeTokenError(w, "invalid_grant")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": "access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     m.idToken(issued.nonce),
+	})
+}
+
+func writeTokenError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+func (m *mockIssuer) idToken(nonce string) string {
+	key := m.key
+	if m.badSigner {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			m.t.Fatal(err)
+		}
+		key = otherKey
+	}
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "test"),
+	)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	payload, err := json.Marshal(map[string]interface{}{
+		"iss":            m.server.URL,
+		"sub":            m.subject,
+		"aud":            testClientID,
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"nonce":          nonce,
+		"email":          m.email,
+		"email_verified": true,
+		"name":           "Alice",
+	})
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	signed, err := signer.Sign(payload)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	raw, err := signed.CompactSerialize()
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	return raw
+}
+
+func newTestRegistry(t *testing.T, issuerURL string) (*Registry, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	rd := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rd.Close() })
+
+	registry := NewRegistry(rd, configs.OIDCConfig{
+		StateTTL: 5 * time.Minute,
+		Providers: []configs.OIDCProviderConfig{{
+			Name:         "test",
+			IssuerURL:    issuerURL,
+			ClientID:     testClientID,
+			ClientSecret: testClientSecret,
+			RedirectURL:  testRedirectURL,
+		}},
+	})
+	return registry, mr
+}
+
+func TestBeginStoresStateAndBuildsPKCEAuthURL(t *testing.T) {
+	issuer := newMockIssuer(t)
+	registry, mr := newTestRegistry(t, issuer.server.URL)
+
+	authURL, state, err := registry.Begin(context.Background(), "test", 0, false)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := parsed.Scheme + "://" + parsed.Host + parsed.Path; got != issuer.server.URL+"/authorize" {
+		t.Errorf("auth url points at %s", got)
+	}
+	query := parsed.Query()
+	if query.Get("state") != state {
+		t.Errorf("auth url state %q, want %q", query.Get("state"), state)
+	}
+	if query.Get("code_challenge") == "" || query.Get("nonce") == "" {
+		t.Errorf("auth url %s misses the PKCE challenge or nonce", authURL)
+	}
+	if query.Get("scope") != "openid email profile" {
+		t.Errorf("default scopes %q", query.Get("scope"))
+	}
+
+	if !mr.Exists(stateKey(state)) {
+		t.Fatal("state is not stored")
+	}
+	if ttl := mr.TTL(stateKey(state)); ttl != 5*time.Minute {
+		t.Errorf("state ttl %v, want 5m", ttl)
+	}
+}
+
+func TestBeginUnknownProvider(t *testing.T) {
+	issuer := newMockIssuer(t)
+	registry, _ := newTestRegistry(t, issuer.server.URL)
+
+	if _, _, err := registry.Begin(context.Background(), "missing", 0, false); !errors.Is(err, ErrUnknownProvider) {
+		t.Fatalf("Begin with unknown provider returned %v", err)
+	}
+}
+
+func TestCompleteReturnsVerifiedClaims(t *testing.T) {
+	issuer := newMockIssuer(t)
+	registry, mr := newTestRegistry(t, issuer.server.URL)
+
+	authURL, state, err := registry.Begin(context.Background(), "test", 0, true)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	code := issuer.authorize(authURL)
+
+	loginState, claims, err := registry.Complete(context.Background(), "test", state, code)
+	if err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if claims.Issuer != issuer.server.URL || claims.Subject != "subject-1" {
+		t.Errorf("claims identify %s/%s", claims.Issuer, claims.Subject)
+	}
+	if claims.Email != "alice@example.com" || !claims.EmailVerified || claims.Name != "Alice" {
+		t.Errorf("unexpected profile claims %+v", claims)
+	}
+	if loginState.LinkUserId != 0 || !loginState.IssueTokens {
+		t.Errorf("unexpected login state %+v", loginState)
+	}
+	if mr.Exists(stateKey(state)) {
+		t.Error("state is not removed after the callback")
+	}
+}
+
+func TestCompleteKeepsLinkUser(t *testing.T) {
+	issuer := newMockIssuer(t)
+	registry, _ := newTestRegistry(t, issuer.server.URL)
+
+	authURL, state, err := registry.Begin(context.Background(), "test", 42, false)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	loginState, claims, err := registry.Complete(context.Background(), "test", state, issuer.authorize(authURL))
+	if err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if loginState.LinkUserId != 42 {
+		t.Errorf("link user %d, want 42", loginState.LinkUserId)
+	}
+	if claims.Subject != "subject-1" {
+		t.Errorf("linked subject %q", claims.Subject)
+	}
+}
+
+func TestCompleteRejectsInvalidState(t *testing.T) {
+	issuer := newMockIssuer(t)
+	registry, _ := newTestRegistry(t, issuer.server.URL)
+
+	authURL, state, err := registry.Begin(context.Background(), "test", 0, false)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	code := issuer.authorize(authURL)
+
+	if _, _, err := registry.Complete(context.Background(), "test", "forged-state", code); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("Complete with unknown state returned %v", err)
+	}
+	if _, _, err := registry.Complete(context.Background(), "test", state, code); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	// a state is only redeemed once
+	if _, _, err := registry.Complete(context.Background(), "test", state, code); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("Complete with replayed state returned %v", err)
+	}
+}
+
+func TestCompleteRejectsStateOfAnotherProvider(t *testing.T) {
+	issuer := newMockIssuer(t)
+	registry, _ := newTestRegistry(t, issuer.server.URL)
+
+	authURL, state, err := registry.Begin(context.Background(), "test", 0, false)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	if _, _, err := registry.Complete(context.Background(), "other", state, issuer.authorize(authURL)); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("Complete for another provider returned %v", err)
+	}
+}
+
+func TestCompleteRejectsPKCEMismatch(t *testing.T) {
+	issuer := newMockIssuer(t)
+	registry, _ := newTestRegistry(t, issuer.server.URL)
+
+	authURL, state, err := registry.Begin(context.Background(), "test", 0, false)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	code := issuer.authorize(authURL)
+	// the code was handed out for a challenge the stored verifier does not match
+	issuer.codes[code] = issuedCode{challenge: "another-challenge", nonce: issuer.codes[code].nonce}
+
+	if _, _, err := registry.Complete(context.Background(), "test", state, code); err == nil {
+		t.Fatal("Complete accepted a code issued for another PKCE challenge")
+	}
+}
+
+func TestCompleteRejectsCodeOfAnotherLogin(t *testing.T) {
+	issuer := newMockIssuer(t)
+	registry, _ := newTestRegistry(t, issuer.server.URL)
+
+	firstURL, _, err := registry.Begin(context.Background(), "test", 0, false)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	_, secondState, err := registry.Begin(context.Background(), "test", 0, false)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+
+	if _, _, err := registry.Complete(context.Background(), "test", secondState, issuer.authorize(firstURL)); err == nil {
+		t.Fatal("Complete accepted a code issued for another login")
+	}
+}
+
+func TestCompleteRejectsUnknownSigningKey(t *testing.T) {
+	issuer := newMockIssuer(t)
+	registry, _ := newTestRegistry(t, issuer.server.URL)
+	issuer.badSigner = true
+
+	authURL, state, err := registry.Begin(context.Background(), "test", 0, false)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	if _, _, err := registry.Complete(context.Background(), "test", state, issuer.authorize(authURL)); err == nil {
+		t.Fatal("Complete accepted an id token signed with an unknown key")
+	}
+}
+
+// the provider is discovered during one request and reused by later ones,
+// fetching the key set must not depend on the first request still running
+func TestCompleteAfterDiscoveryRequestEnded(t *testing.T) {
+	issuer := newMockIssuer(t)
+	registry, _ := newTestRegistry(t, issuer.server.URL)
+
+	beginCtx, cancel := context.WithCancel(context.Background())
+	authURL, state, err := registry.Begin(beginCtx, "test", 0, false)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	cancel()
+
+	if _, _, err := registry.Complete(context.Background(), "test", state, issuer.authorize(authURL)); err != nil {
+		t.Fatalf("Complete after the discovery request ended: %v", err)
+	}
+	if issuer.keyGets == 0 {
+		t.Error("key set was never fetched")
+	}
+}
@@ -0,0 +1,104 @@
+// Package takeout defines the archive format of personal data exports. An
+// archive is a single JSON document holding everything stored about one
+// user, readers must check FormatVersion before interpreting the rest.
+package takeout
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// FormatVersion is bumped on every change that older readers can not ignore
+const FormatVersion = 1
+
+type Archive struct {
+	FormatVersion int       `json:"format_version"`
+	ExportedAt    time.Time `json:"exported_at"`
+	Profile       Profile   `json:"profile"`
+	Posts         []Post    `json:"posts"`
+	// Comments and Likes are the ones the user made, on any post
+	Comments  []Comment `json:"comments"`
+	Likes     []Like    `json:"likes"`
+	Followers []Follow  `json:"followers"`
+	Following []Follow  `json:"following"`
+}
+
+type Profile struct {
+	UserId          int64             `json:"user_id"`
+	UserName        string            `json:"user_name"`
+	Email           string            `json:"email"`
+	EmailVerifiedAt *time.Time        `json:"email_verified_at,omitempty"`
+	FirstName       string            `json:"first_name"`
+	LastName        string            `json:"last_name"`
+	DateOfBirth     *time.Time        `json:"date_of_birth,omitempty"`
+	Bio             string            `json:"bio"`
+	AvatarPath      string            `json:"avatar_path"`
+	Location        string            `json:"location"`
+	Website         string            `json:"website"`
+	Pronouns        string            `json:"pronouns"`
+	Private         bool              `json:"private"`
+	Visibility      ProfileVisibility `json:"visibility"`
+}
+
+// ProfileVisibility values are "public", "followers" or "private"
+type ProfileVisibility struct {
+	Bio      string `json:"bio"`
+	Avatar   string `json:"avatar"`
+	Location string `json:"location"`
+	Website  string `json:"website"`
+	Pronouns string `json:"pronouns"`
+}
+
+type Post struct {
+	PostId           int64     `json:"post_id"`
+	ContentText      string    `json:"content_text"`
+	ContentImagePath string    `json:"content_image_path"`
+	Visible          bool      `json:"visible"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+	// Audience is "public", "followers", "close_friends" or "only_me". It is
+	// empty in archives written before post audiences, Visible applies then
+	Audience string     `json:"audience"`
+	PinnedAt *time.Time `json:"pinned_at,omitempty"`
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+}
+
+type Comment struct {
+	CommentId int64     `json:"comment_id"`
+	PostId    int64     `json:"post_id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Like struct {
+	PostId int64 `json:"post_id"`
+}
+
+// Follow names the other side of a follow edge, by id and by user name so an
+// importer in another environment can match it to an existing user
+type Follow struct {
+	UserId   int64  `json:"user_id"`
+	UserName string `json:"user_name"`
+	// FollowedAt is zero in archives written before follow times were kept
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+func Write(w io.Writer, archive *Archive) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(archive)
+}
+
+// Read rejects archives written in a format version this reader does not know
+func Read(r io.Reader) (*Archive, error) {
+	var archive Archive
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("decode archive: %w", err)
+	}
+	if archive.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("unsupported archive format version %d", archive.FormatVersion)
+	}
+	return &archive, nil
+}
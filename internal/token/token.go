@@ -0,0 +1,325 @@
+package token
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/khailequang334/social_network/configs"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrRevokedToken = errors.New("token revoked")
+)
+
+type signingKey struct {
+	method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
+	canSign   bool
+}
+
+// Manager issues and verifies JWT access tokens and opaque rotating refresh
+// tokens. Every configured key can verify, only the key named by signing_kid
+// signs, so keys can be rotated by adding a new one and switching the kid.
+type Manager struct {
+	Redis      *redis.Client
+	keys       map[string]*signingKey
+	signingKid string
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+type Pair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration
+}
+
+type AccessClaims struct {
+	jwt.RegisteredClaims
+	UserId int64 `json:"uid"`
+	// Generation is bumped by RevokeAll, tokens of an older generation are rejected
+	Generation int64 `json:"gen"`
+}
+
+func NewManager(rd *redis.Client, conf configs.JWTConfig) (*Manager, error) {
+	m := &Manager{
+		Redis:      rd,
+		keys:       make(map[string]*signingKey, len(conf.Keys)),
+		signingKid: conf.SigningKid,
+		issuer:     conf.Issuer,
+		accessTTL:  conf.AccessTTL,
+		refreshTTL: conf.RefreshTTL,
+	}
+	for _, keyConf := range conf.Keys {
+		key, err := loadKey(keyConf)
+		if err != nil {
+			return nil, fmt.Errorf("load jwt key (kid=%s) error: %s", keyConf.Kid, err)
+		}
+		m.keys[keyConf.Kid] = key
+	}
+
+	signing, ok := m.keys[m.signingKid]
+	if !ok {
+		return nil, fmt.Errorf("jwt signing key (kid=%s) not configured", m.signingKid)
+	}
+	if !signing.canSign {
+		return nil, fmt.Errorf("jwt signing key (kid=%s) has no private key", m.signingKid)
+	}
+	if m.accessTTL <= 0 || m.refreshTTL <= 0 {
+		return nil, errors.New("jwt access_ttl and refresh_ttl must be positive")
+	}
+	return m, nil
+}
+
+func readFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	return os.ReadFile(path)
+}
+
+func loadKey(conf configs.JWTKeyConfig) (*signingKey, error) {
+	privatePEM, err := readFile(conf.PrivateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	publicPEM, err := readFile(conf.PublicKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	switch conf.Algorithm {
+	case "HS256":
+		if conf.Secret == "" {
+			return nil, errors.New("secret is required for HS256")
+		}
+		return &signingKey{
+			method:    jwt.SigningMethodHS256,
+			signKey:   []byte(conf.Secret),
+			verifyKey: []byte(conf.Secret),
+			canSign:   true,
+		}, nil
+	case "RS256":
+		key := &signingKey{method: jwt.SigningMethodRS256}
+		if privatePEM != nil {
+			privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privatePEM)
+			if err != nil {
+				return nil, err
+			}
+			key.signKey, key.verifyKey, key.canSign = privateKey, &privateKey.PublicKey, true
+		} else if publicPEM != nil {
+			publicKey, err := jwt.ParseRSAPublicKeyFromPEM(publicPEM)
+			if err != nil {
+				return nil, err
+			}
+			key.verifyKey = publicKey
+		} else {
+			return nil, errors.New("private_key_path or public_key_path is required for RS256")
+		}
+		return key, nil
+	case "EdDSA":
+		key := &signingKey{method: jwt.SigningMethodEdDSA}
+		if privatePEM != nil {
+			privateKey, err := jwt.ParseEdPrivateKeyFromPEM(privatePEM)
+			if err != nil {
+				return nil, err
+			}
+			key.signKey, key.verifyKey, key.canSign = privateKey, privateKey.(crypto.Signer).Public(), true
+		} else if publicPEM != nil {
+			publicKey, err := jwt.ParseEdPublicKeyFromPEM(publicPEM)
+			if err != nil {
+				return nil, err
+			}
+			key.verifyKey = publicKey
+		} else {
+			return nil, errors.New("private_key_path or public_key_path is required for EdDSA")
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", conf.Algorithm)
+	}
+}
+
+func randomToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func revokedKey(jti string) string {
+	return "revoked_jti:" + jti
+}
+
+func generationKey(userId int64) string {
+	return "token_generation:" + strconv.FormatInt(userId, 10)
+}
+
+func refreshKey(tokenHash string) string {
+	return "refresh_token:" + tokenHash
+}
+
+func userRefreshKey(userId int64) string {
+	return "user_refresh_tokens:" + strconv.FormatInt(userId, 10)
+}
+
+func (m *Manager) issueAccessToken(ctx context.Context, userId int64) (string, error) {
+	jti, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	generation, err := m.Redis.Get(ctx, generationKey(userId)).Int64()
+	if err != nil && err != redis.Nil {
+		return "", err
+	}
+	now := time.Now()
+	claims := AccessClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    m.issuer,
+			Subject:   strconv.FormatInt(userId, 10),
+			ID:        jti,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.accessTTL)),
+		},
+		UserId:     userId,
+		Generation: generation,
+	}
+
+	key := m.keys[m.signingKid]
+	t := jwt.NewWithClaims(key.method, claims)
+	t.Header["kid"] = m.signingKid
+	return t.SignedString(key.signKey)
+}
+
+// Issue creates a new access token and a refresh token for the user
+func (m *Manager) Issue(ctx context.Context, userId int64) (*Pair, error) {
+	accessToken, err := m.issueAccessToken(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+
+	pipe := m.Redis.TxPipeline()
+	pipe.Set(ctx, refreshKey(hashToken(refreshToken)), userId, m.refreshTTL)
+	pipe.SAdd(ctx, userRefreshKey(userId), hashToken(refreshToken))
+	pipe.Expire(ctx, userRefreshKey(userId), m.refreshTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return &Pair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    m.accessTTL,
+	}, nil
+}
+
+// Refresh consumes a refresh token and issues a new pair, a refresh token can
+// only be used once
+func (m *Manager) Refresh(ctx context.Context, refreshToken string) (*Pair, error) {
+	userId, err := m.Redis.GetDel(ctx, refreshKey(hashToken(refreshToken))).Int64()
+	if err == redis.Nil {
+		return nil, ErrInvalidToken
+	} else if err != nil {
+		return nil, err
+	}
+	if err := m.Redis.SRem(ctx, userRefreshKey(userId), hashToken(refreshToken)).Err(); err != nil {
+		return nil, err
+	}
+	return m.Issue(ctx, userId)
+}
+
+// Verify checks signature, expiry and revocation of an access token
+func (m *Manager) Verify(ctx context.Context, accessToken string) (*AccessClaims, error) {
+	claims := &AccessClaims{}
+	_, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := m.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown kid %q", kid)
+		}
+		if t.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("unexpected algorithm %q", t.Method.Alg())
+		}
+		return key.verifyKey, nil
+	}, jwt.WithIssuer(m.issuer), jwt.WithExpirationRequired(), jwt.WithIssuedAt())
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	pipe := m.Redis.Pipeline()
+	revoked := pipe.Exists(ctx, revokedKey(claims.ID))
+	generation := pipe.Get(ctx, generationKey(claims.UserId))
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+	if revoked.Val() > 0 {
+		return nil, ErrRevokedToken
+	}
+	if current, _ := generation.Int64(); claims.Generation != current {
+		return nil, ErrRevokedToken
+	}
+	return claims, nil
+}
+
+// Revoke puts the access token on the revocation list until it expires
+func (m *Manager) Revoke(ctx context.Context, claims *AccessClaims) error {
+	ttl := time.Until(claims.ExpiresAt.Time)
+	if ttl <= 0 {
+		return nil
+	}
+	return m.Redis.Set(ctx, revokedKey(claims.ID), 1, ttl).Err()
+}
+
+// RevokeRefreshToken drops a refresh token so it can not be used anymore
+func (m *Manager) RevokeRefreshToken(ctx context.Context, refreshToken string) error {
+	userId, err := m.Redis.GetDel(ctx, refreshKey(hashToken(refreshToken))).Int64()
+	if err == redis.Nil {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return m.Redis.SRem(ctx, userRefreshKey(userId), hashToken(refreshToken)).Err()
+}
+
+// RevokeAll invalidates every access and refresh token issued to the user so far
+func (m *Manager) RevokeAll(ctx context.Context, userId int64) error {
+	hashes, err := m.Redis.SMembers(ctx, userRefreshKey(userId)).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(hashes)+1)
+	for _, hash := range hashes {
+		keys = append(keys, refreshKey(hash))
+	}
+	keys = append(keys, userRefreshKey(userId))
+
+	pipe := m.Redis.TxPipeline()
+	pipe.Del(ctx, keys...)
+	pipe.Incr(ctx, generationKey(userId))
+	_, err = pipe.Exec(ctx)
+	return err
+}
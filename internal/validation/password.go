@@ -0,0 +1,135 @@
+package validation
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/khailequang334/social_network/configs"
+)
+
+type PasswordPolicy struct {
+	minLength int
+	maxBytes  int
+	minScore  int
+	breached  map[string]struct{}
+}
+
+// NewPasswordPolicy loads the breached password list, one password per line,
+// the check is skipped when no list is configured. maxBytes is the longest
+// password the active hasher reads in full, 0 for no limit
+func NewPasswordPolicy(conf configs.PasswordPolicyConfig, maxBytes int) (*PasswordPolicy, error) {
+	policy := &PasswordPolicy{
+		minLength: conf.MinLength,
+		maxBytes:  maxBytes,
+		minScore:  conf.MinScore,
+		breached:  map[string]struct{}{},
+	}
+	if conf.BreachedListPath == "" {
+		return policy, nil
+	}
+
+	f, err := os.Open(conf.BreachedListPath)
+	if err != nil {
+		return nil, fmt.Errorf("open breached password list (path=%s) error: %s", conf.BreachedListPath, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		policy.breached[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read breached password list (path=%s) error: %s", conf.BreachedListPath, err)
+	}
+	return policy, nil
+}
+
+// Check validates a new password, userInputs are values such as the username
+// or email that must not make up the password
+func (p *PasswordPolicy) Check(password string, userInputs ...string) *FieldError {
+	if len(password) < p.minLength {
+		return &FieldError{Field: "password", Message: fmt.Sprintf("must be at least %d characters", p.minLength)}
+	}
+	if p.maxBytes > 0 && len(password) > p.maxBytes {
+		return &FieldError{Field: "password", Message: fmt.Sprintf("must be at most %d bytes", p.maxBytes)}
+	}
+	if _, ok := p.breached[strings.ToLower(password)]; ok {
+		return &FieldError{Field: "password", Message: "appears in a list of breached passwords"}
+	}
+	if PasswordScore(password, userInputs...) < p.minScore {
+		return &FieldError{Field: "password", Message: "is too weak, use a longer password with more variety"}
+	}
+	return nil
+}
+
+// PasswordScore rates a password from 0 (trivial) to 4 (strong) by an
+// entropy estimate where repeated and sequential characters do not count
+func PasswordScore(password string, userInputs ...string) int {
+	lower := strings.ToLower(password)
+	for _, input := range userInputs {
+		input = strings.ToLower(input)
+		if len(input) >= 3 && strings.Contains(lower, input) {
+			return 0
+		}
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+	effectiveLength := 0
+	var prev rune
+	for i, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		default:
+			hasSymbol = true
+		}
+		// "aaaa", "abcd" and "4321" add close to nothing
+		if i == 0 || (r != prev && r != prev+1 && r != prev-1) {
+			effectiveLength++
+		}
+		prev = r
+	}
+
+	charset := 0
+	if hasLower {
+		charset += 26
+	}
+	if hasUpper {
+		charset += 26
+	}
+	if hasDigit {
+		charset += 10
+	}
+	if hasSymbol {
+		charset += 33
+	}
+	if charset == 0 {
+		return 0
+	}
+
+	bits := float64(effectiveLength) * math.Log2(float64(charset))
+	switch {
+	case bits < 28:
+		return 0
+	case bits < 36:
+		return 1
+	case bits < 60:
+		return 2
+	case bits < 80:
+		return 3
+	default:
+		return 4
+	}
+}
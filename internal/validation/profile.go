@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+)
+
+const (
+	BioMaxLength        = 160
+	AvatarPathMaxLength = 255
+	LocationMaxLength   = 100
+	WebsiteMaxLength    = 255
+	PronounsMaxLength   = 30
+)
+
+// Bio, AvatarPath, Location, Website and Pronouns all accept "" to clear the field
+
+func Bio(bio string) *FieldError {
+	return maxLength("bio", bio, BioMaxLength)
+}
+
+func AvatarPath(avatarPath string) *FieldError {
+	return maxLength("avatar_path", avatarPath, AvatarPathMaxLength)
+}
+
+func Location(location string) *FieldError {
+	return maxLength("location", location, LocationMaxLength)
+}
+
+func Pronouns(pronouns string) *FieldError {
+	return maxLength("pronouns", pronouns, PronounsMaxLength)
+}
+
+// Website only accepts absolute http and https links so the profile can render it as-is
+func Website(website string) *FieldError {
+	if website == "" {
+		return nil
+	}
+	if fieldError := maxLength("website", website, WebsiteMaxLength); fieldError != nil {
+		return fieldError
+	}
+	parsed, err := url.Parse(website)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return &FieldError{Field: "website", Message: "must be an http or https link"}
+	}
+	return nil
+}
+
+func maxLength(field string, value string, max int) *FieldError {
+	if utf8.RuneCountInString(value) > max {
+		return &FieldError{Field: field, Message: fmt.Sprintf("must be at most %d characters", max)}
+	}
+	return nil
+}
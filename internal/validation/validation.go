@@ -0,0 +1,63 @@
+package validation
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	UserNameMinLength = 3
+	UserNameMaxLength = 30
+	EmailMaxLength    = 50
+	NameMaxLength     = 50
+)
+
+var userNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.]+$`)
+
+// FieldError describes why the value of a single request field was rejected
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func UserName(userName string) *FieldError {
+	if len(userName) < UserNameMinLength || len(userName) > UserNameMaxLength {
+		return &FieldError{Field: "user_name", Message: fmt.Sprintf("must be between %d and %d characters", UserNameMinLength, UserNameMaxLength)}
+	}
+	if !userNamePattern.MatchString(userName) {
+		return &FieldError{Field: "user_name", Message: "may only contain letters, digits, '_' and '.'"}
+	}
+	if strings.HasPrefix(userName, ".") || strings.HasSuffix(userName, ".") || strings.Contains(userName, "..") {
+		return &FieldError{Field: "user_name", Message: "can not start or end with '.' or contain '..'"}
+	}
+	return nil
+}
+
+// Email only accepts a bare address, display names such as "Bob <bob@example.com>" are rejected
+func Email(email string) *FieldError {
+	if email == "" {
+		return &FieldError{Field: "email", Message: "is required"}
+	}
+	if len(email) > EmailMaxLength {
+		return &FieldError{Field: "email", Message: fmt.Sprintf("must be at most %d characters", EmailMaxLength)}
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email || !strings.Contains(email[strings.LastIndex(email, "@"):], ".") {
+		return &FieldError{Field: "email", Message: "is not a valid email address"}
+	}
+	return nil
+}
+
+// Name checks first and last names, a last name is optional for people with a single name
+func Name(field string, name string, required bool) *FieldError {
+	if required && strings.TrimSpace(name) == "" {
+		return &FieldError{Field: field, Message: "is required"}
+	}
+	if utf8.RuneCountInString(name) > NameMaxLength {
+		return &FieldError{Field: field, Message: fmt.Sprintf("must be at most %d characters", NameMaxLength)}
+	}
+	return nil
+}